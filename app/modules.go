@@ -46,6 +46,8 @@ import (
 	"github.com/osmosis-labs/osmosis/v7/x/epochs"
 	epochstypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/twap"
+	twaptypes "github.com/osmosis-labs/osmosis/v7/x/gamm/twap/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v7/x/incentives"
 	incentivestypes "github.com/osmosis-labs/osmosis/v7/x/incentives/types"
@@ -120,6 +122,7 @@ func appModules(
 		params.NewAppModule(*app.ParamsKeeper),
 		app.TransferModule,
 		gamm.NewAppModule(appCodec, *app.GAMMKeeper, app.AccountKeeper, app.BankKeeper),
+		twap.NewAppModule(appCodec, *app.TwapKeeper),
 		txfees.NewAppModule(appCodec, *app.TxFeesKeeper),
 		incentives.NewAppModule(appCodec, *app.IncentivesKeeper, app.AccountKeeper, app.BankKeeper, app.EpochsKeeper),
 		lockup.NewAppModule(appCodec, *app.LockupKeeper, app.AccountKeeper, app.BankKeeper),
@@ -166,6 +169,7 @@ func orderBeginBlockers() []string {
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
 		gammtypes.ModuleName,
+		twaptypes.ModuleName,
 		incentivestypes.ModuleName,
 		lockuptypes.ModuleName,
 		poolincentivestypes.ModuleName,
@@ -179,8 +183,10 @@ func orderBeginBlockers() []string {
 
 func OrderEndBlockers(allModuleNames []string) []string {
 	ord := partialord.NewPartialOrdering(allModuleNames)
-	// only Osmosis modules with endblock code are: crisis, govtypes, staking
-	// we don't care about the relative ordering between them.
+	// only Osmosis modules with endblock code are: twap, txfees, crisis, govtypes, staking
+	// we don't care about the relative ordering between them,
+	// except that the twap records must include the txfees swaps.
+	ord.Before(txfeestypes.ModuleName, twaptypes.ModuleName)
 	return ord.TotalOrdering()
 }
 
@@ -199,6 +205,7 @@ func OrderInitGenesis(allModuleNames []string) []string {
 		ibchost.ModuleName,
 		icatypes.ModuleName,
 		gammtypes.ModuleName,
+		twaptypes.ModuleName,
 		txfeestypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
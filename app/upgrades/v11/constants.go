@@ -4,6 +4,8 @@ import (
 	"github.com/osmosis-labs/osmosis/v7/app/upgrades"
 
 	store "github.com/cosmos/cosmos-sdk/store/types"
+
+	twaptypes "github.com/osmosis-labs/osmosis/v7/x/gamm/twap/types"
 )
 
 // UpgradeName defines the on-chain upgrade name for the Osmosis v9 upgrade.
@@ -12,5 +14,7 @@ const UpgradeName = "v11"
 var Upgrade = upgrades.Upgrade{
 	UpgradeName:          UpgradeName,
 	CreateUpgradeHandler: CreateUpgradeHandler,
-	StoreUpgrades:        store.StoreUpgrades{},
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{twaptypes.StoreKey},
+	},
 }
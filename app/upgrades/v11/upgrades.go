@@ -7,6 +7,9 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v7/app/keepers"
 	"github.com/osmosis-labs/osmosis/v7/app/upgrades"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 )
 
 func CreateUpgradeHandler(
@@ -16,6 +19,28 @@ func CreateUpgradeHandler(
 	keepers *keepers.AppKeepers,
 ) upgradetypes.UpgradeHandler {
 	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// Start tracking twap records for all pools created before the twap module existed.
+		if err := keepers.TwapKeeper.MigrateExistingPools(ctx, keepers.GAMMKeeper.GetNextPoolNumber(ctx)); err != nil {
+			return nil, err
+		}
+
+		// Value LP shares for superfluid staking from twaps, rather than from pool balances at the epoch boundary.
+		// The parameter is new, so it is set directly on the subspace.
+		keepers.GetSubspace(superfluidtypes.ModuleName).Set(ctx, superfluidtypes.KeyOsmoEquivalentMultiplierMethod, superfluidtypes.OsmoEquivalentMultiplierMethodTwap)
+
+		// Add the gamm taker fee params, taking no taker fee until governance sets one.
+		// The parameters are new, so they are set directly on the subspace.
+		gammSubspace := keepers.GetSubspace(gammtypes.ModuleName)
+		gammSubspace.Set(ctx, gammtypes.KeyTakerFee, sdk.ZeroDec())
+		gammSubspace.Set(ctx, gammtypes.KeyPoolTakerFees, []gammtypes.PoolTakerFee{})
+		// Add the gamm circuit breaker params, leaving the circuit breaker off until governance sets a max price change.
+		gammSubspace.Set(ctx, gammtypes.KeyCircuitBreakerMaxPriceChange, sdk.ZeroDec())
+		gammSubspace.Set(ctx, gammtypes.KeyCircuitBreakerWindow, uint64(gammtypes.DefaultCircuitBreakerWindow))
+
+		// Bound the price at which non-OSMO tx fees are swapped into OSMO at every epoch.
+		// The txfees module had no params before, so all of them are set.
+		keepers.TxFeesKeeper.SetParams(ctx, txfeestypes.DefaultParams())
+
 		return mm.RunMigrations(ctx, configurator, fromVM)
 	}
 }
@@ -130,6 +130,20 @@ arbitrage-min-gas-fee = ".005"
 # This is the minimum gas fee any tx with high gas demand should have, denominated in uosmo per gas
 # Default value of ".0025" then means that a tx with 1 million gas costs (.0025 uosmo/gas) * 1_000_000 gas = .0025 osmo
 min-gas-price-for-high-gas-tx = ".0025"
+
+# Fee filters raise the minimum gas price of the txs they match, in order, after the
+# arbitrage-min-gas-fee above. A filter matches a tx if all of its set matchers do:
+#   arbitrage: the tx looks like an arbitrage tx
+#   msg-types: any msg of the tx has one of these type urls
+#   pool-ids: any msg of the tx joins, exits or swaps through one of these pools
+# and then multiplies the minimum gas price by gas-price-multiplier, and raises it to at least
+# min-gas-price, denominated in uosmo per gas. Uncomment and copy the table below to add filters.
+# [[osmosis-mempool.fee-filters]]
+# name = "pool 1 swaps"
+# msg-types = ["/osmosis.gamm.v1beta1.MsgSwapExactAmountIn", "/osmosis.gamm.v1beta1.MsgSwapExactAmountOut"]
+# pool-ids = [1]
+# gas-price-multiplier = "2"
+# min-gas-price = ".0025"
 `
 
 	return OsmosisAppTemplate, OsmosisAppCfg
@@ -8,6 +8,8 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NOTE: never use new(BigDec) or else we will panic unmarshalling into the
@@ -28,6 +30,10 @@ const (
 
 	// max number of iterations in ApproxRoot function
 	maxApproxRootIterations = 100
+
+	// max number of iterations in LogBase2 function,
+	// each iteration computes one more bit of the fractional part.
+	maxLog2Iterations = DecimalPrecisionBits
 )
 
 var (
@@ -37,6 +43,13 @@ var (
 	zeroInt              = big.NewInt(0)
 	oneInt               = big.NewInt(1)
 	tenInt               = big.NewInt(10)
+
+	// constants are built from the raw integer representation,
+	// as precisionMultipliers are not yet set at variable initialization.
+	twoBigDec = BigDec{new(big.Int).Mul(big.NewInt(2), precisionReuse)}
+
+	// ln(2), used to compute Exp2.
+	ln2 = BigDec{big.NewInt(693147180559945309)}
 )
 
 // Decimal errors
@@ -409,6 +422,89 @@ func (d BigDec) ApproxSqrt() (BigDec, error) {
 	return d.ApproxRoot(2)
 }
 
+// LogBase2 returns log_2 {x}.
+// Rounds down by truncations during division and right shifting.
+// The fractional part is computed one bit at a time, for DecimalPrecisionBits bits.
+func (d BigDec) LogBase2() BigDec {
+	// create a new decimal to avoid mutating
+	// the receiver's int buffer.
+	x := BigDec{new(big.Int).Set(d.i)}
+	if !x.IsPositive() {
+		panic(fmt.Sprintf("log is not defined at <= 0, given (%s)", x))
+	}
+
+	// Normalize x to be 1 <= x < 2.
+	// y is the integer part of the log.
+	y := ZeroDec()
+
+	// repeat until: x >= 1.
+	for x.LT(OneDec()) {
+		x.i.Lsh(x.i, 1)
+		y = y.Sub(OneDec())
+	}
+
+	// repeat until: x < 2.
+	for x.GTE(twoBigDec) {
+		x.i.Rsh(x.i, 1)
+		y = y.Add(OneDec())
+	}
+
+	// b is the weight of the next fractional bit of the log.
+	// Squaring x in [1, 2) doubles its log, so the next bit is set
+	// iff x^2 >= 2.
+	b := OneDec().Quo(twoBigDec)
+	for i := 0; i < maxLog2Iterations; i++ {
+		x = x.Mul(x)
+		if x.GTE(twoBigDec) {
+			x.i.Rsh(x.i, 1)
+			y = y.Add(b)
+		}
+		b.i.Rsh(b.i, 1)
+	}
+
+	return y
+}
+
+// Exp2 returns 2^{exponent}.
+// The integer part of the exponent is applied as a bit shift, and the
+// fractional part is computed with the Taylor series of e^{f * ln(2)}.
+// Negative exponents are computed as 1 / 2^{-exponent}.
+func Exp2(exponent BigDec) BigDec {
+	if exponent.IsNegative() {
+		return OneDec().Quo(Exp2(exponent.Neg()))
+	}
+
+	integer := exponent.TruncateInt()
+	fractional := exponent.Sub(exponent.TruncateDec())
+
+	// e^{f * ln(2)} = sum_k (f * ln(2))^k / k!
+	x := fractional.Mul(ln2)
+	result := OneDec()
+	term := OneDec()
+	for k := int64(1); ; k++ {
+		term = term.Mul(x).QuoInt64(k)
+		if term.IsZero() {
+			break
+		}
+		result = result.Add(term)
+	}
+
+	result.i.Lsh(result.i, uint(integer.Uint64()))
+	return result
+}
+
+// BigDecFromSDKDec returns the sdk.Dec as a BigDec.
+// Both types use the same precision, so the conversion is exact.
+func BigDecFromSDKDec(d sdk.Dec) BigDec {
+	return BigDec{new(big.Int).Set(d.BigInt())}
+}
+
+// SDKDec returns the BigDec as an sdk.Dec.
+// Panics if the value is too large for an sdk.Dec.
+func (d BigDec) SDKDec() sdk.Dec {
+	return sdk.NewDecFromBigIntWithPrec(new(big.Int).Set(d.i), Precision)
+}
+
 // is integer, e.g. decimals are zero
 func (d BigDec) IsInteger() bool {
 	return new(big.Int).Rem(d.i, precisionReuse).Sign() == 0
@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"gopkg.in/yaml.v2"
@@ -448,6 +449,78 @@ func (s *decimalTestSuite) TestApproxSqrt() {
 	}
 }
 
+func (s *decimalTestSuite) TestLogBase2() {
+	tolerance := NewDecWithPrec(1, 16)
+	testCases := []struct {
+		input    BigDec
+		expected BigDec
+	}{
+		{OneDec(), ZeroDec()},                                                          // log2(1) => 0
+		{NewDecFromInt(NewInt(8)), NewDecFromInt(NewInt(3))},                           // log2(8) => 3
+		{NewDecWithPrec(125, 3), NewDecFromInt(NewInt(-3))},                            // log2(0.125) => -3
+		{NewDecFromInt(NewInt(3)), MustNewDecFromStr("1.584962500721156181")},          // log2(3) ≈ 1.584962500721156181
+		{NewDecWithPrec(15, 1), MustNewDecFromStr("0.584962500721156181")},             // log2(1.5) ≈ 0.584962500721156181
+		{NewDecWithPrec(1, 1), MustNewDecFromStr("-3.321928094887362348")},             // log2(0.1) ≈ -3.321928094887362348
+		{SmallestDec(), MustNewDecFromStr("-59.794705707972522262")},                   // log2(1e-18) ≈ -59.794705707972522262
+		{NewDecFromInt(NewInt(1_000_000)), MustNewDecFromStr("19.931568569324174087")}, // log2(1e6) ≈ 19.931568569324174087
+	}
+
+	for i, tc := range testCases {
+		res := tc.input.LogBase2()
+		s.Require().True(tc.expected.Sub(res).Abs().LTE(tolerance), "unexpected result for test case %d, input: %v, got: %v", i, tc.input, res)
+	}
+
+	s.Require().Panics(func() { ZeroDec().LogBase2() })
+	s.Require().Panics(func() { NewDecFromInt(NewInt(-1)).LogBase2() })
+}
+
+func (s *decimalTestSuite) TestExp2() {
+	tolerance := NewDecWithPrec(1, 16)
+	testCases := []struct {
+		exponent BigDec
+		expected BigDec
+	}{
+		{ZeroDec(), OneDec()}, // 2^0 => 1
+		{NewDecFromInt(NewInt(10)), NewDecFromInt(NewInt(1024))},                       // 2^10 => 1024
+		{NewDecFromInt(NewInt(-1)), NewDecWithPrec(5, 1)},                              // 2^-1 => 0.5
+		{NewDecWithPrec(5, 1), NewDecWithPrec(1414213562373095049, 18)},                // 2^0.5 ≈ 1.414213562373095049
+		{MustNewDecFromStr("1.584962500721156181"), NewDecFromInt(NewInt(3))},          // 2^log2(3) ≈ 3
+		{MustNewDecFromStr("-3.321928094887362348"), NewDecWithPrec(1, 1)},             // 2^log2(0.1) ≈ 0.1
+		{MustNewDecFromStr("19.931568569324174087"), NewDecFromInt(NewInt(1_000_000))}, // 2^log2(1e6) ≈ 1e6, with relative error
+	}
+
+	for i, tc := range testCases {
+		res := Exp2(tc.exponent)
+		relErr := tc.expected.Sub(res).Abs().Quo(tc.expected)
+		s.Require().True(relErr.LTE(tolerance), "unexpected result for test case %d, exponent: %v, got: %v", i, tc.exponent, res)
+	}
+}
+
+func (s *decimalTestSuite) TestLogBase2Exp2RoundTrip() {
+	tolerance := NewDecWithPrec(1, 15)
+	inputs := []BigDec{
+		NewDecWithPrec(1, 6),
+		NewDecWithPrec(3, 1),
+		NewDecWithPrec(123456789, 6),
+		NewDecFromInt(NewInt(7)),
+		MustNewDecFromStr("987654321.123456789"),
+	}
+	for _, input := range inputs {
+		res := Exp2(input.LogBase2())
+		relErr := input.Sub(res).Abs().Quo(input)
+		s.Require().True(relErr.LTE(tolerance), "round trip of %v gave %v", input, res)
+	}
+}
+
+func (s *decimalTestSuite) TestSDKDecConversion() {
+	for _, str := range []string{"0", "1", "-2.5", "0.000000000000000001", "123456789.987654321"} {
+		sdkDec := sdk.MustNewDecFromStr(str)
+		bigDec := BigDecFromSDKDec(sdkDec)
+		s.Require().Equal(MustNewDecFromStr(str), bigDec)
+		s.Require().Equal(sdkDec, bigDec.SDKDec())
+	}
+}
+
 func (s *decimalTestSuite) TestDecSortableBytes() {
 	tests := []struct {
 		d    BigDec
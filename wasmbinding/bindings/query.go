@@ -2,6 +2,7 @@ package bindings
 
 import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // OsmosisQuery contains osmosis custom queries.
@@ -19,6 +20,9 @@ type OsmosisQuery struct {
 	SpotPrice *SpotPrice `json:"spot_price,omitempty"`
 	/// Return current spot price swapping In for Out on given pool ID.
 	EstimateSwap *EstimateSwap `json:"estimate_swap,omitempty"`
+	/// Return the routes that swap an amount in for the most out, optionally split across several routes.
+	/// Its gas grows with the number of swaps it estimates, so it is bounded by the query's gas limit.
+	BestRoute *BestRoute `json:"best_route,omitempty"`
 	/// Returns the admin of a denom, if the denom is a Token Factory denom.
 	DenomAdmin *DenomAdmin `json:"denom_admin,omitempty"`
 }
@@ -60,6 +64,14 @@ func (e *EstimateSwap) ToSwapMsg() *SwapMsg {
 	}
 }
 
+type BestRoute struct {
+	DenomIn   string  `json:"denom_in"`
+	DenomOut  string  `json:"denom_out"`
+	AmountIn  sdk.Int `json:"amount_in"`
+	MaxHops   uint32  `json:"max_hops"`
+	MaxSplits uint32  `json:"max_splits"`
+}
+
 type FullDenomResponse struct {
 	Denom string `json:"denom"`
 }
@@ -81,3 +93,16 @@ type EstimatePriceResponse struct {
 	// If you query with SwapAmount::Output, this is SwapAmount::Input.
 	Amount SwapAmount `json:"swap_amount"`
 }
+
+type BestRouteResponse struct {
+	/// The routes to swap the amount in through, and the part of it each one gets.
+	Routes []SplitRoute `json:"routes"`
+	/// The amount out all the routes are estimated to get
+	AmountOut sdk.Int `json:"amount_out"`
+}
+
+type SplitRoute struct {
+	Route     []Step  `json:"route"`
+	AmountIn  sdk.Int `json:"amount_in"`
+	AmountOut sdk.Int `json:"amount_out"`
+}
@@ -99,3 +99,38 @@ func (qp QueryPlugin) EstimateSwap(ctx sdk.Context, estimateSwap *bindings.Estim
 	estimate, err := PerformSwap(qp.gammKeeper, ctx, senderAddr, estimateSwap.ToSwapMsg())
 	return estimate, err
 }
+
+func (qp QueryPlugin) BestRoute(ctx sdk.Context, bestRoute *bindings.BestRoute) (*bindings.BestRouteResponse, error) {
+	if bestRoute == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm best route null"}
+	}
+	if err := sdk.ValidateDenom(bestRoute.DenomIn); err != nil {
+		return nil, sdkerrors.Wrap(err, "gamm best route denom in")
+	}
+	if bestRoute.AmountIn.IsNil() {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm best route empty amount in"}
+	}
+
+	tokenIn := sdk.Coin{Denom: bestRoute.DenomIn, Amount: bestRoute.AmountIn}
+	splitRoutes, tokenOutAmount, err := qp.gammKeeper.BestRoute(ctx, tokenIn, bestRoute.DenomOut, int(bestRoute.MaxHops), int(bestRoute.MaxSplits))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "gamm best route")
+	}
+
+	res := &bindings.BestRouteResponse{
+		Routes:    make([]bindings.SplitRoute, len(splitRoutes)),
+		AmountOut: tokenOutAmount,
+	}
+	for i, splitRoute := range splitRoutes {
+		route := make([]bindings.Step, len(splitRoute.Routes))
+		for j, step := range splitRoute.Routes {
+			route[j] = bindings.Step{PoolId: step.PoolId, DenomOut: step.TokenOutDenom}
+		}
+		res.Routes[i] = bindings.SplitRoute{
+			Route:     route,
+			AmountIn:  splitRoute.TokenInAmount,
+			AmountOut: splitRoute.TokenOutAmount,
+		}
+	}
+	return res, nil
+}
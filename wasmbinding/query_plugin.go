@@ -103,6 +103,19 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.BestRoute != nil:
+			res, err := qp.BestRoute(ctx, contractQuery.BestRoute)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo best route query")
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo best route query response")
+			}
+
+			return bz, nil
+
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown osmosis query variant"}
 		}
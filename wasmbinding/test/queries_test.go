@@ -539,3 +539,91 @@ func TestEstimateSwap(t *testing.T) {
 		})
 	}
 }
+
+func TestBestRoute(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	// 20 star to 1 osmo
+	starPool := preparePool(t, ctx, osmosis, actor, []sdk.Coin{
+		sdk.NewInt64Coin("uosmo", 12000000),
+		sdk.NewInt64Coin("ustar", 240000000),
+	})
+	// 2 atom to 1 osmo
+	atomPool := preparePool(t, ctx, osmosis, actor, []sdk.Coin{
+		sdk.NewInt64Coin("uosmo", 6000000),
+		sdk.NewInt64Coin("uatom", 12000000),
+	})
+
+	amountIn := sdk.NewInt(10000)
+
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TokenFactoryKeeper)
+
+	specs := map[string]struct {
+		bestRoute *bindings.BestRoute
+		expRoute  []bindings.Step
+		expErr    bool
+	}{
+		"route through osmo": {
+			bestRoute: &bindings.BestRoute{
+				DenomIn:  "ustar",
+				DenomOut: "uatom",
+				AmountIn: amountIn,
+			},
+			expRoute: []bindings.Step{
+				{PoolId: starPool, DenomOut: "uosmo"},
+				{PoolId: atomPool, DenomOut: "uatom"},
+			},
+		},
+		"one hop": {
+			bestRoute: &bindings.BestRoute{
+				DenomIn:  "ustar",
+				DenomOut: "uatom",
+				AmountIn: amountIn,
+				MaxHops:  1,
+			},
+			expErr: true,
+		},
+		"invalid denom in": {
+			bestRoute: &bindings.BestRoute{
+				DenomIn:  "invalid",
+				DenomOut: "uatom",
+				AmountIn: amountIn,
+			},
+			expErr: true,
+		},
+		"empty amount in": {
+			bestRoute: &bindings.BestRoute{
+				DenomIn:  "ustar",
+				DenomOut: "uatom",
+			},
+			expErr: true,
+		},
+		"negative amount in": {
+			bestRoute: &bindings.BestRoute{
+				DenomIn:  "ustar",
+				DenomOut: "uatom",
+				AmountIn: amountIn.Neg(),
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotRes, gotErr := queryPlugin.BestRoute(ctx, spec.bestRoute)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Len(t, gotRes.Routes, 1)
+			assert.Equal(t, spec.expRoute, gotRes.Routes[0].Route)
+			assert.Equal(t, amountIn, gotRes.Routes[0].AmountIn)
+			assert.True(t, gotRes.AmountOut.IsPositive())
+		})
+	}
+}
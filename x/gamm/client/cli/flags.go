@@ -21,6 +21,8 @@ const (
 	PoolFileTargetPoolWeights        = "target-pool-weights"
 
 	FlagPoolId = "pool-id"
+	// Will be parsed to time.Time, in RFC3339.
+	FlagStartTime = "start-time"
 	// Will be parsed to sdk.Int.
 	FlagShareAmountOut = "share-amount-out"
 	// Will be parsed to []sdk.Coin.
@@ -37,6 +39,18 @@ const (
 	FlagSwapRouteAmounts = "swap-route-amounts"
 	// Will be parsed to []string.
 	FlagSwapRouteDenoms = "swap-route-denoms"
+
+	// Will be parsed to bool.
+	FlagPauseSwaps = "pause-swaps"
+	// Will be parsed to bool.
+	FlagPauseJoins = "pause-joins"
+	// Will be parsed to bool.
+	FlagPauseExits = "pause-exits"
+
+	// Will be parsed to uint32.
+	FlagMaxHops = "max-hops"
+	// Will be parsed to uint32.
+	FlagMaxSplits = "max-splits"
 )
 
 type createPoolInputs struct {
@@ -48,6 +62,12 @@ type createPoolInputs struct {
 	SmoothWeightChangeParams smoothWeightChangeParamsInputs `json:"lbp-params"`
 }
 
+type updatePoolParamsInputs struct {
+	SwapFee                  string                         `json:"swap-fee"`
+	ExitFee                  string                         `json:"exit-fee"`
+	SmoothWeightChangeParams smoothWeightChangeParamsInputs `json:"lbp-params"`
+}
+
 type smoothWeightChangeParamsInputs struct {
 	StartTime         string `json:"start-time"`
 	Duration          string `json:"duration"`
@@ -77,6 +97,37 @@ func FlagSetCreatePool() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetUpdatePoolParams() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagPoolFile, "", "Pool params json file path")
+	return fs
+}
+
+func FlagSetScheduleWeightChange() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagStartTime, "", "RFC3339 start time of the weight change (defaults to the block time)")
+	return fs
+}
+
+func FlagSetPoolPause() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Bool(FlagPauseSwaps, false, "Pause swaps on the pool")
+	fs.Bool(FlagPauseJoins, false, "Pause joins to the pool")
+	fs.Bool(FlagPauseExits, false, "Pause exits from the pool")
+	return fs
+}
+
+func FlagSetBestRoute() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint32(FlagMaxHops, 0, "Largest number of pools in a route (defaults to 3)")
+	fs.Uint32(FlagMaxSplits, 0, "Largest number of routes to split the tokens in across (0 or 1 doesn't split them)")
+	return fs
+}
+
 func FlagSetJoinPool() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -51,3 +51,46 @@ func parseCreatePoolFlags(fs *pflag.FlagSet) (*createPoolInputs, error) {
 
 	return pool, nil
 }
+
+type XUpdatePoolParamsInputs updatePoolParamsInputs
+
+type XUpdatePoolParamsInputsExceptions struct {
+	XUpdatePoolParamsInputs
+	Other *string // Other won't raise an error
+}
+
+// UnmarshalJSON should error if there are fields unexpected.
+func (release *updatePoolParamsInputs) UnmarshalJSON(data []byte) error {
+	var updatePoolParamsE XUpdatePoolParamsInputsExceptions
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields() // Force
+
+	if err := dec.Decode(&updatePoolParamsE); err != nil {
+		return err
+	}
+
+	*release = updatePoolParamsInputs(updatePoolParamsE.XUpdatePoolParamsInputs)
+	return nil
+}
+
+func parseUpdatePoolParamsFlags(fs *pflag.FlagSet) (*updatePoolParamsInputs, error) {
+	params := &updatePoolParamsInputs{}
+	poolFile, _ := fs.GetString(FlagPoolFile)
+
+	if poolFile == "" {
+		return nil, fmt.Errorf("must pass in a pool params json using the --%s flag", FlagPoolFile)
+	}
+
+	contents, err := ioutil.ReadFile(poolFile)
+	if err != nil {
+		return nil, err
+	}
+
+	// make exception if unknown field exists
+	err = params.UnmarshalJSON(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
@@ -32,11 +32,17 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdPools(),
 		GetCmdNumPools(),
 		GetCmdPoolParams(),
+		GetCmdPoolGovernor(),
+		GetCmdTakerFee(),
+		GetCmdPoolPause(),
+		GetCmdPausedPools(),
+		GetCmdSmoothWeightChange(),
 		GetCmdTotalShares(),
 		GetCmdSpotPrice(),
 		GetCmdQueryTotalLiquidity(),
 		GetCmdEstimateSwapExactAmountIn(),
 		GetCmdEstimateSwapExactAmountOut(),
+		GetCmdBestRoute(),
 	)
 
 	return cmd
@@ -83,6 +89,205 @@ $ %s query gamm pool 1
 	return cmd
 }
 
+// GetCmdPoolGovernor returns the future governor of a pool and the votes for new pool params.
+func GetCmdPoolGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-governor <poolID>",
+		Short: "Query the future governor of a pool and the votes for new pool params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the future governor of a pool and the votes for new pool params.
+Example:
+$ %s query gamm pool-governor 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			poolID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolGovernor(cmd.Context(), &types.QueryPoolGovernorRequest{
+				PoolId: uint64(poolID),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSmoothWeightChange returns the smooth weight change schedule of a pool and its progress.
+func GetCmdSmoothWeightChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "smooth-weight-change <poolID>",
+		Short: "Query the smooth weight change schedule of a pool and its progress",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the smooth weight change schedule of a pool, its current weights and the elapsed fraction of the schedule.
+Example:
+$ %s query gamm smooth-weight-change 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			poolID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SmoothWeightChange(cmd.Context(), &types.QuerySmoothWeightChangeRequest{
+				PoolId: uint64(poolID),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdTakerFee returns the taker fee of a pool, its recipient and the taker fees collected.
+func GetCmdTakerFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "taker-fee <poolID>",
+		Short: "Query the taker fee of a pool, its recipient and the taker fees collected",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fraction of the swap fee taken from the swaps of a pool, where it is sent, and the taker fees collected.
+Example:
+$ %s query gamm taker-fee 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			poolID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TakerFee(cmd.Context(), &types.QueryTakerFeeRequest{
+				PoolId: uint64(poolID),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPoolPause returns the actions that are paused on a pool.
+func GetCmdPoolPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-pause <poolID>",
+		Short: "Query the actions that are paused on a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether swaps, joins and exits of a pool are paused, and who paused them.
+Example:
+$ %s query gamm pool-pause 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			poolID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolPause(cmd.Context(), &types.QueryPoolPauseRequest{
+				PoolId: uint64(poolID),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPausedPools returns the pauses of all pools with paused actions.
+func GetCmdPausedPools() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paused-pools",
+		Short: "Query the pauses of all pools with paused actions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pauses of all pools with paused actions.
+Example:
+$ %s query gamm paused-pools
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PausedPools(cmd.Context(), &types.QueryPausedPoolsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // TODO: Push this to the SDK.
 func writeOutputBoilerplate(ctx client.Context, out []byte) error {
 	writer := ctx.Output
@@ -503,3 +708,54 @@ $ %s query gamm estimate-swap-exact-amount-out 1 osm11vmx8jtggpd9u7qr0t8vxclycz8
 
 	return cmd
 }
+
+// GetCmdBestRoute returns the best routes to swap token in for the most tokens out.
+func GetCmdBestRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "best-route <tokenIn> <tokenOutDenom>",
+		Short: "Query the best routes to swap tokens in for the most tokens out",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the best routes to swap tokens in for the most tokens out, optionally split across several routes.
+Example:
+$ %s query gamm best-route 1000000uosmo uion --max-hops=2 --max-splits=3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			maxHops, err := cmd.Flags().GetUint32(FlagMaxHops)
+			if err != nil {
+				return err
+			}
+
+			maxSplits, err := cmd.Flags().GetUint32(FlagMaxSplits)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BestRoute(cmd.Context(), &types.QueryBestRouteRequest{
+				TokenIn:       args[0],
+				TokenOutDenom: args[1],
+				MaxHops:       maxHops,
+				MaxSplits:     maxSplits,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetBestRoute())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func NewTxCmd() *cobra.Command {
@@ -37,6 +39,10 @@ func NewTxCmd() *cobra.Command {
 		NewJoinSwapShareAmountOut(),
 		NewExitSwapExternAmountOut(),
 		NewExitSwapShareAmountIn(),
+		NewUpdatePoolParamsCmd(),
+		NewScheduleWeightChangeCmd(),
+		NewSetTakerFeeRecipientCmd(),
+		NewSetPoolPauseCmd(),
 	)
 
 	return txCmd
@@ -82,6 +88,272 @@ func NewCreatePoolCmd() *cobra.Command {
 	return cmd
 }
 
+func NewUpdatePoolParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-pool-params [pool-id] [flags]",
+		Short: "update the params of a balancer pool, or vote for them with locked lptoken",
+		Long: `Must provide path to a pool params JSON file (--pool-file) describing the new params.
+If the pool's future governor is an address, it must be the sender.
+If it is a lock duration, the sender votes for the params with its lptoken locked for at least that duration,
+and the params are updated once more than half of all such locked lptoken voted for them.`,
+		Example: `Sample pool params JSON file contents:
+{
+	"swap-fee": "0.01",
+	"exit-fee": "0.01",
+	"lbp-params": {
+		"duration": "72h",
+		"target-pool-weights": "1uatom,1osmo"
+	}
+}
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildUpdatePoolParamsMsg(clientCtx, txf, cmd.Flags(), poolId)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetUpdatePoolParams())
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagPoolFile)
+
+	return cmd
+}
+
+func NewScheduleWeightChangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-weight-change [pool-id] [target-pool-weights] [duration] [flags]",
+		Short: "schedule a smooth weight change of a balancer pool from its current weights, or vote for it with locked lptoken",
+		Long: `Schedule a smooth weight change of a balancer pool from its current weights to the target weights over the duration,
+keeping its fees and replacing any weight change in progress. It is governed like update-pool-params.`,
+		Example: `osmosisd tx gamm schedule-weight-change 1 1uatom,3uosmo 72h --start-time 2022-08-01T00:00:00Z`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			targetPoolAssetCoins, err := sdk.ParseDecCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[2])
+			if err != nil {
+				return fmt.Errorf("could not parse duration: %w", err)
+			}
+
+			params := balancer.SmoothWeightChangeParams{
+				Duration: duration,
+			}
+			for _, coin := range targetPoolAssetCoins {
+				params.TargetPoolWeights = append(params.TargetPoolWeights, balancer.PoolAsset{
+					Weight: coin.Amount.RoundInt(),
+					Token:  sdk.NewCoin(coin.Denom, sdk.ZeroInt()),
+				})
+			}
+
+			startTimeStr, err := cmd.Flags().GetString(FlagStartTime)
+			if err != nil {
+				return err
+			}
+			if startTimeStr != "" {
+				params.StartTime, err = time.Parse(time.RFC3339, startTimeStr)
+				if err != nil {
+					return fmt.Errorf("could not parse time: %w", err)
+				}
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := balancer.NewMsgScheduleWeightChange(clientCtx.GetFromAddress(), poolId, params)
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, &msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetScheduleWeightChange())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetTakerFeeRecipientCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-taker-fee-recipient [pool-id] [recipient]",
+		Short: "set the address that the taker fees of a balancer pool are sent to",
+		Long: `Set the address that the taker fees of a balancer pool are sent to, as its future governor, which must be an address.
+Without a recipient, the taker fees are sent to the community pool.`,
+		Example: `osmosisd tx gamm set-taker-fee-recipient 1 osmo1fqlr98d45v5ysqgp6h56kpujcj4cvsjnjq9nck`,
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var recipient sdk.AccAddress
+			if len(args) == 2 {
+				recipient, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := balancer.NewMsgSetTakerFeeRecipient(clientCtx.GetFromAddress(), poolId, recipient)
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetPoolPauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pool-pause [pool-id] [flags]",
+		Short: "pause or unpause swaps, joins and exits of a balancer pool",
+		Long: `Pause or unpause swaps, joins and exits of a balancer pool, as its future governor, which must be an address.
+Actions without a flag are unpaused. A pause set by governance can't be changed.`,
+		Example: `osmosisd tx gamm set-pool-pause 1 --pause-swaps --pause-joins`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			swapsPaused, joinsPaused, exitsPaused, err := parsePoolPauseFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := balancer.NewMsgSetPoolPause(clientCtx.GetFromAddress(), poolId, swapsPaused, joinsPaused, exitsPaused)
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, &msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetPoolPause())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitSetPoolPauseProposal implements a command handler for submitting a pool pause proposal transaction.
+func NewCmdSubmitSetPoolPauseProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-pool-pause-proposal [pool-id] [flags]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to pause or unpause swaps, joins and exits of a pool",
+		Long:    "Submit a proposal to pause or unpause swaps, joins and exits of a pool. Actions without a flag are unpaused.",
+		Example: `osmosisd tx gov submit-proposal set-pool-pause-proposal 1 --pause-swaps --pause-joins --title "Pause pool 1" --description "..." --deposit 10000000uosmo`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			swapsPaused, joinsPaused, exitsPaused, err := parsePoolPauseFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetPoolPauseProposal(title, description, poolId, swapsPaused, joinsPaused, exitsPaused)
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().AddFlagSet(FlagSetPoolPause())
+
+	return cmd
+}
+
+func parsePoolPauseFlags(fs *flag.FlagSet) (swapsPaused, joinsPaused, exitsPaused bool, err error) {
+	if swapsPaused, err = fs.GetBool(FlagPauseSwaps); err != nil {
+		return false, false, false, err
+	}
+	if joinsPaused, err = fs.GetBool(FlagPauseJoins); err != nil {
+		return false, false, false, err
+	}
+	if exitsPaused, err = fs.GetBool(FlagPauseExits); err != nil {
+		return false, false, false, err
+	}
+	return swapsPaused, joinsPaused, exitsPaused, nil
+}
+
 func NewJoinPoolCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "join-pool",
@@ -421,6 +693,68 @@ func NewBuildCreateBalancerPoolMsg(clientCtx client.Context, txf tx.Factory, fs
 	return txf, msg, nil
 }
 
+func NewBuildUpdatePoolParamsMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet, poolId uint64) (tx.Factory, sdk.Msg, error) {
+	params, err := parseUpdatePoolParamsFlags(fs)
+	if err != nil {
+		return txf, nil, fmt.Errorf("failed to parse pool params: %w", err)
+	}
+
+	swapFee, err := sdk.NewDecFromStr(params.SwapFee)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	exitFee, err := sdk.NewDecFromStr(params.ExitFee)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	poolParams := balancer.PoolParams{
+		SwapFee: swapFee,
+		ExitFee: exitFee,
+	}
+
+	if (params.SmoothWeightChangeParams != smoothWeightChangeParamsInputs{}) {
+		duration, err := time.ParseDuration(params.SmoothWeightChangeParams.Duration)
+		if err != nil {
+			return txf, nil, fmt.Errorf("could not parse duration: %w", err)
+		}
+
+		targetPoolAssetCoins, err := sdk.ParseDecCoins(params.SmoothWeightChangeParams.TargetPoolWeights)
+		if err != nil {
+			return txf, nil, err
+		}
+
+		var targetPoolAssets []balancer.PoolAsset
+		for _, coin := range targetPoolAssetCoins {
+			// The initial weights are set from the pool's current weights in the keeper.
+			targetPoolAssets = append(targetPoolAssets, balancer.PoolAsset{
+				Weight: coin.Amount.RoundInt(),
+				Token:  sdk.NewCoin(coin.Denom, sdk.ZeroInt()),
+			})
+		}
+
+		smoothWeightParams := balancer.SmoothWeightChangeParams{
+			Duration:          duration,
+			TargetPoolWeights: targetPoolAssets,
+		}
+
+		if params.SmoothWeightChangeParams.StartTime != "" {
+			startTime, err := time.Parse(time.RFC3339, params.SmoothWeightChangeParams.StartTime)
+			if err != nil {
+				return txf, nil, fmt.Errorf("could not parse time: %w", err)
+			}
+
+			smoothWeightParams.StartTime = startTime
+		}
+
+		poolParams.SmoothWeightChangeParams = &smoothWeightParams
+	}
+
+	msg := balancer.NewMsgUpdatePoolParams(clientCtx.GetFromAddress(), poolId, poolParams)
+	return txf, &msg, nil
+}
+
 func NewBuildJoinPoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
 	poolId, err := fs.GetUint64(FlagPoolId)
 	if err != nil {
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// The circuit breaker pauses the swaps and joins of a pool once the spot price of any of its asset pairs
+// moves by more than the CircuitBreakerMaxPriceChange param within CircuitBreakerWindow blocks,
+// for the following CircuitBreakerWindow blocks.
+// For each pair, it records the spot price before the first change of the pair's reserves in a window,
+// and compares the spot price after every change in the window with it.
+// Exits into all of the pool's assets stay open, so that LPs can still withdraw from a paused pool,
+// while single asset exits swap against the pool, and are paused with its swaps.
+// The spot price is checked after a trade, so the trade that trips the circuit breaker executes in full,
+// and only the trades after it are paused: rejecting it would revert the pause along with it.
+// This lets a single trade on a thin pool pause it for everyone, at the cost of its price impact and swap fee.
+
+// circuitBreakerPairs returns the sorted asset pairs of pool that any of denoms is in.
+func circuitBreakerPairs(ctx sdk.Context, pool types.PoolI, denoms []string) [][2]string {
+	pairs := [][2]string{}
+	seen := map[[2]string]bool{}
+	for _, denom := range denoms {
+		for _, asset := range pool.GetTotalPoolLiquidity(ctx) {
+			if asset.Denom == denom {
+				continue
+			}
+			pair := [2]string{denom, asset.Denom}
+			sort.Strings(pair[:])
+			if !seen[pair] {
+				seen[pair] = true
+				pairs = append(pairs, pair)
+			}
+		}
+	}
+	return pairs
+}
+
+// recordCircuitBreakerReferences records the spot prices of the asset pairs of pool that any of denoms is in,
+// for the pairs that have no reference within the circuit breaker window.
+// It must be called before the reserves of denoms in pool change.
+func (k Keeper) recordCircuitBreakerReferences(ctx sdk.Context, pool types.PoolI, denoms ...string) error {
+	params := k.GetParams(ctx)
+	if params.CircuitBreakerMaxPriceChange.IsZero() {
+		return nil
+	}
+
+	for _, pair := range circuitBreakerPairs(ctx, pool, denoms) {
+		reference, found := k.getCircuitBreakerReference(ctx, pool.GetId(), pair[0], pair[1])
+		if found && ctx.BlockHeight()-reference.Height < int64(params.CircuitBreakerWindow) {
+			continue
+		}
+
+		spotPrice, err := pool.SpotPrice(ctx, pair[0], pair[1])
+		if err != nil {
+			return err
+		}
+		k.setCircuitBreakerReference(ctx, types.CircuitBreakerReference{
+			PoolId:     pool.GetId(),
+			BaseAsset:  pair[0],
+			QuoteAsset: pair[1],
+			Height:     ctx.BlockHeight(),
+			SpotPrice:  spotPrice,
+		})
+	}
+	return nil
+}
+
+// checkCircuitBreaker pauses the swaps and joins of pool if the spot price of any of its asset pairs
+// that any of denoms is in moved by more than the CircuitBreakerMaxPriceChange param from its reference.
+// It must be called after the reserves of denoms in pool changed.
+func (k Keeper) checkCircuitBreaker(ctx sdk.Context, pool types.PoolI, denoms ...string) error {
+	maxPriceChange := k.GetParams(ctx).CircuitBreakerMaxPriceChange
+	if maxPriceChange.IsZero() {
+		return nil
+	}
+
+	for _, pair := range circuitBreakerPairs(ctx, pool, denoms) {
+		reference, found := k.getCircuitBreakerReference(ctx, pool.GetId(), pair[0], pair[1])
+		if !found || !reference.SpotPrice.IsPositive() {
+			continue
+		}
+
+		spotPrice, err := pool.SpotPrice(ctx, pair[0], pair[1])
+		if err != nil {
+			return err
+		}
+		priceChange := spotPrice.Sub(reference.SpotPrice).Abs().Quo(reference.SpotPrice)
+		if priceChange.GT(maxPriceChange) {
+			k.tripCircuitBreaker(ctx, reference, spotPrice)
+			return nil
+		}
+	}
+	return nil
+}
+
+// tripCircuitBreaker pauses the swaps and joins of the pool of reference for CircuitBreakerWindow blocks,
+// as its spot price moved to spotPrice.
+func (k Keeper) tripCircuitBreaker(ctx sdk.Context, reference types.CircuitBreakerReference, spotPrice sdk.Dec) {
+	pause := k.GetPoolPause(ctx, reference.PoolId)
+	// A pause by governance or the pool's governor stays one, and lasts until they lift it,
+	// so that the pool's governor can't lift what governance paused.
+	if !pause.IsPaused() || pause.Source == types.PauseSourceCircuitBreaker {
+		pause.Source = types.PauseSourceCircuitBreaker
+		pause.PausedUntilHeight = ctx.BlockHeight() + int64(k.GetParams(ctx).CircuitBreakerWindow)
+	}
+	pause.SwapsPaused = true
+	pause.JoinsPaused = true
+	pause.Height = ctx.BlockHeight()
+	k.setPoolPause(ctx, pause)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtCircuitBreakerTripped,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(reference.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyBaseAsset, reference.BaseAsset),
+		sdk.NewAttribute(types.AttributeKeyQuoteAsset, reference.QuoteAsset),
+		sdk.NewAttribute(types.AttributeKeyReferenceSpotPrice, reference.SpotPrice.String()),
+		sdk.NewAttribute(types.AttributeKeySpotPrice, spotPrice.String()),
+	))
+}
+
+func (k Keeper) getCircuitBreakerReference(ctx sdk.Context, poolId uint64, baseAsset, quoteAsset string) (types.CircuitBreakerReference, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyCircuitBreakerReference(poolId, baseAsset, quoteAsset))
+	if bz == nil {
+		return types.CircuitBreakerReference{}, false
+	}
+
+	var reference types.CircuitBreakerReference
+	k.cdc.MustUnmarshal(bz, &reference)
+	return reference, true
+}
+
+func (k Keeper) setCircuitBreakerReference(ctx sdk.Context, reference types.CircuitBreakerReference) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetKeyCircuitBreakerReference(reference.PoolId, reference.BaseAsset, reference.QuoteAsset)
+	store.Set(key, k.cdc.MustMarshal(&reference))
+}
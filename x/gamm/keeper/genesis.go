@@ -34,6 +34,20 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 	}
 
 	k.SetTotalLiquidity(ctx, liquidity)
+
+	for _, recipient := range genState.TakerFeeRecipients {
+		addr, err := sdk.AccAddressFromBech32(recipient.Recipient)
+		if err != nil {
+			panic(err)
+		}
+		k.setTakerFeeRecipient(ctx, recipient.PoolId, addr)
+	}
+	for _, collected := range genState.TakerFeesCollected {
+		k.setTakerFeesCollected(ctx, collected)
+	}
+	for _, pause := range genState.PoolPauses {
+		k.setPoolPause(ctx, pause)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -54,5 +68,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		NextPoolNumber: k.GetNextPoolNumber(ctx),
 		Pools:          poolAnys,
 		Params:         k.GetParams(ctx),
+
+		TakerFeeRecipients: k.GetTakerFeeRecipients(ctx),
+		TakerFeesCollected: k.GetAllTakerFeesCollected(ctx),
+		PoolPauses:         k.GetPoolPauses(ctx),
 	}
 }
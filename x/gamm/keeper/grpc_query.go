@@ -153,6 +153,127 @@ func (q Querier) PoolParams(ctx context.Context, req *types.QueryPoolParamsReque
 	}
 }
 
+func (q Querier) PoolGovernor(ctx context.Context, req *types.QueryPoolGovernorRequest) (*types.QueryPoolGovernorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.Keeper.getBalancerPoolAndPoke(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryPoolGovernorResponse{
+		FuturePoolGovernor: pool.FuturePoolGovernor,
+		Votes:              []types.PoolParamsVote{},
+		TotalVotingPower:   sdk.ZeroInt(),
+	}
+	governor, err := types.ParseFutureGovernor(pool.FuturePoolGovernor, req.PoolId)
+	if err != nil || governor.Address != nil {
+		return res, nil
+	}
+
+	res.TotalVotingPower = q.Keeper.getTotalVotingPower(sdkCtx, governor)
+	for _, vote := range q.Keeper.GetPoolParamsVotes(sdkCtx, req.PoolId) {
+		vote := vote
+		any, err := codectypes.NewAnyWithValue(&vote.PoolParams)
+		if err != nil {
+			return nil, err
+		}
+		res.Votes = append(res.Votes, types.PoolParamsVote{
+			Voter:       vote.Voter.String(),
+			PoolParams:  any,
+			VotingPower: q.Keeper.getVotingPower(sdkCtx, governor, vote.Voter),
+		})
+	}
+	return res, nil
+}
+
+func (q Querier) TakerFee(ctx context.Context, req *types.QueryTakerFeeRequest) (*types.QueryTakerFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTakerFeeResponse{
+		TakerFee:      q.Keeper.GetTakerFee(sdkCtx, req.PoolId),
+		Recipient:     q.Keeper.GetTakerFeeRecipient(sdkCtx, req.PoolId).String(),
+		FeesCollected: q.Keeper.GetTakerFeesCollected(sdkCtx, req.PoolId),
+	}, nil
+}
+
+func (q Querier) PoolPause(ctx context.Context, req *types.QueryPoolPauseRequest) (*types.QueryPoolPauseResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolPauseResponse{
+		PoolPause: q.Keeper.GetPoolPause(sdkCtx, req.PoolId),
+	}, nil
+}
+
+func (q Querier) PausedPools(ctx context.Context, req *types.QueryPausedPoolsRequest) (*types.QueryPausedPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryPausedPoolsResponse{
+		PoolPauses: q.Keeper.GetPoolPauses(sdkCtx),
+	}, nil
+}
+
+func (q Querier) SmoothWeightChange(ctx context.Context, req *types.QuerySmoothWeightChangeRequest) (*types.QuerySmoothWeightChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.Keeper.getBalancerPoolAndPoke(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QuerySmoothWeightChangeResponse{
+		CurrentWeights: []types.PoolAssetWeight{},
+		Progress:       sdk.ZeroDec(),
+	}
+	for _, asset := range pool.GetAllPoolAssets() {
+		res.CurrentWeights = append(res.CurrentWeights, types.PoolAssetWeight{
+			Denom:  asset.Token.Denom,
+			Weight: asset.Weight,
+		})
+	}
+
+	params := pool.PoolParams.SmoothWeightChangeParams
+	if params == nil {
+		return res, nil
+	}
+	any, err := codectypes.NewAnyWithValue(params)
+	if err != nil {
+		return nil, err
+	}
+	res.SmoothWeightChangeParams = any
+	res.EndTime = params.EndTime()
+	res.Progress = params.Progress(sdkCtx.BlockTime())
+	return res, nil
+}
+
 func (q Querier) TotalPoolLiquidity(ctx context.Context, req *types.QueryTotalPoolLiquidityRequest) (*types.QueryTotalPoolLiquidityResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -304,3 +425,34 @@ func (q Querier) EstimateSwapExactAmountOut(ctx context.Context, req *types.Quer
 		TokenInAmount: tokenInAmount,
 	}, nil
 }
+
+func (q Querier) BestRoute(ctx context.Context, req *types.QueryBestRouteRequest) (*types.QueryBestRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	if req.TokenOutDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token out denom")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	splitRoutes, tokenOutAmount, err := q.Keeper.BestRoute(sdkCtx, tokenIn, req.TokenOutDenom, int(req.MaxHops), int(req.MaxSplits))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBestRouteResponse{
+		SplitRoutes:    splitRoutes,
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
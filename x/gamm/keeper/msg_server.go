@@ -43,6 +43,129 @@ func (server msgServer) CreateBalancerPool(goCtx context.Context, msg *balancer.
 	return &balancer.MsgCreateBalancerPoolResponse{PoolID: poolId}, err
 }
 
+func (server msgServer) UpdatePoolParams(goCtx context.Context, msg *balancer.MsgUpdatePoolParams) (*balancer.MsgUpdatePoolParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := server.keeper.UpdatePoolParams(ctx, sender, msg.PoolId, msg.PoolParams)
+	if err != nil {
+		return nil, err
+	}
+
+	evtType := types.TypeEvtPoolParamsVoted
+	if updated {
+		evtType = types.TypeEvtPoolParamsUpdated
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			evtType,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &balancer.MsgUpdatePoolParamsResponse{Updated: updated}, nil
+}
+
+func (server msgServer) ScheduleWeightChange(goCtx context.Context, msg *balancer.MsgScheduleWeightChange) (*balancer.MsgScheduleWeightChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduled, err := server.keeper.ScheduleWeightChange(ctx, sender, msg.PoolId, msg.SmoothWeightChangeParams)
+	if err != nil {
+		return nil, err
+	}
+
+	evtType := types.TypeEvtWeightChangeVoted
+	if scheduled {
+		evtType = types.TypeEvtWeightChangeScheduled
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			evtType,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &balancer.MsgScheduleWeightChangeResponse{Scheduled: scheduled}, nil
+}
+
+func (server msgServer) SetTakerFeeRecipient(goCtx context.Context, msg *balancer.MsgSetTakerFeeRecipient) (*balancer.MsgSetTakerFeeRecipientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	var recipient sdk.AccAddress
+	if msg.Recipient != "" {
+		recipient, err = sdk.AccAddressFromBech32(msg.Recipient)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := server.keeper.SetTakerFeeRecipient(ctx, sender, msg.PoolId, recipient); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTakerFeeRecipientSet,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &balancer.MsgSetTakerFeeRecipientResponse{}, nil
+}
+
+func (server msgServer) SetPoolPause(goCtx context.Context, msg *balancer.MsgSetPoolPause) (*balancer.MsgSetPoolPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.SetPoolPause(ctx, sender, msg.PoolId, msg.SwapsPaused, msg.JoinsPaused, msg.ExitsPaused); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &balancer.MsgSetPoolPauseResponse{}, nil
+}
+
 // func (server msgServer) CreateStableswapPool(goCtx context.Context, msg *stableswap.MsgCreateStableswapPool) (*stableswap.MsgCreateStableswapPoolResponse, error) {
 // 	poolId, err := server.CreatePool(goCtx, msg)
 // 	if err != nil {
@@ -54,13 +177,43 @@ func (server msgServer) CreateBalancerPool(goCtx context.Context, msg *balancer.
 // func (server msgServer) StableSwapAdjustScalingFactors(goCtx context.Context, msg *stableswap.MsgStableSwapAdjustScalingFactors) (*stableswap.MsgStableSwapAdjustScalingFactorsResponse, error) {
 // 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-// 	if err := server.keeper.SetStableSwapScalingFactors(ctx, msg.ScalingFactors, msg.PoolID, msg.ScalingFactorGovernor); err != nil {
+// 	if err := server.keeper.SetStableSwapScalingFactors(ctx, msg.ScalingFactors, msg.Duration, msg.PoolID, msg.Sender); err != nil {
 // 		return nil, err
 // 	}
 
 // 	return &stableswap.MsgStableSwapAdjustScalingFactorsResponse{}, nil
 // }
 
+// func (server msgServer) StableSwapRampAmplification(goCtx context.Context, msg *stableswap.MsgStableSwapRampAmplification) (*stableswap.MsgStableSwapRampAmplificationResponse, error) {
+// 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+// 	if err := server.keeper.SetStableSwapAmplificationRamp(ctx, msg.TargetAmplificationParameter, msg.Duration, msg.PoolID, msg.Sender); err != nil {
+// 		return nil, err
+// 	}
+
+// 	return &stableswap.MsgStableSwapRampAmplificationResponse{}, nil
+// }
+
+// func (server msgServer) StableSwapTransferScalingFactorGovernor(goCtx context.Context, msg *stableswap.MsgStableSwapTransferScalingFactorGovernor) (*stableswap.MsgStableSwapTransferScalingFactorGovernorResponse, error) {
+// 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+// 	if err := server.keeper.TransferStableSwapScalingFactorGovernor(ctx, msg.NewScalingFactorGovernor, msg.PoolID, msg.Sender); err != nil {
+// 		return nil, err
+// 	}
+
+// 	return &stableswap.MsgStableSwapTransferScalingFactorGovernorResponse{}, nil
+// }
+
+// func (server msgServer) StableSwapAcceptScalingFactorGovernor(goCtx context.Context, msg *stableswap.MsgStableSwapAcceptScalingFactorGovernor) (*stableswap.MsgStableSwapAcceptScalingFactorGovernorResponse, error) {
+// 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+// 	if err := server.keeper.AcceptStableSwapScalingFactorGovernor(ctx, msg.PoolID, msg.Sender); err != nil {
+// 		return nil, err
+// 	}
+
+// 	return &stableswap.MsgStableSwapAcceptScalingFactorGovernorResponse{}, nil
+// }
+
 func (server msgServer) CreatePool(goCtx context.Context, msg types.CreatePoolMsg) (poolId uint64, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
 
@@ -43,7 +44,7 @@ func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.PoolI, err
 	return pool, nil
 }
 
-// Get pool and check if the pool is active, i.e. allowed to be swapped against.
+// Get pool and check if the pool is active and its swaps aren't paused, i.e. allowed to be swapped against.
 func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
 	pool, err := k.GetPoolAndPoke(ctx, poolId)
 	if err != nil {
@@ -53,6 +54,9 @@ func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.PoolI, err
 	if !pool.IsActive(ctx) {
 		return &balancer.Pool{}, sdkerrors.Wrapf(types.ErrPoolLocked, "swap on inactive pool")
 	}
+	if err := k.checkSwapsNotPaused(ctx, poolId); err != nil {
+		return &balancer.Pool{}, err
+	}
 	return pool, nil
 }
 
@@ -232,30 +236,85 @@ func (k Keeper) getNextPoolNumberAndIncrement(ctx sdk.Context) uint64 {
 	return nextPoolId
 }
 
-// set ScalingFactors in stable stableswap pools
-func (k *Keeper) SetStableSwapScalingFactors(ctx sdk.Context, scalingFactors []uint64, poolId uint64, scalingFactorGovernor string) error {
+// getStableSwapPoolAsGovernor returns the poked stableswap pool with poolId,
+// if scalingFactorGovernor is its scaling factor governor.
+func (k *Keeper) getStableSwapPoolAsGovernor(ctx sdk.Context, poolId uint64, scalingFactorGovernor string) (*stableswap.Pool, error) {
 	poolI, err := k.GetPoolAndPoke(ctx, poolId)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	stableswapPool, ok := poolI.(*stableswap.Pool)
 	if !ok {
-		return types.ErrNotStableSwapPool
+		return nil, types.ErrNotStableSwapPool
 	}
 
 	if scalingFactorGovernor != stableswapPool.ScalingFactorGovernor {
-		return types.ErrNotScalingFactorGovernor
+		return nil, types.ErrNotScalingFactorGovernor
+	}
+	return stableswapPool, nil
+}
+
+// SetStableSwapScalingFactors ramps the scaling factors of the stableswap pool with poolId
+// from its current ones to scalingFactors over duration, starting at the current block time.
+// If duration is zero, the scaling factors change immediately.
+func (k *Keeper) SetStableSwapScalingFactors(ctx sdk.Context, scalingFactors []uint64, duration time.Duration, poolId uint64, scalingFactorGovernor string) error {
+	stableswapPool, err := k.getStableSwapPoolAsGovernor(ctx, poolId, scalingFactorGovernor)
+	if err != nil {
+		return err
 	}
 
-	if len(scalingFactors) != stableswapPool.PoolLiquidity.Len() {
-		return types.ErrInvalidStableswapScalingFactors
+	if err := stableswapPool.SetScalingFactors(scalingFactors, duration, ctx.BlockTime()); err != nil {
+		return err
 	}
+	return k.SetPool(ctx, stableswapPool)
+}
 
-	stableswapPool.ScalingFactor = scalingFactors
+// SetStableSwapAmplificationRamp ramps the amplification parameter of the stableswap pool with poolId
+// from its current one to targetAmplification over duration, starting at the current block time.
+func (k *Keeper) SetStableSwapAmplificationRamp(ctx sdk.Context, targetAmplification uint64, duration time.Duration, poolId uint64, scalingFactorGovernor string) error {
+	stableswapPool, err := k.getStableSwapPoolAsGovernor(ctx, poolId, scalingFactorGovernor)
+	if err != nil {
+		return err
+	}
 
-	if err = k.SetPool(ctx, stableswapPool); err != nil {
+	if err := stableswapPool.SetAmplificationRamp(targetAmplification, duration, ctx.BlockTime()); err != nil {
 		return err
 	}
-	return nil
+	return k.SetPool(ctx, stableswapPool)
+}
+
+// TransferStableSwapScalingFactorGovernor makes newScalingFactorGovernor the pending scaling factor governor
+// of the stableswap pool with poolId. It only becomes the scaling factor governor once it accepts the role,
+// and until then, scalingFactorGovernor remains it, and can transfer the role to another address instead.
+func (k *Keeper) TransferStableSwapScalingFactorGovernor(ctx sdk.Context, newScalingFactorGovernor string, poolId uint64, scalingFactorGovernor string) error {
+	stableswapPool, err := k.getStableSwapPoolAsGovernor(ctx, poolId, scalingFactorGovernor)
+	if err != nil {
+		return err
+	}
+
+	stableswapPool.PendingScalingFactorGovernor = newScalingFactorGovernor
+	return k.SetPool(ctx, stableswapPool)
+}
+
+// AcceptStableSwapScalingFactorGovernor makes the pending scaling factor governor of the stableswap pool
+// with poolId its scaling factor governor, if it is pendingScalingFactorGovernor.
+func (k *Keeper) AcceptStableSwapScalingFactorGovernor(ctx sdk.Context, poolId uint64, pendingScalingFactorGovernor string) error {
+	poolI, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	stableswapPool, ok := poolI.(*stableswap.Pool)
+	if !ok {
+		return types.ErrNotStableSwapPool
+	}
+
+	if stableswapPool.PendingScalingFactorGovernor == "" || pendingScalingFactorGovernor != stableswapPool.PendingScalingFactorGovernor {
+		return types.ErrNotPendingScalingFactorGovernor
+	}
+
+	stableswapPool.ScalingFactorGovernor = stableswapPool.PendingScalingFactorGovernor
+	stableswapPool.PendingScalingFactorGovernor = ""
+	return k.SetPool(ctx, stableswapPool)
 }
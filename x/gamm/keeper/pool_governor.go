@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+)
+
+// PoolParamsVote is a vote of a locked LP holder for new pool params.
+type PoolParamsVote struct {
+	Voter      sdk.AccAddress
+	PoolParams balancer.PoolParams
+}
+
+// UpdatePoolParams updates the params of the balancer pool with poolId on behalf of its future pool governor,
+// and returns whether they were updated.
+// If the governor is an address, sender must be it, and the params are updated.
+// Otherwise sender votes for the params with its governor lptoken locked for at least the governor's duration,
+// replacing its previous vote. The params are updated once the current voting power of the votes for them
+// is more than half of all such locked lptoken, which clears all votes of the pool.
+func (k Keeper) UpdatePoolParams(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, params balancer.PoolParams) (bool, error) {
+	pool, err := k.getBalancerPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return false, err
+	}
+
+	governor, err := types.ParseFutureGovernor(pool.FuturePoolGovernor, poolId)
+	if err != nil {
+		return false, err
+	}
+
+	if governor.Address != nil {
+		if !governor.Address.Equals(sender) {
+			return false, sdkerrors.Wrapf(types.ErrNotPoolGovernor, "%s is not the governor of pool %d", sender, poolId)
+		}
+		return true, k.setBalancerPoolParams(ctx, pool, params)
+	}
+
+	if !k.getVotingPower(ctx, governor, sender).IsPositive() {
+		return false, sdkerrors.Wrapf(types.ErrNoVotingPower, "%s has no %s locked for at least %s", sender, governor.LockDenom, governor.LockDuration)
+	}
+	// Validate the params before voting for them, so that invalid params can't collect votes.
+	if err := params.Validate(pool.PoolAssets); err != nil {
+		return false, err
+	}
+	k.setPoolParamsVote(ctx, poolId, PoolParamsVote{Voter: sender, PoolParams: params})
+
+	paramsBz := k.cdc.MustMarshal(&params)
+	votingPowerFor := sdk.ZeroInt()
+	for _, vote := range k.GetPoolParamsVotes(ctx, poolId) {
+		if bytes.Equal(k.cdc.MustMarshal(&vote.PoolParams), paramsBz) {
+			votingPowerFor = votingPowerFor.Add(k.getVotingPower(ctx, governor, vote.Voter))
+		}
+	}
+	totalVotingPower := k.getTotalVotingPower(ctx, governor)
+	if votingPowerFor.MulRaw(2).LTE(totalVotingPower) {
+		return false, nil
+	}
+
+	k.deletePoolParamsVotes(ctx, poolId)
+	return true, k.setBalancerPoolParams(ctx, pool, params)
+}
+
+// ScheduleWeightChange schedules a smooth weight change of the balancer pool with poolId from its current weights,
+// keeping its fees, and returns whether it was scheduled. It is governed like UpdatePoolParams,
+// so with a lock governor sender votes for the pool's current fees along with the weight change.
+func (k Keeper) ScheduleWeightChange(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, params balancer.SmoothWeightChangeParams) (bool, error) {
+	pool, err := k.getBalancerPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return false, err
+	}
+
+	poolParams := balancer.NewPoolParams(pool.PoolParams.SwapFee, pool.PoolParams.ExitFee, &params)
+	return k.UpdatePoolParams(ctx, sender, poolId, poolParams)
+}
+
+// GetPoolParamsVotes returns the votes of the locked LP holders of the pool with poolId for new pool params.
+func (k Keeper) GetPoolParamsVotes(ctx sdk.Context, poolId uint64) []PoolParamsVote {
+	prefix := types.GetKeyPrefixPoolParamsVotes(poolId)
+	iter := k.iterator(ctx, prefix)
+	defer iter.Close()
+
+	votes := []PoolParamsVote{}
+	for ; iter.Valid(); iter.Next() {
+		// The key is the length prefixed voter address after the prefix.
+		voter := sdk.AccAddress(iter.Key()[len(prefix)+1:])
+		var params balancer.PoolParams
+		k.cdc.MustUnmarshal(iter.Value(), &params)
+		votes = append(votes, PoolParamsVote{Voter: voter, PoolParams: params})
+	}
+	return votes
+}
+
+func (k Keeper) setPoolParamsVote(ctx sdk.Context, poolId uint64, vote PoolParamsVote) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyPoolParamsVote(poolId, vote.Voter), k.cdc.MustMarshal(&vote.PoolParams))
+}
+
+func (k Keeper) deletePoolParamsVotes(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := k.iterator(ctx, types.GetKeyPrefixPoolParamsVotes(poolId))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// getVotingPower returns the governor lptoken that voter has locked for at least the governor's duration.
+func (k Keeper) getVotingPower(ctx sdk.Context, governor types.FutureGovernor, voter sdk.AccAddress) sdk.Int {
+	votingPower := sdk.ZeroInt()
+	for _, lock := range k.lockupKeeper.GetAccountLockedLongerDurationDenom(ctx, voter, governor.LockDenom, governor.LockDuration) {
+		votingPower = votingPower.Add(lock.Coins.AmountOf(governor.LockDenom))
+	}
+	return votingPower
+}
+
+// getTotalVotingPower returns all governor lptoken locked for at least the governor's duration.
+func (k Keeper) getTotalVotingPower(ctx sdk.Context, governor types.FutureGovernor) sdk.Int {
+	return k.lockupKeeper.GetPeriodLocksAccumulation(ctx, lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         governor.LockDenom,
+		Duration:      governor.LockDuration,
+	})
+}
+
+func (k Keeper) getBalancerPoolAndPoke(ctx sdk.Context, poolId uint64) (*balancer.Pool, error) {
+	poolI, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	pool, ok := poolI.(*balancer.Pool)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrNotBalancerPool, "pool %d is a %T", poolId, poolI)
+	}
+	return pool, nil
+}
+
+// validateAddressGovernor returns an error unless the future pool governor of pool is the address sender.
+// Pools governed by their lockers can't take actions that need an address governor.
+func validateAddressGovernor(pool *balancer.Pool, sender sdk.AccAddress, action string) error {
+	governor, err := types.ParseFutureGovernor(pool.FuturePoolGovernor, pool.Id)
+	if err != nil {
+		return err
+	}
+	if governor.Address == nil {
+		return sdkerrors.Wrapf(types.ErrNotPoolGovernor, "pool %d is governed by its lockers, who can't %s", pool.Id, action)
+	}
+	if !governor.Address.Equals(sender) {
+		return sdkerrors.Wrapf(types.ErrNotPoolGovernor, "%s is not the governor of pool %d", sender, pool.Id)
+	}
+	return nil
+}
+
+func (k Keeper) setBalancerPoolParams(ctx sdk.Context, pool *balancer.Pool, params balancer.PoolParams) error {
+	if err := pool.UpdatePoolParams(params, ctx.BlockTime()); err != nil {
+		return err
+	}
+	return k.SetPool(ctx, pool)
+}
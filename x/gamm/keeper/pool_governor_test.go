@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) prepareGovernedBalancerPool(governor string) uint64 {
+	suite.FundAcc(suite.TestAccs[0], defaultAcctFunds)
+
+	poolId, err := suite.App.GAMMKeeper.CreatePool(
+		suite.Ctx,
+		balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], defaultPoolParams, defaultPoolAssets, governor),
+	)
+	suite.Require().NoError(err)
+	return poolId
+}
+
+func (suite *KeeperTestSuite) TestUpdatePoolParamsAddressGovernor() {
+	suite.SetupTest()
+	governor := suite.TestAccs[0]
+	poolId := suite.prepareGovernedBalancerPool(governor.String())
+
+	newParams := balancer.PoolParams{
+		SwapFee: sdk.NewDecWithPrec(3, 2),
+		ExitFee: sdk.ZeroDec(),
+	}
+
+	_, err := suite.App.GAMMKeeper.UpdatePoolParams(suite.Ctx, suite.TestAccs[1], poolId, newParams)
+	suite.Require().ErrorIs(err, types.ErrNotPoolGovernor)
+
+	updated, err := suite.App.GAMMKeeper.UpdatePoolParams(suite.Ctx, governor, poolId, newParams)
+	suite.Require().NoError(err)
+	suite.Require().True(updated)
+
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(newParams.SwapFee, pool.GetSwapFee(suite.Ctx))
+	suite.Require().Equal(newParams.ExitFee, pool.GetExitFee(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestUpdatePoolParamsLockGovernor() {
+	suite.SetupTest()
+	lockDuration := 24 * time.Hour
+	poolId := suite.prepareGovernedBalancerPool(lockDuration.String())
+	shareDenom := types.GetPoolShareDenom(poolId)
+
+	// TestAccs[0] locks 60% of the locked shares, TestAccs[1] 30% and TestAccs[2] 10%.
+	// TestAccs[2]'s lock is too short to vote with.
+	shares := suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[0], shareDenom).Amount
+	lockedShares := shares.QuoRaw(10)
+	for i, locked := range []struct {
+		sharesTenths int64
+		duration     time.Duration
+	}{{6, lockDuration}, {3, lockDuration}, {1, time.Hour}} {
+		coins := sdk.NewCoins(sdk.NewCoin(shareDenom, lockedShares.MulRaw(locked.sharesTenths)))
+		if i != 0 {
+			suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAccs[0], suite.TestAccs[i], coins))
+		}
+		_, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, suite.TestAccs[i], coins, locked.duration)
+		suite.Require().NoError(err)
+	}
+
+	newParams := balancer.PoolParams{
+		SwapFee: sdk.NewDecWithPrec(3, 2),
+		ExitFee: sdk.ZeroDec(),
+	}
+	otherParams := balancer.PoolParams{
+		SwapFee: sdk.NewDecWithPrec(4, 2),
+		ExitFee: sdk.ZeroDec(),
+	}
+
+	_, err := suite.App.GAMMKeeper.UpdatePoolParams(suite.Ctx, suite.TestAccs[2], poolId, newParams)
+	suite.Require().ErrorIs(err, types.ErrNoVotingPower)
+
+	_, err = suite.App.GAMMKeeper.UpdatePoolParams(suite.Ctx, suite.TestAccs[1], poolId, balancer.PoolParams{
+		SwapFee: sdk.OneDec(),
+		ExitFee: sdk.ZeroDec(),
+	})
+	suite.Require().Error(err)
+
+	updated, err := suite.App.GAMMKeeper.UpdatePoolParams(suite.Ctx, suite.TestAccs[1], poolId, newParams)
+	suite.Require().NoError(err)
+	suite.Require().False(updated)
+
+	// Votes for different params don't add up.
+	updated, err = suite.App.GAMMKeeper.UpdatePoolParams(suite.Ctx, suite.TestAccs[0], poolId, otherParams)
+	suite.Require().NoError(err)
+	suite.Require().True(updated)
+
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(otherParams.SwapFee, pool.GetSwapFee(suite.Ctx))
+	suite.Require().Empty(suite.App.GAMMKeeper.GetPoolParamsVotes(suite.Ctx, poolId))
+
+	// A vote is replaced by the voter's next one.
+	updated, err = suite.App.GAMMKeeper.UpdatePoolParams(suite.Ctx, suite.TestAccs[1], poolId, newParams)
+	suite.Require().NoError(err)
+	suite.Require().False(updated)
+	updated, err = suite.App.GAMMKeeper.UpdatePoolParams(suite.Ctx, suite.TestAccs[1], poolId, otherParams)
+	suite.Require().NoError(err)
+	suite.Require().False(updated)
+
+	res, err := suite.queryClient.PoolGovernor(sdk.WrapSDKContext(suite.Ctx), &types.QueryPoolGovernorRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(lockDuration.String(), res.FuturePoolGovernor)
+	suite.Require().Equal(lockedShares.MulRaw(9), res.TotalVotingPower)
+	suite.Require().Len(res.Votes, 1)
+	suite.Require().Equal(suite.TestAccs[1].String(), res.Votes[0].Voter)
+	suite.Require().Equal(lockedShares.MulRaw(3), res.Votes[0].VotingPower)
+	var votedParams balancer.PoolParams
+	suite.Require().NoError(suite.App.AppCodec().Unmarshal(res.Votes[0].PoolParams.Value, &votedParams))
+	suite.Require().Equal(otherParams.SwapFee, votedParams.SwapFee)
+}
+
+func (suite *KeeperTestSuite) TestScheduleWeightChange() {
+	suite.SetupTest()
+	governor := suite.TestAccs[0]
+	poolId := suite.prepareGovernedBalancerPool(governor.String())
+	precision := int64(balancer.GuaranteedWeightPrecision)
+
+	res, err := suite.queryClient.SmoothWeightChange(sdk.WrapSDKContext(suite.Ctx), &types.QuerySmoothWeightChangeRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Nil(res.SmoothWeightChangeParams)
+	suite.Require().Equal(sdk.ZeroDec(), res.Progress)
+	suite.Require().Equal([]types.PoolAssetWeight{
+		{Denom: "bar", Weight: sdk.NewInt(100 * precision)},
+		{Denom: "foo", Weight: sdk.NewInt(100 * precision)},
+	}, res.CurrentWeights)
+
+	params := balancer.SmoothWeightChangeParams{
+		Duration: 100 * time.Second,
+		TargetPoolWeights: []balancer.PoolAsset{
+			{Weight: sdk.NewInt(100), Token: sdk.NewCoin("foo", sdk.ZeroInt())},
+			{Weight: sdk.NewInt(300), Token: sdk.NewCoin("bar", sdk.ZeroInt())},
+		},
+	}
+
+	_, err = suite.App.GAMMKeeper.ScheduleWeightChange(suite.Ctx, suite.TestAccs[1], poolId, params)
+	suite.Require().ErrorIs(err, types.ErrNotPoolGovernor)
+
+	// A start time left unset is the block time, in whole seconds.
+	startTime := time.Unix(1650000000, 0).UTC()
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime)
+	scheduled, err := suite.App.GAMMKeeper.ScheduleWeightChange(suite.Ctx, governor, poolId, params)
+	suite.Require().NoError(err)
+	suite.Require().True(scheduled)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(50 * time.Second))
+	suite.QueryHelper.Ctx = suite.Ctx
+	res, err = suite.queryClient.SmoothWeightChange(sdk.WrapSDKContext(suite.Ctx), &types.QuerySmoothWeightChangeRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res.SmoothWeightChangeParams)
+	suite.Require().Equal(startTime.Add(100*time.Second), res.EndTime)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), res.Progress)
+	suite.Require().Equal([]types.PoolAssetWeight{
+		{Denom: "bar", Weight: sdk.NewInt(200 * precision)},
+		{Denom: "foo", Weight: sdk.NewInt(100 * precision)},
+	}, res.CurrentWeights)
+
+	// The fees are kept.
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(defaultPoolParams.SwapFee, pool.GetSwapFee(suite.Ctx))
+	suite.Require().Equal(defaultPoolParams.ExitFee, pool.GetExitFee(suite.Ctx))
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// HandleSetPoolPauseProposal pauses or unpauses the swaps, joins and exits of the pool in the proposal.
+func (k Keeper) HandleSetPoolPauseProposal(ctx sdk.Context, p *types.SetPoolPauseProposal) error {
+	if _, err := k.GetPoolAndPoke(ctx, p.PoolId); err != nil {
+		return err
+	}
+
+	k.setPoolPause(ctx, types.PoolPause{
+		PoolId:      p.PoolId,
+		SwapsPaused: p.SwapsPaused,
+		JoinsPaused: p.JoinsPaused,
+		ExitsPaused: p.ExitsPaused,
+		Source:      types.PauseSourceGovernance,
+		Height:      ctx.BlockHeight(),
+	})
+	return nil
+}
+
+// SetPoolPause pauses or unpauses the swaps, joins and exits of the balancer pool with poolId,
+// on behalf of its future pool governor, which must be an address and sender.
+// It can't change a pause set by governance, which only governance can lift.
+func (k Keeper) SetPoolPause(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, swapsPaused, joinsPaused, exitsPaused bool) error {
+	pool, err := k.getBalancerPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	if err := validateAddressGovernor(pool, sender, "pause the pool"); err != nil {
+		return err
+	}
+	if pause := k.GetPoolPause(ctx, poolId); pause.IsPaused() && pause.Source == types.PauseSourceGovernance {
+		return sdkerrors.Wrapf(types.ErrPoolPaused, "pool %d was paused by governance, which alone can change its pause", poolId)
+	}
+
+	k.setPoolPause(ctx, types.PoolPause{
+		PoolId:      poolId,
+		SwapsPaused: swapsPaused,
+		JoinsPaused: joinsPaused,
+		ExitsPaused: exitsPaused,
+		Source:      types.PauseSourcePoolGovernor,
+		Height:      ctx.BlockHeight(),
+	})
+	return nil
+}
+
+// GetPoolPause returns the actions that are paused on the pool with poolId.
+// A pause by the circuit breaker that expired pauses nothing.
+func (k Keeper) GetPoolPause(ctx sdk.Context, poolId uint64) types.PoolPause {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyPoolPause(poolId))
+	if bz == nil {
+		return types.PoolPause{PoolId: poolId}
+	}
+
+	var pause types.PoolPause
+	k.cdc.MustUnmarshal(bz, &pause)
+	if pause.IsExpired(ctx.BlockHeight()) {
+		return types.PoolPause{PoolId: poolId}
+	}
+	return pause
+}
+
+// GetPoolPauses returns the pauses of all pools with paused actions.
+func (k Keeper) GetPoolPauses(ctx sdk.Context) []types.PoolPause {
+	iter := k.iterator(ctx, types.KeyPrefixPoolPauses)
+	defer iter.Close()
+
+	pauses := []types.PoolPause{}
+	for ; iter.Valid(); iter.Next() {
+		var pause types.PoolPause
+		k.cdc.MustUnmarshal(iter.Value(), &pause)
+		if pause.IsExpired(ctx.BlockHeight()) {
+			continue
+		}
+		pauses = append(pauses, pause)
+	}
+	return pauses
+}
+
+// setPoolPause stores pause, deleting it if it pauses nothing, and emits an event for it.
+func (k Keeper) setPoolPause(ctx sdk.Context, pause types.PoolPause) {
+	store := ctx.KVStore(k.storeKey)
+	if pause.IsPaused() {
+		store.Set(types.GetKeyPoolPause(pause.PoolId), k.cdc.MustMarshal(&pause))
+	} else {
+		store.Delete(types.GetKeyPoolPause(pause.PoolId))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPoolPauseSet,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pause.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeySwapsPaused, strconv.FormatBool(pause.SwapsPaused)),
+		sdk.NewAttribute(types.AttributeKeyJoinsPaused, strconv.FormatBool(pause.JoinsPaused)),
+		sdk.NewAttribute(types.AttributeKeyExitsPaused, strconv.FormatBool(pause.ExitsPaused)),
+		sdk.NewAttribute(types.AttributeKeyPauseSource, pause.Source.String()),
+	))
+}
+
+func (k Keeper) checkSwapsNotPaused(ctx sdk.Context, poolId uint64) error {
+	if k.GetPoolPause(ctx, poolId).SwapsPaused {
+		return sdkerrors.Wrapf(types.ErrPoolPaused, "swaps on pool %d are paused", poolId)
+	}
+	return nil
+}
+
+func (k Keeper) checkJoinsNotPaused(ctx sdk.Context, poolId uint64) error {
+	if k.GetPoolPause(ctx, poolId).JoinsPaused {
+		return sdkerrors.Wrapf(types.ErrPoolPaused, "joins to pool %d are paused", poolId)
+	}
+	return nil
+}
+
+func (k Keeper) checkExitsNotPaused(ctx sdk.Context, poolId uint64) error {
+	if k.GetPoolPause(ctx, poolId).ExitsPaused {
+		return sdkerrors.Wrapf(types.ErrPoolPaused, "exits from pool %d are paused", poolId)
+	}
+	return nil
+}
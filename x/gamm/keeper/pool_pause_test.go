@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) setCircuitBreaker(maxPriceChange sdk.Dec, window uint64) {
+	params := suite.App.GAMMKeeper.GetParams(suite.Ctx)
+	params.CircuitBreakerMaxPriceChange = maxPriceChange
+	params.CircuitBreakerWindow = window
+	suite.App.GAMMKeeper.SetParams(suite.Ctx, params)
+}
+
+func (suite *KeeperTestSuite) TestSetPoolPause() {
+	suite.SetupTest()
+	governor := suite.TestAccs[0]
+	poolId := suite.prepareGovernedBalancerPool(governor.String())
+	suite.FundAcc(suite.TestAccs[1], defaultAcctFunds)
+	trader := suite.TestAccs[1]
+
+	err := suite.App.GAMMKeeper.SetPoolPause(suite.Ctx, trader, poolId, true, true, true)
+	suite.Require().ErrorIs(err, types.ErrNotPoolGovernor)
+
+	msgServer := keeper.NewBalancerMsgServerImpl(suite.App.GAMMKeeper)
+	_, err = msgServer.SetPoolPause(sdk.WrapSDKContext(suite.Ctx), &balancer.MsgSetPoolPause{
+		Sender:      governor.String(),
+		PoolId:      poolId,
+		SwapsPaused: true,
+		JoinsPaused: true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.PoolPause{
+		PoolId:      poolId,
+		SwapsPaused: true,
+		JoinsPaused: true,
+		Source:      types.PauseSourcePoolGovernor,
+		Height:      suite.Ctx.BlockHeight(),
+	}, suite.App.GAMMKeeper.GetPoolPause(suite.Ctx, poolId))
+
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, trader, poolId, sdk.NewInt64Coin("foo", 1_000), "bar", sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolPaused)
+	_, err = suite.App.GAMMKeeper.SwapExactAmountOut(suite.Ctx, trader, poolId, "foo", sdk.NewInt(10_000), sdk.NewInt64Coin("bar", 1_000))
+	suite.Require().ErrorIs(err, types.ErrPoolPaused)
+	_, _, err = suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, trader, poolId, types.OneShare.MulRaw(10), sdk.Coins{})
+	suite.Require().ErrorIs(err, types.ErrPoolPaused)
+
+	// Exits aren't paused, so LPs can withdraw.
+	shares := suite.App.BankKeeper.GetBalance(suite.Ctx, governor, types.GetPoolShareDenom(poolId)).Amount
+	_, err = suite.App.GAMMKeeper.ExitPool(suite.Ctx, governor, poolId, shares.QuoRaw(2), sdk.Coins{})
+	suite.Require().NoError(err)
+
+	// Unpausing everything deletes the pause.
+	err = suite.App.GAMMKeeper.SetPoolPause(suite.Ctx, governor, poolId, false, false, false)
+	suite.Require().NoError(err)
+	suite.Require().False(suite.App.GAMMKeeper.GetPoolPause(suite.Ctx, poolId).IsPaused())
+	suite.Require().Empty(suite.App.GAMMKeeper.GetPoolPauses(suite.Ctx))
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, trader, poolId, sdk.NewInt64Coin("foo", 1_000), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestSetPoolPauseProposal() {
+	suite.SetupTest()
+	governor := suite.TestAccs[0]
+	poolId := suite.prepareGovernedBalancerPool(governor.String())
+	handler := gamm.NewGammProposalHandler(*suite.App.GAMMKeeper)
+
+	proposal := types.NewSetPoolPauseProposal("title", "description", poolId, false, false, true)
+	suite.Require().NoError(handler(suite.Ctx, &proposal))
+
+	shares := suite.App.BankKeeper.GetBalance(suite.Ctx, governor, types.GetPoolShareDenom(poolId)).Amount
+	_, err := suite.App.GAMMKeeper.ExitPool(suite.Ctx, governor, poolId, shares.QuoRaw(2), sdk.Coins{})
+	suite.Require().ErrorIs(err, types.ErrPoolPaused)
+	_, err = suite.App.GAMMKeeper.ExitSwapShareAmountIn(suite.Ctx, governor, poolId, "foo", shares.QuoRaw(2), sdk.ZeroInt())
+	suite.Require().ErrorIs(err, types.ErrPoolPaused)
+
+	// The governor can't lift a pause set by governance.
+	err = suite.App.GAMMKeeper.SetPoolPause(suite.Ctx, governor, poolId, false, false, false)
+	suite.Require().ErrorIs(err, types.ErrPoolPaused)
+
+	proposal = types.NewSetPoolPauseProposal("title", "description", poolId, false, false, false)
+	suite.Require().NoError(handler(suite.Ctx, &proposal))
+	_, err = suite.App.GAMMKeeper.ExitPool(suite.Ctx, governor, poolId, shares.QuoRaw(2), sdk.Coins{})
+	suite.Require().NoError(err)
+
+	proposal = types.NewSetPoolPauseProposal("title", "description", poolId+1, true, true, true)
+	suite.Require().Error(handler(suite.Ctx, &proposal))
+}
+
+func (suite *KeeperTestSuite) TestCircuitBreaker() {
+	suite.SetupTest()
+	governor := suite.TestAccs[0]
+	poolId := suite.prepareGovernedBalancerPool(governor.String())
+	suite.FundAcc(suite.TestAccs[1], defaultAcctFunds)
+	trader := suite.TestAccs[1]
+	suite.setCircuitBreaker(sdk.NewDecWithPrec(1, 1), 10)
+
+	// Each swap of 200 foo moves the spot price by about 4%, so the third one trips the circuit breaker.
+	for i := 0; i < 2; i++ {
+		_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, trader, poolId, sdk.NewInt64Coin("foo", 200), "bar", sdk.OneInt())
+		suite.Require().NoError(err)
+		suite.Require().False(suite.App.GAMMKeeper.GetPoolPause(suite.Ctx, poolId).IsPaused())
+	}
+	_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, trader, poolId, sdk.NewInt64Coin("foo", 200), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.PoolPause{
+		PoolId:            poolId,
+		SwapsPaused:       true,
+		JoinsPaused:       true,
+		Source:            types.PauseSourceCircuitBreaker,
+		Height:            suite.Ctx.BlockHeight(),
+		PausedUntilHeight: suite.Ctx.BlockHeight() + 10,
+	}, suite.App.GAMMKeeper.GetPoolPause(suite.Ctx, poolId))
+
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, trader, poolId, sdk.NewInt64Coin("bar", 200), "foo", sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolPaused)
+
+	// LPs can exit into all of the pool's assets, but not into a single one, which swaps against the pool.
+	exitCtx, _ := suite.Ctx.CacheContext()
+	shares := suite.App.BankKeeper.GetBalance(exitCtx, governor, types.GetPoolShareDenom(poolId)).Amount
+	_, err = suite.App.GAMMKeeper.ExitSwapShareAmountIn(exitCtx, governor, poolId, "foo", shares.QuoRaw(10), sdk.ZeroInt())
+	suite.Require().ErrorIs(err, types.ErrPoolPaused)
+	_, err = suite.App.GAMMKeeper.ExitPool(exitCtx, governor, poolId, shares.QuoRaw(10), sdk.Coins{})
+	suite.Require().NoError(err)
+
+	// The pause expires after the window.
+	pausedCtx := suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 9)
+	suite.Require().True(suite.App.GAMMKeeper.GetPoolPause(pausedCtx, poolId).SwapsPaused)
+	expiredCtx, _ := suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 10).CacheContext()
+	suite.Require().Equal(types.PoolPause{PoolId: poolId}, suite.App.GAMMKeeper.GetPoolPause(expiredCtx, poolId))
+	suite.Require().Empty(suite.App.GAMMKeeper.GetPoolPauses(expiredCtx))
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(expiredCtx, trader, poolId, sdk.NewInt64Coin("bar", 200), "foo", sdk.OneInt())
+	suite.Require().NoError(err)
+
+	// The governor lifts the circuit breaker's pause, and the price moves within a new window.
+	err = suite.App.GAMMKeeper.SetPoolPause(suite.Ctx, governor, poolId, false, false, false)
+	suite.Require().NoError(err)
+	suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 10)
+	for i := 0; i < 2; i++ {
+		_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, trader, poolId, sdk.NewInt64Coin("bar", 200), "foo", sdk.OneInt())
+		suite.Require().NoError(err)
+	}
+	suite.Require().False(suite.App.GAMMKeeper.GetPoolPause(suite.Ctx, poolId).IsPaused())
+}
+
+// TestCircuitBreakerTrippingTrade checks that the trade that trips the circuit breaker still executes in full
+// at the moved price, and only the trades after it are paused.
+func (suite *KeeperTestSuite) TestCircuitBreakerTrippingTrade() {
+	suite.SetupTest()
+	poolId := suite.prepareGovernedBalancerPool("")
+	suite.FundAcc(suite.TestAccs[1], defaultAcctFunds)
+	trader := suite.TestAccs[1]
+	tokenIn := sdk.NewInt64Coin("foo", 1_000)
+
+	// What the trade gets without the circuit breaker.
+	uncheckedCtx, _ := suite.Ctx.CacheContext()
+	expectedOut, err := suite.App.GAMMKeeper.SwapExactAmountIn(uncheckedCtx, trader, poolId, tokenIn, "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+
+	// A single swap of 1000 foo moves the spot price by about 20%, which trips the circuit breaker by itself.
+	suite.setCircuitBreaker(sdk.NewDecWithPrec(1, 1), 10)
+	poolBefore, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	tokenOut, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, trader, poolId, tokenIn, "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedOut, tokenOut)
+	poolAfter, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(poolBefore.GetTotalPoolLiquidity(suite.Ctx).Add(tokenIn).Sub(sdk.NewCoins(sdk.NewCoin("bar", tokenOut))),
+		poolAfter.GetTotalPoolLiquidity(suite.Ctx))
+	suite.Require().True(suite.App.GAMMKeeper.GetPoolPause(suite.Ctx, poolId).SwapsPaused)
+	tripped := false
+	for _, event := range suite.Ctx.EventManager().Events() {
+		tripped = tripped || event.Type == types.TypeEvtCircuitBreakerTripped
+	}
+	suite.Require().True(tripped)
+
+	// Even a small trade back is paused, for everyone, until the window passes.
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, trader, poolId, sdk.NewInt64Coin("bar", 10), "foo", sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolPaused)
+}
+
+func (suite *KeeperTestSuite) TestCircuitBreakerKeepsGovernorPause() {
+	suite.SetupTest()
+	governor := suite.TestAccs[0]
+	poolId := suite.prepareGovernedBalancerPool(governor.String())
+	suite.FundAcc(suite.TestAccs[1], defaultAcctFunds)
+	trader := suite.TestAccs[1]
+	suite.setCircuitBreaker(sdk.NewDecWithPrec(1, 1), 10)
+
+	err := suite.App.GAMMKeeper.SetPoolPause(suite.Ctx, governor, poolId, false, false, true)
+	suite.Require().NoError(err)
+	for i := 0; i < 3; i++ {
+		_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, trader, poolId, sdk.NewInt64Coin("foo", 200), "bar", sdk.OneInt())
+		suite.Require().NoError(err)
+	}
+
+	// The circuit breaker adds to the governor's pause, which lasts until the governor lifts it.
+	expected := types.PoolPause{
+		PoolId:      poolId,
+		SwapsPaused: true,
+		JoinsPaused: true,
+		ExitsPaused: true,
+		Source:      types.PauseSourcePoolGovernor,
+		Height:      suite.Ctx.BlockHeight(),
+	}
+	suite.Require().Equal(expected, suite.App.GAMMKeeper.GetPoolPause(suite.Ctx, poolId))
+	suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 10)
+	suite.Require().Equal(expected, suite.App.GAMMKeeper.GetPoolPause(suite.Ctx, poolId))
+}
+
+func (suite *KeeperTestSuite) TestCircuitBreakerDisabled() {
+	suite.SetupTest()
+	poolId := suite.prepareGovernedBalancerPool("")
+	suite.FundAcc(suite.TestAccs[1], defaultAcctFunds)
+
+	_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[1], poolId, sdk.NewInt64Coin("foo", 5_000), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().False(suite.App.GAMMKeeper.GetPoolPause(suite.Ctx, poolId).IsPaused())
+}
+
+func (suite *KeeperTestSuite) TestQueryPoolPause() {
+	suite.SetupTest()
+	queryClient := suite.queryClient
+	governor := suite.TestAccs[0]
+	poolId := suite.prepareGovernedBalancerPool(governor.String())
+	otherPoolId := suite.prepareGovernedBalancerPool(governor.String())
+
+	res, err := queryClient.PoolPause(gocontext.Background(), &types.QueryPoolPauseRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.PoolPause{PoolId: poolId}, res.PoolPause)
+
+	err = suite.App.GAMMKeeper.SetPoolPause(suite.Ctx, governor, poolId, true, false, false)
+	suite.Require().NoError(err)
+
+	res, err = queryClient.PoolPause(gocontext.Background(), &types.QueryPoolPauseRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().True(res.PoolPause.SwapsPaused)
+	suite.Require().Equal(types.PauseSourcePoolGovernor, res.PoolPause.Source)
+
+	_, err = queryClient.PoolPause(gocontext.Background(), &types.QueryPoolPauseRequest{PoolId: otherPoolId + 1})
+	suite.Require().Error(err)
+
+	pausedRes, err := queryClient.PausedPools(gocontext.Background(), &types.QueryPausedPoolsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PoolPause{res.PoolPause}, pausedRes.PoolPauses)
+
+	// The pauses survive a genesis export and import.
+	genesis := suite.App.GAMMKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Equal([]types.PoolPause{res.PoolPause}, genesis.PoolPauses)
+	suite.Require().NoError(genesis.Validate())
+}
@@ -189,6 +189,9 @@ func (k Keeper) JoinPoolNoSwap(
 	if err != nil {
 		return nil, sdk.ZeroInt(), err
 	}
+	if err := k.checkJoinsNotPaused(ctx, poolId); err != nil {
+		return nil, sdk.ZeroInt(), err
+	}
 
 	// we do an abstract calculation on the lp liquidity coins needed to have
 	// the designated amount of given shares of the pool without performing swap
@@ -268,6 +271,16 @@ func (k Keeper) JoinSwapExactAmountIn(
 	if err != nil {
 		return sdk.Int{}, err
 	}
+	if err := k.checkJoinsNotPaused(ctx, poolId); err != nil {
+		return sdk.Int{}, err
+	}
+	denomsIn := make([]string, 0, len(tokensIn))
+	for _, tokenIn := range tokensIn {
+		denomsIn = append(denomsIn, tokenIn.Denom)
+	}
+	if err := k.recordCircuitBreakerReferences(ctx, pool, denomsIn...); err != nil {
+		return sdk.Int{}, err
+	}
 
 	sharesOut, err := pool.JoinPool(ctx, tokensIn, pool.GetSwapFee(ctx))
 	switch {
@@ -288,6 +301,9 @@ func (k Keeper) JoinSwapExactAmountIn(
 	if err := k.applyJoinPoolStateChange(ctx, pool, sender, sharesOut, tokensIn); err != nil {
 		return sdk.ZeroInt(), err
 	}
+	if err := k.checkCircuitBreaker(ctx, pool, denomsIn...); err != nil {
+		return sdk.ZeroInt(), err
+	}
 
 	return sharesOut, nil
 }
@@ -304,11 +320,17 @@ func (k Keeper) JoinSwapShareAmountOut(
 	if err != nil {
 		return sdk.Int{}, err
 	}
+	if err := k.checkJoinsNotPaused(ctx, poolId); err != nil {
+		return sdk.Int{}, err
+	}
 
 	extendedPool, ok := pool.(types.PoolAmountOutExtension)
 	if !ok {
 		return sdk.Int{}, fmt.Errorf("pool with id %d does not support this kind of join", poolId)
 	}
+	if err := k.recordCircuitBreakerReferences(ctx, pool, tokenInDenom); err != nil {
+		return sdk.Int{}, err
+	}
 
 	tokenInAmount, err = extendedPool.CalcTokenInShareAmountOut(ctx, tokenInDenom, shareOutAmount, pool.GetSwapFee(ctx))
 	if err != nil {
@@ -327,6 +349,9 @@ func (k Keeper) JoinSwapShareAmountOut(
 	if err != nil {
 		return sdk.ZeroInt(), err
 	}
+	if err := k.checkCircuitBreaker(ctx, pool, tokenInDenom); err != nil {
+		return sdk.ZeroInt(), err
+	}
 	return tokenInAmount, nil
 }
 
@@ -341,6 +366,9 @@ func (k Keeper) ExitPool(
 	if err != nil {
 		return sdk.Coins{}, err
 	}
+	if err := k.checkExitsNotPaused(ctx, poolId); err != nil {
+		return sdk.Coins{}, err
+	}
 
 	totalSharesAmount := pool.GetTotalShares()
 	if shareInAmount.GTE(totalSharesAmount) || shareInAmount.LTE(sdk.ZeroInt()) {
@@ -411,11 +439,17 @@ func (k Keeper) ExitSwapExactAmountOut(
 	if err != nil {
 		return sdk.Int{}, err
 	}
+	if err := k.checkExitsNotPaused(ctx, poolId); err != nil {
+		return sdk.Int{}, err
+	}
 
 	extendedPool, ok := pool.(types.PoolAmountOutExtension)
 	if !ok {
 		return sdk.Int{}, fmt.Errorf("pool with id %d does not support this kind of exit", poolId)
 	}
+	if err := k.recordCircuitBreakerReferences(ctx, pool, tokenOut.Denom); err != nil {
+		return sdk.Int{}, err
+	}
 
 	shareInAmount, err = extendedPool.ExitSwapExactAmountOut(ctx, tokenOut, shareInMaxAmount)
 	if err != nil {
@@ -425,6 +459,9 @@ func (k Keeper) ExitSwapExactAmountOut(
 	if err := k.applyExitPoolStateChange(ctx, pool, sender, shareInAmount, sdk.Coins{tokenOut}); err != nil {
 		return sdk.Int{}, err
 	}
+	if err := k.checkCircuitBreaker(ctx, pool, tokenOut.Denom); err != nil {
+		return sdk.Int{}, err
+	}
 
 	return shareInAmount, nil
 }
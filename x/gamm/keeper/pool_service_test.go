@@ -210,9 +210,7 @@ func (suite *KeeperTestSuite) TestCreateBalancerPool() {
 	}, {
 		fn: func() {
 			keeper := suite.App.GAMMKeeper
-			keeper.SetParams(suite.Ctx, types.Params{
-				PoolCreationFee: sdk.Coins{},
-			})
+			keeper.SetParams(suite.Ctx, types.NewParams(sdk.Coins{}))
 			msg := balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], balancer.PoolParams{
 				SwapFee: sdk.NewDecWithPrec(1, 2),
 				ExitFee: sdk.NewDecWithPrec(1, 2),
@@ -226,9 +224,7 @@ func (suite *KeeperTestSuite) TestCreateBalancerPool() {
 	}, {
 		fn: func() {
 			keeper := suite.App.GAMMKeeper
-			keeper.SetParams(suite.Ctx, types.Params{
-				PoolCreationFee: nil,
-			})
+			keeper.SetParams(suite.Ctx, types.NewParams(nil))
 			msg := balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], balancer.PoolParams{
 				SwapFee: sdk.NewDecWithPrec(1, 2),
 				ExitFee: sdk.NewDecWithPrec(1, 2),
@@ -694,7 +690,7 @@ func (suite *KeeperTestSuite) TestJoinSwapExactAmountInConsistency() {
 // 	poolID, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, msg)
 // 	suite.Require().NoError(err)
 
-// 	err = suite.App.GAMMKeeper.SetStableSwapScalingFactors(suite.Ctx, testScalingFactors, poolID, "")
+// 	err = suite.App.GAMMKeeper.SetStableSwapScalingFactors(suite.Ctx, testScalingFactors, 0, poolID, "")
 // 	suite.Require().NoError(err)
 
 // 	poolI, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolID)
@@ -0,0 +1,284 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+const (
+	// DefaultBestRouteHops is the largest number of pools in the routes that BestRoute searches by default.
+	DefaultBestRouteHops = 3
+	// MaxBestRouteHops is the largest number of pools in the routes that BestRoute searches.
+	MaxBestRouteHops = 4
+	// MaxBestRouteSplits is the largest number of routes that BestRoute splits tokens in across.
+	MaxBestRouteSplits = 4
+	// MaxBestRouteCandidates is the largest number of routes that BestRoute compares.
+	MaxBestRouteCandidates = 32
+	// BestRouteSplitParts is the number of equal parts that BestRoute splits tokens in into,
+	// and assigns one by one to the routes they get the most out of.
+	BestRouteSplitParts = 10
+	// BestRouteEstimateGas is the gas that BestRoute consumes for every swap it estimates,
+	// which bounds its work under the gas limit of the query, such as a CosmWasm query's.
+	BestRouteEstimateGas = 5_000
+	// BestRouteLoadPoolGas is the gas that BestRoute consumes for every pool it loads to search routes through.
+	BestRouteLoadPoolGas = 1_000
+	// BestRouteSearchGas is the gas that BestRoute consumes for every step of its search for routes,
+	// which is a pool it considers, or an asset of one it considers swapping into.
+	BestRouteSearchGas = 100
+	// MaxBestRouteSearchSteps is the largest number of steps that BestRoute searches routes for,
+	// after which it compares the routes it found.
+	MaxBestRouteSearchSteps = 10_000
+)
+
+// BestRoute searches the pools for the routes of at most maxHops pools that swap tokenIn
+// for the most tokens of tokenOutDenom, and returns them with the tokens out they are estimated to get.
+// If maxSplits is more than one, tokenIn is split across up to maxSplits routes that share no pool.
+// Pools that are inactive, or whose swaps are paused, are left out.
+func (k Keeper) BestRoute(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, maxHops, maxSplits int) ([]types.SplitRoute, sdk.Int, error) {
+	if !tokenIn.IsValid() || !tokenIn.IsPositive() {
+		return nil, sdk.Int{}, fmt.Errorf("token in must be positive: %s", tokenIn)
+	}
+	if err := sdk.ValidateDenom(tokenOutDenom); err != nil {
+		return nil, sdk.Int{}, err
+	}
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, sdk.Int{}, fmt.Errorf("cannot route same denomination in and out")
+	}
+	if maxHops == 0 {
+		maxHops = DefaultBestRouteHops
+	}
+	if maxHops < 0 || maxHops > MaxBestRouteHops {
+		return nil, sdk.Int{}, fmt.Errorf("max hops must be between 1 and %d, got %d", MaxBestRouteHops, maxHops)
+	}
+	if maxSplits < 0 || maxSplits > MaxBestRouteSplits {
+		return nil, sdk.Int{}, fmt.Errorf("max splits must be between 0 and %d, got %d", MaxBestRouteSplits, maxSplits)
+	}
+
+	poolsByDenom, err := k.getSwappablePoolsByDenom(ctx)
+	if err != nil {
+		return nil, sdk.Int{}, err
+	}
+
+	candidates := []routeEstimate{}
+	for _, route := range findRoutes(ctx, poolsByDenom, tokenIn.Denom, tokenOutDenom, maxHops) {
+		tokenOutAmount, err := k.estimateRoute(ctx, route, tokenIn)
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, routeEstimate{route: route, tokenOutAmount: tokenOutAmount})
+	}
+	if len(candidates) == 0 {
+		return nil, sdk.Int{}, fmt.Errorf("no route swaps %s for %s within %d hops", tokenIn.Denom, tokenOutDenom, maxHops)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].tokenOutAmount.GT(candidates[j].tokenOutAmount)
+	})
+
+	best := candidates[0]
+	bestRoutes := []types.SplitRoute{best.splitRoute(tokenIn.Amount)}
+	if maxSplits <= 1 {
+		return bestRoutes, best.tokenOutAmount, nil
+	}
+
+	splitRoutes, tokenOutAmount := k.splitAcrossRoutes(ctx, disjointRoutes(candidates, maxSplits), tokenIn)
+	if tokenOutAmount.LTE(best.tokenOutAmount) {
+		return bestRoutes, best.tokenOutAmount, nil
+	}
+	return splitRoutes, tokenOutAmount, nil
+}
+
+// routeHop is a swap in pool for tokens of tokenOutDenom.
+type routeHop struct {
+	pool          types.PoolI
+	tokenOutDenom string
+}
+
+type routeEstimate struct {
+	route          []routeHop
+	tokenOutAmount sdk.Int
+}
+
+func (e routeEstimate) splitRoute(tokenInAmount sdk.Int) types.SplitRoute {
+	routes := make([]types.SwapAmountInRoute, len(e.route))
+	for i, hop := range e.route {
+		routes[i] = types.SwapAmountInRoute{PoolId: hop.pool.GetId(), TokenOutDenom: hop.tokenOutDenom}
+	}
+	return types.SplitRoute{Routes: routes, TokenInAmount: tokenInAmount, TokenOutAmount: e.tokenOutAmount}
+}
+
+// getSwappablePoolsByDenom returns the pools that can be swapped against, by the denoms of their assets,
+// consuming BestRouteLoadPoolGas for every pool.
+func (k Keeper) getSwappablePoolsByDenom(ctx sdk.Context) (map[string][]types.PoolI, error) {
+	pools, err := k.GetPoolsAndPoke(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	poolsByDenom := map[string][]types.PoolI{}
+	for _, pool := range pools {
+		ctx.GasMeter().ConsumeGas(BestRouteLoadPoolGas, "best route load pool")
+		if !pool.IsActive(ctx) || k.GetPoolPause(ctx, pool.GetId()).SwapsPaused {
+			continue
+		}
+		for _, asset := range pool.GetTotalPoolLiquidity(ctx) {
+			poolsByDenom[asset.Denom] = append(poolsByDenom[asset.Denom], pool)
+		}
+	}
+	return poolsByDenom, nil
+}
+
+// findRoutes returns up to MaxBestRouteCandidates routes of at most maxHops pools from tokenInDenom to tokenOutDenom,
+// which pass through every pool and denom at most once. Shorter routes are found first.
+// It searches for at most MaxBestRouteSearchSteps steps, consuming BestRouteSearchGas for every one.
+func findRoutes(ctx sdk.Context, poolsByDenom map[string][]types.PoolI, tokenInDenom, tokenOutDenom string, maxHops int) [][]routeHop {
+	routes := [][]routeHop{}
+	steps := 0
+	// step consumes the gas of a search step, and returns whether the search may take it.
+	step := func() bool {
+		if steps >= MaxBestRouteSearchSteps {
+			return false
+		}
+		steps++
+		ctx.GasMeter().ConsumeGas(BestRouteSearchGas, "best route search")
+		return true
+	}
+	for hops := 1; hops <= maxHops && len(routes) < MaxBestRouteCandidates && steps < MaxBestRouteSearchSteps; hops++ {
+		visitedDenoms := map[string]bool{tokenInDenom: true}
+		visitedPools := map[uint64]bool{}
+		var search func(denom string, route []routeHop)
+		search = func(denom string, route []routeHop) {
+			for _, pool := range poolsByDenom[denom] {
+				if len(routes) >= MaxBestRouteCandidates || !step() {
+					return
+				}
+				if visitedPools[pool.GetId()] {
+					continue
+				}
+				for _, asset := range pool.GetTotalPoolLiquidity(ctx) {
+					nextDenom := asset.Denom
+					if visitedDenoms[nextDenom] || len(routes) >= MaxBestRouteCandidates {
+						continue
+					}
+					if !step() {
+						return
+					}
+					nextRoute := append(append([]routeHop{}, route...), routeHop{pool: pool, tokenOutDenom: nextDenom})
+					if len(nextRoute) == hops {
+						if nextDenom == tokenOutDenom {
+							routes = append(routes, nextRoute)
+						}
+						continue
+					}
+					if nextDenom == tokenOutDenom {
+						continue
+					}
+					visitedDenoms[nextDenom] = true
+					visitedPools[pool.GetId()] = true
+					search(nextDenom, nextRoute)
+					visitedDenoms[nextDenom] = false
+					visitedPools[pool.GetId()] = false
+				}
+			}
+		}
+		search(tokenInDenom, nil)
+	}
+	return routes
+}
+
+// estimateRoute returns the tokens out that swapping tokenIn through route is estimated to get,
+// net of the taker fees of its pools, consuming BestRouteEstimateGas for every pool in it.
+func (k Keeper) estimateRoute(ctx sdk.Context, route []routeHop, tokenIn sdk.Coin) (sdk.Int, error) {
+	for _, hop := range route {
+		ctx.GasMeter().ConsumeGas(BestRouteEstimateGas, "best route swap estimate")
+		_, poolTokenIn, poolSwapFee := k.splitTakerFee(ctx, hop.pool.GetId(), tokenIn, hop.pool.GetSwapFee(ctx))
+		tokenOut, err := hop.pool.CalcOutAmtGivenIn(ctx, sdk.Coins{poolTokenIn}, hop.tokenOutDenom, poolSwapFee)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		if !tokenOut.Amount.IsPositive() {
+			return sdk.Int{}, fmt.Errorf("route through pool %d gets no %s", hop.pool.GetId(), hop.tokenOutDenom)
+		}
+		tokenIn = tokenOut
+	}
+	return tokenIn.Amount, nil
+}
+
+// disjointRoutes returns up to maxRoutes of candidates, in order, that share no pool with an earlier one.
+// Swaps through them don't affect each other, so their estimates add up.
+func disjointRoutes(candidates []routeEstimate, maxRoutes int) []routeEstimate {
+	routes := []routeEstimate{}
+	usedPools := map[uint64]bool{}
+	for _, candidate := range candidates {
+		if len(routes) == maxRoutes {
+			break
+		}
+		disjoint := true
+		for _, hop := range candidate.route {
+			if usedPools[hop.pool.GetId()] {
+				disjoint = false
+				break
+			}
+		}
+		if !disjoint {
+			continue
+		}
+		for _, hop := range candidate.route {
+			usedPools[hop.pool.GetId()] = true
+		}
+		routes = append(routes, candidate)
+	}
+	return routes
+}
+
+// splitAcrossRoutes splits tokenIn into BestRouteSplitParts parts, and assigns them one by one
+// to the route that gets the most more tokens out for it. It returns the routes assigned any part.
+func (k Keeper) splitAcrossRoutes(ctx sdk.Context, routes []routeEstimate, tokenIn sdk.Coin) ([]types.SplitRoute, sdk.Int) {
+	tokenInAmounts := make([]sdk.Int, len(routes))
+	tokenOutAmounts := make([]sdk.Int, len(routes))
+	for i := range routes {
+		tokenInAmounts[i] = sdk.ZeroInt()
+		tokenOutAmounts[i] = sdk.ZeroInt()
+	}
+
+	partAmount := tokenIn.Amount.QuoRaw(BestRouteSplitParts)
+	remaining := tokenIn.Amount
+	for remaining.IsPositive() {
+		part := partAmount
+		if part.IsZero() || remaining.Sub(part).LT(partAmount) {
+			part = remaining
+		}
+
+		bestIndex, bestGain, bestTokenOutAmount := -1, sdk.ZeroInt(), sdk.ZeroInt()
+		for i, route := range routes {
+			tokenOutAmount, err := k.estimateRoute(ctx, route.route, sdk.NewCoin(tokenIn.Denom, tokenInAmounts[i].Add(part)))
+			if err != nil {
+				continue
+			}
+			if gain := tokenOutAmount.Sub(tokenOutAmounts[i]); bestIndex == -1 || gain.GT(bestGain) {
+				bestIndex, bestGain, bestTokenOutAmount = i, gain, tokenOutAmount
+			}
+		}
+		if bestIndex == -1 {
+			return nil, sdk.ZeroInt()
+		}
+		tokenInAmounts[bestIndex] = tokenInAmounts[bestIndex].Add(part)
+		tokenOutAmounts[bestIndex] = bestTokenOutAmount
+		remaining = remaining.Sub(part)
+	}
+
+	splitRoutes := []types.SplitRoute{}
+	totalTokenOutAmount := sdk.ZeroInt()
+	for i, route := range routes {
+		if tokenInAmounts[i].IsZero() {
+			continue
+		}
+		route.tokenOutAmount = tokenOutAmounts[i]
+		splitRoutes = append(splitRoutes, route.splitRoute(tokenInAmounts[i]))
+		totalTokenOutAmount = totalTokenOutAmount.Add(tokenOutAmounts[i])
+	}
+	return splitRoutes, totalTokenOutAmount
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// connectedPoolsByDenom returns pools of every pair of numDenoms denoms, by the denoms of their assets.
+func connectedPoolsByDenom(t *testing.T, numDenoms int) map[string][]types.PoolI {
+	poolsByDenom := map[string][]types.PoolI{}
+	poolId := uint64(1)
+	for i := 0; i < numDenoms; i++ {
+		for j := i + 1; j < numDenoms; j++ {
+			assets := []balancer.PoolAsset{
+				{Token: sdk.NewInt64Coin(fmt.Sprintf("denom%d", i), 1_000_000), Weight: sdk.NewInt(1)},
+				{Token: sdk.NewInt64Coin(fmt.Sprintf("denom%d", j), 1_000_000), Weight: sdk.NewInt(1)},
+			}
+			pool, err := balancer.NewBalancerPool(poolId, balancer.PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec()}, assets, "", time.Unix(0, 0))
+			require.NoError(t, err)
+			for _, asset := range assets {
+				poolsByDenom[asset.Token.Denom] = append(poolsByDenom[asset.Token.Denom], &pool)
+			}
+			poolId++
+		}
+	}
+	return poolsByDenom
+}
+
+func TestFindRoutesSearchBound(t *testing.T) {
+	poolsByDenom := connectedPoolsByDenom(t, 30)
+
+	// There are far more routes of 4 pools between denoms of a connected graph than candidates.
+	ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+	routes := findRoutes(ctx, poolsByDenom, "denom0", "denom1", MaxBestRouteHops)
+	require.Len(t, routes, MaxBestRouteCandidates)
+	require.Len(t, routes[0], 1)
+	require.True(t, ctx.GasMeter().GasConsumed() < MaxBestRouteSearchSteps*BestRouteSearchGas)
+
+	// Searching for a denom without pools visits the whole graph, so the search stops at its step bound.
+	ctx = sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+	routes = findRoutes(ctx, poolsByDenom, "denom0", "qux", MaxBestRouteHops)
+	require.Empty(t, routes)
+	require.Equal(t, sdk.Gas(MaxBestRouteSearchSteps*BestRouteSearchGas), ctx.GasMeter().GasConsumed())
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// prepareRoutePools creates a shallow foo/bar pool, and deep foo/baz and baz/bar pools
+// that swap foo for bar through baz.
+func (suite *KeeperTestSuite) prepareRoutePools() (directPoolId, fooBazPoolId, bazBarPoolId uint64) {
+	directPoolId = suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+	fooBazPoolId = suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("foo", 10_000_000), sdk.NewInt64Coin("baz", 10_000_000))
+	bazBarPoolId = suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("baz", 10_000_000), sdk.NewInt64Coin("bar", 10_000_000))
+	return directPoolId, fooBazPoolId, bazBarPoolId
+}
+
+func (suite *KeeperTestSuite) TestBestRoute() {
+	suite.SetupTest()
+	directPoolId, fooBazPoolId, bazBarPoolId := suite.prepareRoutePools()
+	suite.FundAcc(suite.TestAccs[1], defaultAcctFunds)
+	tokenIn := sdk.NewInt64Coin("foo", 100_000)
+
+	// The deep route through baz gets more bar than the shallow direct pool.
+	splitRoutes, tokenOutAmount, err := suite.App.GAMMKeeper.BestRoute(suite.Ctx, tokenIn, "bar", 0, 0)
+	suite.Require().NoError(err)
+	suite.Require().Len(splitRoutes, 1)
+	suite.Require().Equal([]types.SwapAmountInRoute{
+		{PoolId: fooBazPoolId, TokenOutDenom: "baz"},
+		{PoolId: bazBarPoolId, TokenOutDenom: "bar"},
+	}, splitRoutes[0].Routes)
+	suite.Require().Equal(tokenIn.Amount, splitRoutes[0].TokenInAmount)
+	suite.Require().Equal(tokenOutAmount, splitRoutes[0].TokenOutAmount)
+
+	// Within one hop, only the direct pool is left.
+	oneHopRoutes, oneHopTokenOutAmount, err := suite.App.GAMMKeeper.BestRoute(suite.Ctx, tokenIn, "bar", 1, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SwapAmountInRoute{{PoolId: directPoolId, TokenOutDenom: "bar"}}, oneHopRoutes[0].Routes)
+	suite.Require().True(oneHopTokenOutAmount.LT(tokenOutAmount))
+
+	// The estimate is what the swap gets.
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	swapTokenOutAmount, err := suite.App.GAMMKeeper.MultihopSwapExactAmountIn(cacheCtx, suite.TestAccs[1], splitRoutes[0].Routes, tokenIn, sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(tokenOutAmount, swapTokenOutAmount)
+
+	// Splitting the tokens in across both routes gets more than either, and the swaps get the estimate.
+	splitRoutes, splitTokenOutAmount, err := suite.App.GAMMKeeper.BestRoute(suite.Ctx, tokenIn, "bar", 0, 2)
+	suite.Require().NoError(err)
+	suite.Require().Len(splitRoutes, 2)
+	suite.Require().True(splitTokenOutAmount.GT(tokenOutAmount))
+	splitTokenInAmount, swapTokenOutAmount := sdk.ZeroInt(), sdk.ZeroInt()
+	for _, splitRoute := range splitRoutes {
+		splitTokenInAmount = splitTokenInAmount.Add(splitRoute.TokenInAmount)
+		routeTokenOutAmount, err := suite.App.GAMMKeeper.MultihopSwapExactAmountIn(
+			suite.Ctx, suite.TestAccs[1], splitRoute.Routes, sdk.NewCoin("foo", splitRoute.TokenInAmount), sdk.OneInt())
+		suite.Require().NoError(err)
+		suite.Require().Equal(splitRoute.TokenOutAmount, routeTokenOutAmount)
+		swapTokenOutAmount = swapTokenOutAmount.Add(routeTokenOutAmount)
+	}
+	suite.Require().Equal(tokenIn.Amount, splitTokenInAmount)
+	suite.Require().Equal(splitTokenOutAmount, swapTokenOutAmount)
+
+	// The search consumes gas for every pool it loads, besides the swaps it estimates.
+	gasCtx := suite.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, _, err = suite.App.GAMMKeeper.BestRoute(gasCtx, tokenIn, "bar", 1, 0)
+	suite.Require().NoError(err)
+	suite.Require().True(gasCtx.GasMeter().GasConsumed() >= 3*keeper.BestRouteLoadPoolGas+keeper.BestRouteEstimateGas)
+}
+
+func (suite *KeeperTestSuite) TestBestRouteSkipsPausedPools() {
+	suite.SetupTest()
+	directPoolId, fooBazPoolId, _ := suite.prepareRoutePools()
+	tokenIn := sdk.NewInt64Coin("foo", 100_000)
+
+	proposal := types.NewSetPoolPauseProposal("title", "description", fooBazPoolId, true, false, false)
+	suite.Require().NoError(suite.App.GAMMKeeper.HandleSetPoolPauseProposal(suite.Ctx, &proposal))
+
+	splitRoutes, _, err := suite.App.GAMMKeeper.BestRoute(suite.Ctx, tokenIn, "bar", 0, 2)
+	suite.Require().NoError(err)
+	suite.Require().Len(splitRoutes, 1)
+	suite.Require().Equal([]types.SwapAmountInRoute{{PoolId: directPoolId, TokenOutDenom: "bar"}}, splitRoutes[0].Routes)
+
+	proposal = types.NewSetPoolPauseProposal("title", "description", directPoolId, true, false, false)
+	suite.Require().NoError(suite.App.GAMMKeeper.HandleSetPoolPauseProposal(suite.Ctx, &proposal))
+	_, _, err = suite.App.GAMMKeeper.BestRoute(suite.Ctx, tokenIn, "bar", 0, 2)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestBestRouteInvalid() {
+	suite.SetupTest()
+	suite.prepareRoutePools()
+	tokenIn := sdk.NewInt64Coin("foo", 100_000)
+
+	tests := map[string]struct {
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+		maxHops       int
+		maxSplits     int
+	}{
+		"zero token in":       {tokenIn: sdk.NewInt64Coin("foo", 0), tokenOutDenom: "bar"},
+		"same denom":          {tokenIn: tokenIn, tokenOutDenom: "foo"},
+		"invalid denom out":   {tokenIn: tokenIn, tokenOutDenom: "1"},
+		"too many hops":       {tokenIn: tokenIn, tokenOutDenom: "bar", maxHops: keeper.MaxBestRouteHops + 1},
+		"too many splits":     {tokenIn: tokenIn, tokenOutDenom: "bar", maxSplits: keeper.MaxBestRouteSplits + 1},
+		"no pool of denom in": {tokenIn: sdk.NewInt64Coin("qux", 100_000), tokenOutDenom: "bar"},
+	}
+	for name, tc := range tests {
+		_, _, err := suite.App.GAMMKeeper.BestRoute(suite.Ctx, tc.tokenIn, tc.tokenOutDenom, tc.maxHops, tc.maxSplits)
+		suite.Require().Error(err, name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryBestRoute() {
+	suite.SetupTest()
+	queryClient := suite.queryClient
+	_, fooBazPoolId, bazBarPoolId := suite.prepareRoutePools()
+
+	res, err := queryClient.BestRoute(gocontext.Background(), &types.QueryBestRouteRequest{
+		TokenIn:       "100000foo",
+		TokenOutDenom: "bar",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.SplitRoutes, 1)
+	suite.Require().Equal([]types.SwapAmountInRoute{
+		{PoolId: fooBazPoolId, TokenOutDenom: "baz"},
+		{PoolId: bazBarPoolId, TokenOutDenom: "bar"},
+	}, res.SplitRoutes[0].Routes)
+	suite.Require().Equal(res.TokenOutAmount, res.SplitRoutes[0].TokenOutAmount)
+
+	_, err = queryClient.BestRoute(gocontext.Background(), &types.QueryBestRouteRequest{
+		TokenIn:       "foo",
+		TokenOutDenom: "bar",
+	})
+	suite.Require().Error(err)
+}
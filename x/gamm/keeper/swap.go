@@ -47,11 +47,18 @@ func (k Keeper) swapExactAmountIn(
 	if tokenIn.Denom == tokenOutDenom {
 		return sdk.Int{}, errors.New("cannot trade same denomination in and out")
 	}
-	tokensIn := sdk.Coins{tokenIn}
+	// The pool's taker fee is split off the tokens in, and the pool charges the rest of the swap fee
+	// on what remains of them.
+	takerFee, poolTokenIn, poolSwapFee := k.splitTakerFee(ctx, pool.GetId(), tokenIn, swapFee)
+	tokensIn := sdk.Coins{poolTokenIn}
+
+	if err := k.recordCircuitBreakerReferences(ctx, pool, tokenIn.Denom, tokenOutDenom); err != nil {
+		return sdk.Int{}, err
+	}
 
 	// Executes the swap in the pool and stores the output. Updates pool assets but
 	// does not actually transfer any tokens to or from the pool.
-	tokenOutCoin, err := pool.SwapOutAmtGivenIn(ctx, tokensIn, tokenOutDenom, swapFee)
+	tokenOutCoin, err := pool.SwapOutAmtGivenIn(ctx, tokensIn, tokenOutDenom, poolSwapFee)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -68,7 +75,13 @@ func (k Keeper) swapExactAmountIn(
 
 	// Settles balances between the tx sender and the pool to match the swap that was executed earlier.
 	// Also emits swap event and updates related liquidity metrics
-	if err := k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOutCoin); err != nil {
+	if err := k.updatePoolForSwap(ctx, pool, sender, poolTokenIn, tokenOutCoin); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.chargeTakerFee(ctx, sender, pool.GetId(), takerFee); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.checkCircuitBreaker(ctx, pool, tokenIn.Denom, tokenOutDenom); err != nil {
 		return sdk.Int{}, err
 	}
 
@@ -113,7 +126,10 @@ func (k Keeper) swapExactAmountOut(
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrTooManyTokensOut,
 			"can't get more tokens out than there are tokens in the pool")
 	}
-	tokenIn, err := pool.SwapInAmtGivenOut(ctx, sdk.Coins{tokenOut}, tokenInDenom, swapFee)
+	if err := k.recordCircuitBreakerReferences(ctx, pool, tokenInDenom, tokenOut.Denom); err != nil {
+		return sdk.Int{}, err
+	}
+	tokenIn, poolTokenIn, err := k.swapInAmtGivenOutWithTakerFee(ctx, pool, tokenOut, tokenInDenom, swapFee)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -127,13 +143,63 @@ func (k Keeper) swapExactAmountOut(
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "Swap requires %s, which is greater than the amount %s", tokenIn, tokenInMaxAmount)
 	}
 
-	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut)
+	err = k.updatePoolForSwap(ctx, pool, sender, poolTokenIn, tokenOut)
 	if err != nil {
 		return sdk.Int{}, err
 	}
+	if err := k.chargeTakerFee(ctx, sender, pool.GetId(), tokenIn.Sub(poolTokenIn)); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.checkCircuitBreaker(ctx, pool, tokenInDenom, tokenOut.Denom); err != nil {
+		return sdk.Int{}, err
+	}
 	return tokenInAmount, nil
 }
 
+// splitTakerFee splits the taker fee of the pool off tokenIn, which a swap is charged swapFee on.
+// It returns the taker fee, the rest of tokenIn, which goes into the pool,
+// and the swap fee that the pool charges on it.
+func (k Keeper) splitTakerFee(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, swapFee sdk.Dec) (takerFee sdk.Coin, poolTokenIn sdk.Coin, poolSwapFee sdk.Dec) {
+	takerFeeRate := k.GetTakerFee(ctx, poolId)
+	if takerFeeRate.IsZero() || swapFee.IsZero() {
+		return sdk.Coin{Denom: tokenIn.Denom, Amount: sdk.ZeroInt()}, tokenIn, swapFee
+	}
+
+	// The taker fee rounds down, in favor of the pool.
+	takerFeeAmount := tokenIn.Amount.ToDec().Mul(swapFee).Mul(takerFeeRate).TruncateInt()
+	takerFee = sdk.Coin{Denom: tokenIn.Denom, Amount: takerFeeAmount}
+	return takerFee, tokenIn.Sub(takerFee), swapFeeAfterTakerFee(swapFee, takerFeeRate)
+}
+
+// swapInAmtGivenOutWithTakerFee swaps tokens of tokenInDenom in the pool for tokenOut, charging swapFee,
+// and returns the tokens the swap takes in, and those of them that go into the pool.
+// The pool charges a swap fee net of its taker fee on the tokens it gets, and the rest of the tokens
+// the swap takes in are the taker fee. So the swap takes in as many tokens as it would without a taker fee.
+func (k Keeper) swapInAmtGivenOutWithTakerFee(
+	ctx sdk.Context,
+	pool types.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+) (tokenIn sdk.Coin, poolTokenIn sdk.Coin, err error) {
+	takerFeeRate := k.GetTakerFee(ctx, pool.GetId())
+	if takerFeeRate.IsZero() || swapFee.IsZero() {
+		tokenIn, err = pool.SwapInAmtGivenOut(ctx, sdk.Coins{tokenOut}, tokenInDenom, swapFee)
+		return tokenIn, tokenIn, err
+	}
+
+	tokenIn, err = pool.CalcInAmtGivenOut(ctx, sdk.Coins{tokenOut}, tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	// The pool charges a lower swap fee than swapFee, so it never takes in more than tokenIn.
+	poolTokenIn, err = pool.SwapInAmtGivenOut(ctx, sdk.Coins{tokenOut}, tokenInDenom, swapFeeAfterTakerFee(swapFee, takerFeeRate))
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	return tokenIn, poolTokenIn, nil
+}
+
 // updatePoolForSwap takes a pool, sender, and tokenIn, tokenOut amounts
 // It then updates the pool's balances to the new reserve amounts, and
 // sends the in tokens from the sender to the pool, and the out tokens from the pool to the sender.
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// GetTakerFee returns the fraction of the swap fee that is taken from the swaps of the pool with poolId.
+func (k Keeper) GetTakerFee(ctx sdk.Context, poolId uint64) sdk.Dec {
+	return k.GetParams(ctx).GetTakerFee(poolId)
+}
+
+// SetTakerFeeRecipient sets the address that the taker fees of the balancer pool with poolId are sent to,
+// on behalf of its future pool governor, which must be an address and sender.
+// A nil recipient sends them to the community pool.
+func (k Keeper) SetTakerFeeRecipient(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, recipient sdk.AccAddress) error {
+	pool, err := k.getBalancerPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	if err := validateAddressGovernor(pool, sender, "set a taker fee recipient"); err != nil {
+		return err
+	}
+
+	k.setTakerFeeRecipient(ctx, poolId, recipient)
+	return nil
+}
+
+// GetTakerFeeRecipient returns the address that the taker fees of the pool with poolId are sent to,
+// or nil if they are sent to the community pool.
+func (k Keeper) GetTakerFeeRecipient(ctx sdk.Context, poolId uint64) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.GetKeyTakerFeeRecipient(poolId))
+}
+
+func (k Keeper) setTakerFeeRecipient(ctx sdk.Context, poolId uint64, recipient sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if recipient.Empty() {
+		store.Delete(types.GetKeyTakerFeeRecipient(poolId))
+		return
+	}
+	store.Set(types.GetKeyTakerFeeRecipient(poolId), recipient)
+}
+
+// GetTakerFeeRecipients returns the taker fee recipients of all pools that have one.
+func (k Keeper) GetTakerFeeRecipients(ctx sdk.Context) []types.TakerFeeRecipient {
+	iter := k.iterator(ctx, types.KeyPrefixTakerFeeRecipients)
+	defer iter.Close()
+
+	recipients := []types.TakerFeeRecipient{}
+	for ; iter.Valid(); iter.Next() {
+		recipients = append(recipients, types.TakerFeeRecipient{
+			PoolId:    sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixTakerFeeRecipients):]),
+			Recipient: sdk.AccAddress(iter.Value()).String(),
+		})
+	}
+	return recipients
+}
+
+// GetTakerFeesCollected returns all taker fees taken from the swaps of the pool with poolId.
+func (k Keeper) GetTakerFeesCollected(ctx sdk.Context, poolId uint64) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyTakerFeesCollected(poolId))
+	if bz == nil {
+		return sdk.Coins{}
+	}
+
+	var collected types.TakerFeesCollected
+	k.cdc.MustUnmarshal(bz, &collected)
+	return collected.Fees
+}
+
+func (k Keeper) setTakerFeesCollected(ctx sdk.Context, collected types.TakerFeesCollected) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyTakerFeesCollected(collected.PoolId), k.cdc.MustMarshal(&collected))
+}
+
+// GetAllTakerFeesCollected returns the taker fees taken from the swaps of all pools that have had any.
+func (k Keeper) GetAllTakerFeesCollected(ctx sdk.Context) []types.TakerFeesCollected {
+	iter := k.iterator(ctx, types.KeyPrefixTakerFeesCollected)
+	defer iter.Close()
+
+	allCollected := []types.TakerFeesCollected{}
+	for ; iter.Valid(); iter.Next() {
+		var collected types.TakerFeesCollected
+		k.cdc.MustUnmarshal(iter.Value(), &collected)
+		allCollected = append(allCollected, collected)
+	}
+	return allCollected
+}
+
+// swapFeeAfterTakerFee returns the swap fee that a pool charges on the tokens it gets from a swap,
+// once a takerFee fraction of swapFee is taken from the tokens the swap is charged swapFee on.
+// For the pool to leave the swap's price as it is,
+// tokensIn (1 - swapFee) = tokensIn (1 - swapFee takerFee) (1 - poolSwapFee), so
+// poolSwapFee = swapFee (1 - takerFee) / (1 - swapFee takerFee).
+func swapFeeAfterTakerFee(swapFee, takerFee sdk.Dec) sdk.Dec {
+	return swapFee.Mul(sdk.OneDec().Sub(takerFee)).Quo(sdk.OneDec().Sub(swapFee.Mul(takerFee)))
+}
+
+// chargeTakerFee sends takerFee from sender to the taker fee recipient of the pool with poolId,
+// or to the community pool if it has none, and records it in the pool's collected taker fees.
+func (k Keeper) chargeTakerFee(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, takerFee sdk.Coin) error {
+	if !takerFee.IsPositive() {
+		return nil
+	}
+
+	takerFees := sdk.NewCoins(takerFee)
+	recipient := k.GetTakerFeeRecipient(ctx, poolId)
+	if recipient == nil {
+		if err := k.distrKeeper.FundCommunityPool(ctx, takerFees, sender); err != nil {
+			return err
+		}
+	} else if err := k.bankKeeper.SendCoins(ctx, sender, recipient, takerFees); err != nil {
+		return err
+	}
+
+	k.setTakerFeesCollected(ctx, types.TakerFeesCollected{
+		PoolId: poolId,
+		Fees:   k.GetTakerFeesCollected(ctx, poolId).Add(takerFee),
+	})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtTakerFeeCharged,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTakerFee, takerFee.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+	))
+	return nil
+}
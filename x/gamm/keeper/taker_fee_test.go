@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) setTakerFee(takerFee sdk.Dec, poolTakerFees ...types.PoolTakerFee) {
+	params := suite.App.GAMMKeeper.GetParams(suite.Ctx)
+	params.TakerFee = takerFee
+	params.PoolTakerFees = append([]types.PoolTakerFee{}, poolTakerFees...)
+	suite.App.GAMMKeeper.SetParams(suite.Ctx, params)
+}
+
+func (suite *KeeperTestSuite) communityPoolBalance(denom string) sdk.Int {
+	return suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx).AmountOf(denom).TruncateInt()
+}
+
+func (suite *KeeperTestSuite) TestSwapExactAmountInTakerFee() {
+	suite.SetupTest()
+	poolId := suite.prepareGovernedBalancerPool("")
+	suite.setTakerFee(sdk.NewDecWithPrec(5, 1))
+
+	tokenIn := sdk.NewInt64Coin("foo", 1_000)
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	expectedTokenOut, err := pool.CalcOutAmtGivenIn(suite.Ctx, sdk.NewCoins(tokenIn), "bar", defaultSwapFee)
+	suite.Require().NoError(err)
+	poolFooBefore := pool.GetTotalPoolLiquidity(suite.Ctx).AmountOf("foo")
+	communityPoolBefore := suite.communityPoolBalance("foo")
+
+	tokenOutAmount, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, tokenIn, "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+
+	// The trader gets as much as without a taker fee, up to rounding in its favor.
+	suite.Require().True(tokenOutAmount.Sub(expectedTokenOut.Amount).Abs().LTE(sdk.OneInt()),
+		"token out %s, expected %s", tokenOutAmount, expectedTokenOut)
+
+	// Half of the 2.5% swap fee of 1,000 foo is taken, rounded down.
+	expectedTakerFee := sdk.NewInt(12)
+	suite.Require().Equal(expectedTakerFee, suite.communityPoolBalance("foo").Sub(communityPoolBefore))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("foo", expectedTakerFee)), suite.App.GAMMKeeper.GetTakerFeesCollected(suite.Ctx, poolId))
+
+	pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(poolFooBefore.Add(tokenIn.Amount).Sub(expectedTakerFee), pool.GetTotalPoolLiquidity(suite.Ctx).AmountOf("foo"))
+}
+
+func (suite *KeeperTestSuite) TestSwapExactAmountOutTakerFee() {
+	suite.SetupTest()
+	poolId := suite.prepareGovernedBalancerPool("")
+	suite.setTakerFee(sdk.NewDecWithPrec(5, 1))
+
+	tokenOut := sdk.NewInt64Coin("bar", 1_000)
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	expectedTokenIn, err := pool.CalcInAmtGivenOut(suite.Ctx, sdk.NewCoins(tokenOut), "foo", defaultSwapFee)
+	suite.Require().NoError(err)
+	poolFooBefore := pool.GetTotalPoolLiquidity(suite.Ctx).AmountOf("foo")
+	communityPoolBefore := suite.communityPoolBalance("foo")
+
+	// The trader pays as much as without a taker fee, so the max can be exactly that.
+	tokenInAmount, err := suite.App.GAMMKeeper.SwapExactAmountOut(suite.Ctx, suite.TestAccs[0], poolId, "foo", expectedTokenIn.Amount, tokenOut)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTokenIn.Amount, tokenInAmount)
+
+	// About half of the 2.5% swap fee of the tokens in is taken.
+	takerFee := suite.communityPoolBalance("foo").Sub(communityPoolBefore)
+	approxTakerFee := tokenInAmount.ToDec().Mul(defaultSwapFee).QuoInt64(2).TruncateInt()
+	suite.Require().True(takerFee.Sub(approxTakerFee).Abs().LTE(sdk.OneInt()), "taker fee %s, expected about %s", takerFee, approxTakerFee)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("foo", takerFee)), suite.App.GAMMKeeper.GetTakerFeesCollected(suite.Ctx, poolId))
+
+	pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(poolFooBefore.Add(tokenInAmount).Sub(takerFee), pool.GetTotalPoolLiquidity(suite.Ctx).AmountOf("foo"))
+}
+
+func (suite *KeeperTestSuite) TestPoolTakerFeeOverride() {
+	suite.SetupTest()
+	poolId := suite.prepareGovernedBalancerPool("")
+	otherPoolId := suite.prepareGovernedBalancerPool("")
+	suite.setTakerFee(sdk.NewDecWithPrec(5, 1), types.PoolTakerFee{PoolId: poolId, TakerFee: sdk.ZeroDec()})
+
+	suite.Require().Equal(sdk.ZeroDec(), suite.App.GAMMKeeper.GetTakerFee(suite.Ctx, poolId))
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), suite.App.GAMMKeeper.GetTakerFee(suite.Ctx, otherPoolId))
+
+	_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewInt64Coin("foo", 1_000), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.GAMMKeeper.GetTakerFeesCollected(suite.Ctx, poolId).Empty())
+
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], otherPoolId, sdk.NewInt64Coin("foo", 1_000), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().False(suite.App.GAMMKeeper.GetTakerFeesCollected(suite.Ctx, otherPoolId).Empty())
+}
+
+func (suite *KeeperTestSuite) TestSetTakerFeeRecipient() {
+	suite.SetupTest()
+	governor := suite.TestAccs[0]
+	recipient := suite.TestAccs[2]
+	poolId := suite.prepareGovernedBalancerPool(governor.String())
+	suite.setTakerFee(sdk.NewDecWithPrec(5, 1))
+	suite.FundAcc(suite.TestAccs[1], defaultAcctFunds)
+
+	err := suite.App.GAMMKeeper.SetTakerFeeRecipient(suite.Ctx, suite.TestAccs[1], poolId, recipient)
+	suite.Require().ErrorIs(err, types.ErrNotPoolGovernor)
+
+	lockGovernedPoolId := suite.prepareGovernedBalancerPool((24 * time.Hour).String())
+	err = suite.App.GAMMKeeper.SetTakerFeeRecipient(suite.Ctx, governor, lockGovernedPoolId, recipient)
+	suite.Require().ErrorIs(err, types.ErrNotPoolGovernor)
+
+	err = suite.App.GAMMKeeper.SetTakerFeeRecipient(suite.Ctx, governor, poolId, recipient)
+	suite.Require().NoError(err)
+	suite.Require().Equal(recipient, suite.App.GAMMKeeper.GetTakerFeeRecipient(suite.Ctx, poolId))
+	suite.Require().Equal([]types.TakerFeeRecipient{{PoolId: poolId, Recipient: recipient.String()}},
+		suite.App.GAMMKeeper.GetTakerFeeRecipients(suite.Ctx))
+
+	recipientBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, "foo").Amount
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[1], poolId, sdk.NewInt64Coin("foo", 1_000), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(12), suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, "foo").Amount.Sub(recipientBefore))
+
+	// Clearing the recipient sends the taker fees to the community pool again.
+	err = suite.App.GAMMKeeper.SetTakerFeeRecipient(suite.Ctx, governor, poolId, nil)
+	suite.Require().NoError(err)
+	suite.Require().Nil(suite.App.GAMMKeeper.GetTakerFeeRecipient(suite.Ctx, poolId))
+	communityPoolBefore := suite.communityPoolBalance("foo")
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[1], poolId, sdk.NewInt64Coin("foo", 1_000), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().True(suite.communityPoolBalance("foo").GT(communityPoolBefore))
+}
+
+func (suite *KeeperTestSuite) TestQueryTakerFee() {
+	suite.SetupTest()
+	queryClient := suite.queryClient
+	governor := suite.TestAccs[0]
+	poolId := suite.prepareGovernedBalancerPool(governor.String())
+	suite.setTakerFee(sdk.NewDecWithPrec(5, 1))
+	suite.FundAcc(suite.TestAccs[1], defaultAcctFunds)
+
+	res, err := queryClient.TakerFee(gocontext.Background(), &types.QueryTakerFeeRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), res.TakerFee)
+	suite.Require().Equal("", res.Recipient)
+	suite.Require().True(res.FeesCollected.Empty())
+
+	msgServer := keeper.NewBalancerMsgServerImpl(suite.App.GAMMKeeper)
+	_, err = msgServer.SetTakerFeeRecipient(sdk.WrapSDKContext(suite.Ctx), &balancer.MsgSetTakerFeeRecipient{
+		Sender:    governor.String(),
+		PoolId:    poolId,
+		Recipient: suite.TestAccs[2].String(),
+	})
+	suite.Require().NoError(err)
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[1], poolId, sdk.NewInt64Coin("foo", 1_000), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+
+	res, err = queryClient.TakerFee(gocontext.Background(), &types.QueryTakerFeeRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.TestAccs[2].String(), res.Recipient)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 12)), res.FeesCollected)
+
+	_, err = queryClient.TakerFee(gocontext.Background(), &types.QueryTakerFeeRequest{PoolId: poolId + 1})
+	suite.Require().Error(err)
+
+	// The taker fee state survives a genesis export and import.
+	genesis := suite.App.GAMMKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Equal([]types.TakerFeeRecipient{{PoolId: poolId, Recipient: suite.TestAccs[2].String()}}, genesis.TakerFeeRecipients)
+	suite.Require().Equal([]types.TakerFeesCollected{{PoolId: poolId, Fees: res.FeesCollected}}, genesis.TakerFeesCollected)
+	suite.Require().NoError(genesis.Validate())
+}
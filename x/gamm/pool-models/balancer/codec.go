@@ -15,6 +15,10 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Pool{}, "osmosis/gamm/BalancerPool", nil)
 	cdc.RegisterConcrete(&MsgCreateBalancerPool{}, "osmosis/gamm/create-balancer-pool", nil)
+	cdc.RegisterConcrete(&MsgUpdatePoolParams{}, "osmosis/gamm/update-pool-params", nil)
+	cdc.RegisterConcrete(&MsgScheduleWeightChange{}, "osmosis/gamm/schedule-weight-change", nil)
+	cdc.RegisterConcrete(&MsgSetTakerFeeRecipient{}, "osmosis/gamm/set-taker-fee-recipient", nil)
+	cdc.RegisterConcrete(&MsgSetPoolPause{}, "osmosis/gamm/set-pool-pause", nil)
 	cdc.RegisterConcrete(&PoolParams{}, "osmosis/gamm/BalancerPoolParams", nil)
 }
 
@@ -27,10 +31,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgCreateBalancerPool{},
+		&MsgUpdatePoolParams{},
+		&MsgScheduleWeightChange{},
+		&MsgSetTakerFeeRecipient{},
+		&MsgSetPoolPause{},
 	)
 	registry.RegisterImplementations(
 		(*proto.Message)(nil),
 		&PoolParams{},
+		&SmoothWeightChangeParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
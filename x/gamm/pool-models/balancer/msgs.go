@@ -8,12 +8,20 @@ import (
 )
 
 const (
-	TypeMsgCreateBalancerPool = "create_balancer_pool"
+	TypeMsgCreateBalancerPool   = "create_balancer_pool"
+	TypeMsgUpdatePoolParams     = "update_pool_params"
+	TypeMsgScheduleWeightChange = "schedule_weight_change"
+	TypeMsgSetTakerFeeRecipient = "set_taker_fee_recipient"
+	TypeMsgSetPoolPause         = "set_pool_pause"
 )
 
 var (
 	_ sdk.Msg             = &MsgCreateBalancerPool{}
 	_ types.CreatePoolMsg = &MsgCreateBalancerPool{}
+	_ sdk.Msg             = &MsgUpdatePoolParams{}
+	_ sdk.Msg             = &MsgScheduleWeightChange{}
+	_ sdk.Msg             = &MsgSetTakerFeeRecipient{}
+	_ sdk.Msg             = &MsgSetPoolPause{}
 )
 
 func NewMsgCreateBalancerPool(
@@ -98,3 +106,151 @@ func (msg MsgCreateBalancerPool) CreatePool(ctx sdk.Context, poolID uint64) (typ
 	poolI, err := NewBalancerPool(poolID, *msg.PoolParams, msg.PoolAssets, msg.FuturePoolGovernor, ctx.BlockTime())
 	return &poolI, err
 }
+
+func NewMsgUpdatePoolParams(sender sdk.AccAddress, poolId uint64, poolParams PoolParams) MsgUpdatePoolParams {
+	return MsgUpdatePoolParams{
+		Sender:     sender.String(),
+		PoolId:     poolId,
+		PoolParams: poolParams,
+	}
+}
+
+func (msg MsgUpdatePoolParams) Route() string { return types.RouterKey }
+func (msg MsgUpdatePoolParams) Type() string  { return TypeMsgUpdatePoolParams }
+func (msg MsgUpdatePoolParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	// The target weights are validated against the pool's assets in the keeper.
+	if msg.PoolParams.SmoothWeightChangeParams != nil {
+		return msg.PoolParams.Validate(msg.PoolParams.SmoothWeightChangeParams.TargetPoolWeights)
+	}
+	return msg.PoolParams.Validate(nil)
+}
+
+func (msg MsgUpdatePoolParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdatePoolParams) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func NewMsgScheduleWeightChange(sender sdk.AccAddress, poolId uint64, params SmoothWeightChangeParams) MsgScheduleWeightChange {
+	return MsgScheduleWeightChange{
+		Sender:                   sender.String(),
+		PoolId:                   poolId,
+		SmoothWeightChangeParams: params,
+	}
+}
+
+func (msg MsgScheduleWeightChange) Route() string { return types.RouterKey }
+func (msg MsgScheduleWeightChange) Type() string  { return TypeMsgScheduleWeightChange }
+func (msg MsgScheduleWeightChange) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	// The fees are kept, so only the target weights are validated here,
+	// against the pool's assets in the keeper.
+	params := PoolParams{
+		SwapFee:                  sdk.ZeroDec(),
+		ExitFee:                  sdk.ZeroDec(),
+		SmoothWeightChangeParams: &msg.SmoothWeightChangeParams,
+	}
+	return params.Validate(msg.SmoothWeightChangeParams.TargetPoolWeights)
+}
+
+func (msg MsgScheduleWeightChange) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgScheduleWeightChange) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgSetTakerFeeRecipient returns a MsgSetTakerFeeRecipient,
+// which sends the pool's taker fees to the community pool if recipient is nil.
+func NewMsgSetTakerFeeRecipient(sender sdk.AccAddress, poolId uint64, recipient sdk.AccAddress) MsgSetTakerFeeRecipient {
+	msg := MsgSetTakerFeeRecipient{
+		Sender: sender.String(),
+		PoolId: poolId,
+	}
+	if !recipient.Empty() {
+		msg.Recipient = recipient.String()
+	}
+	return msg
+}
+
+func (msg MsgSetTakerFeeRecipient) Route() string { return types.RouterKey }
+func (msg MsgSetTakerFeeRecipient) Type() string  { return TypeMsgSetTakerFeeRecipient }
+func (msg MsgSetTakerFeeRecipient) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.Recipient == "" {
+		return nil
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+	}
+	return nil
+}
+
+func (msg MsgSetTakerFeeRecipient) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetTakerFeeRecipient) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func NewMsgSetPoolPause(sender sdk.AccAddress, poolId uint64, swapsPaused, joinsPaused, exitsPaused bool) MsgSetPoolPause {
+	return MsgSetPoolPause{
+		Sender:      sender.String(),
+		PoolId:      poolId,
+		SwapsPaused: swapsPaused,
+		JoinsPaused: joinsPaused,
+		ExitsPaused: exitsPaused,
+	}
+}
+
+func (msg MsgSetPoolPause) Route() string { return types.RouterKey }
+func (msg MsgSetPoolPause) Type() string  { return TypeMsgSetPoolPause }
+func (msg MsgSetPoolPause) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (msg MsgSetPoolPause) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetPoolPause) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -2,6 +2,7 @@ package balancer
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -239,3 +240,198 @@ func TestMsgCreateBalancerPool(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgUpdatePoolParams(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgUpdatePoolParams) MsgUpdatePoolParams) MsgUpdatePoolParams {
+		msg := NewMsgUpdatePoolParams(addr1, 1, PoolParams{
+			SwapFee: sdk.NewDecWithPrec(1, 2),
+			ExitFee: sdk.NewDecWithPrec(1, 2),
+		})
+		return after(msg)
+	}
+
+	default_msg := createMsg(func(msg MsgUpdatePoolParams) MsgUpdatePoolParams {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, default_msg.Route(), types.RouterKey)
+	require.Equal(t, default_msg.Type(), "update_pool_params")
+	signers := default_msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        MsgUpdatePoolParams
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgUpdatePoolParams) MsgUpdatePoolParams {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgUpdatePoolParams) MsgUpdatePoolParams {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative swap fee",
+			msg: createMsg(func(msg MsgUpdatePoolParams) MsgUpdatePoolParams {
+				msg.PoolParams.SwapFee = sdk.NewDecWithPrec(-1, 2)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "too large exit fee",
+			msg: createMsg(func(msg MsgUpdatePoolParams) MsgUpdatePoolParams {
+				msg.PoolParams.ExitFee = sdk.OneDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "smooth weight change",
+			msg: createMsg(func(msg MsgUpdatePoolParams) MsgUpdatePoolParams {
+				msg.PoolParams.SmoothWeightChangeParams = &SmoothWeightChangeParams{
+					Duration: time.Hour,
+					TargetPoolWeights: []PoolAsset{
+						{
+							Weight: sdk.NewInt(200),
+							Token:  sdk.NewCoin("test", sdk.ZeroInt()),
+						},
+						{
+							Weight: sdk.NewInt(50),
+							Token:  sdk.NewCoin("test2", sdk.ZeroInt()),
+						},
+					},
+				}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "smooth weight change with a zero target weight",
+			msg: createMsg(func(msg MsgUpdatePoolParams) MsgUpdatePoolParams {
+				msg.PoolParams.SmoothWeightChangeParams = &SmoothWeightChangeParams{
+					Duration: time.Hour,
+					TargetPoolWeights: []PoolAsset{
+						{
+							Weight: sdk.ZeroInt(),
+							Token:  sdk.NewCoin("test", sdk.ZeroInt()),
+						},
+						{
+							Weight: sdk.NewInt(50),
+							Token:  sdk.NewCoin("test2", sdk.ZeroInt()),
+						},
+					},
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgScheduleWeightChange(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgScheduleWeightChange) MsgScheduleWeightChange) MsgScheduleWeightChange {
+		msg := NewMsgScheduleWeightChange(addr1, 1, SmoothWeightChangeParams{
+			Duration: time.Hour,
+			TargetPoolWeights: []PoolAsset{
+				{
+					Weight: sdk.NewInt(200),
+					Token:  sdk.NewCoin("test", sdk.ZeroInt()),
+				},
+				{
+					Weight: sdk.NewInt(50),
+					Token:  sdk.NewCoin("test2", sdk.ZeroInt()),
+				},
+			},
+		})
+		return after(msg)
+	}
+
+	default_msg := createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, default_msg.Route(), types.RouterKey)
+	require.Equal(t, default_msg.Type(), "schedule_weight_change")
+	signers := default_msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        MsgScheduleWeightChange
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero duration",
+			msg: createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+				msg.SmoothWeightChangeParams.Duration = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "too large of a target weight",
+			msg: createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+				msg.SmoothWeightChangeParams.TargetPoolWeights[0].Weight = sdk.NewInt(1 << 21)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -208,6 +208,28 @@ func (p *Pool) setInitialPoolParams(params PoolParams, sortedAssets []PoolAsset,
 	return nil
 }
 
+// UpdatePoolParams replaces the pool's params with params. The pool is poked at blockTime first,
+// so that a new smooth weight change starts from the pool's current weights,
+// and one in progress stops at them if params have none.
+func (p *Pool) UpdatePoolParams(params PoolParams, blockTime time.Time) error {
+	p.PokePool(blockTime)
+
+	sortedPoolAssets := p.GetAllPoolAssets()
+	err := params.Validate(sortedPoolAssets)
+	if err != nil {
+		return err
+	}
+
+	if params.SmoothWeightChangeParams != nil {
+		startTime := params.SmoothWeightChangeParams.StartTime
+		if startTime.Unix() > 0 && startTime.Before(blockTime) {
+			return fmt.Errorf("smooth weight change start time %s is before the block time %s", startTime, blockTime)
+		}
+	}
+
+	return p.setInitialPoolParams(params, sortedPoolAssets, blockTime)
+}
+
 // GetPoolAssets returns the denom's PoolAsset, If the PoolAsset doesn't exist, will return error.
 // As above, it will search the denom's PoolAsset by using binary search.
 // So, it is important to make sure that the PoolAssets are sorted.
@@ -428,8 +450,7 @@ func (p *Pool) PokePool(blockTime time.Time) {
 	default:
 		// case 3: t > start_time + duration: w(t) = target_pool_weights
 
-		shiftedBlockTime := blockTime.Sub(params.StartTime).Milliseconds()
-		percentDurationElapsed := sdk.NewDec(shiftedBlockTime).QuoInt64(params.Duration.Milliseconds())
+		percentDurationElapsed := params.Progress(blockTime)
 
 		// If the duration elapsed is equal to the total time, or a rounding error
 		// makes it seem like it is, just set to target weight.
@@ -2,6 +2,7 @@ package balancer
 
 import (
 	"errors"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -76,3 +77,21 @@ func (params PoolParams) GetPoolSwapFee() sdk.Dec {
 func (params PoolParams) GetPoolExitFee() sdk.Dec {
 	return params.ExitFee
 }
+
+// EndTime returns the time at which the pool reaches its target weights.
+func (params SmoothWeightChangeParams) EndTime() time.Time {
+	return params.StartTime.Add(params.Duration)
+}
+
+// Progress returns the elapsed fraction of the weight change's duration at blockTime,
+// from 0 at its start time to 1 at its end time, as interpolated by PokePool.
+func (params SmoothWeightChangeParams) Progress(blockTime time.Time) sdk.Dec {
+	if !blockTime.After(params.StartTime) {
+		return sdk.ZeroDec()
+	}
+	if !blockTime.Before(params.EndTime()) {
+		return sdk.OneDec()
+	}
+	shiftedBlockTime := blockTime.Sub(params.StartTime).Milliseconds()
+	return sdk.NewDec(shiftedBlockTime).QuoInt64(params.Duration.Milliseconds())
+}
@@ -1225,3 +1225,176 @@ func TestBalancerPoolPokeTokenWeights(t *testing.T) {
 		require.Nil(t, pacc.PoolParams.SmoothWeightChangeParams)
 	}
 }
+
+func TestBalancerPoolUpdatePoolParams(t *testing.T) {
+	initialPoolAssets := []balancer.PoolAsset{
+		{
+			Weight: sdk.NewInt(1),
+			Token:  sdk.NewCoin("asset1", sdk.NewInt(1000)),
+		},
+		{
+			Weight: sdk.NewInt(1),
+			Token:  sdk.NewCoin("asset2", sdk.NewInt(1000)),
+		},
+	}
+	targetPoolWeights := []balancer.PoolAsset{
+		{
+			Weight: sdk.NewInt(1),
+			Token:  sdk.NewCoin("asset1", sdk.NewInt(0)),
+		},
+		{
+			Weight: sdk.NewInt(3),
+			Token:  sdk.NewCoin("asset2", sdk.NewInt(0)),
+		},
+	}
+	newSwapFee := sdk.MustNewDecFromStr("0.01")
+
+	tests := []struct {
+		name            string
+		params          balancer.PoolParams
+		blockTime       time.Time
+		expectedWeights []sdk.Int
+		expectErr       bool
+	}{
+		{
+			name:            "fees only",
+			params:          balancer.PoolParams{SwapFee: newSwapFee, ExitFee: defaultExitFee},
+			blockTime:       defaultCurBlockTime,
+			expectedWeights: []sdk.Int{sdk.NewInt(1 * balancer.GuaranteedWeightPrecision), sdk.NewInt(1 * balancer.GuaranteedWeightPrecision)},
+		},
+		{
+			name: "smooth weight change starting at the block time",
+			params: balancer.PoolParams{SwapFee: newSwapFee, ExitFee: defaultExitFee, SmoothWeightChangeParams: &balancer.SmoothWeightChangeParams{
+				Duration:          100 * time.Second,
+				TargetPoolWeights: targetPoolWeights,
+			}},
+			// Halfway through, from 1:1 to 1:3
+			blockTime:       defaultCurBlockTime.Add(50 * time.Second),
+			expectedWeights: []sdk.Int{sdk.NewInt(1 * balancer.GuaranteedWeightPrecision), sdk.NewInt(2 * balancer.GuaranteedWeightPrecision)},
+		},
+		{
+			name:      "invalid swap fee",
+			params:    balancer.PoolParams{SwapFee: sdk.OneDec(), ExitFee: defaultExitFee},
+			expectErr: true,
+		},
+		{
+			name: "missing target weight",
+			params: balancer.PoolParams{SwapFee: newSwapFee, ExitFee: defaultExitFee, SmoothWeightChangeParams: &balancer.SmoothWeightChangeParams{
+				Duration:          100 * time.Second,
+				TargetPoolWeights: targetPoolWeights[:1],
+			}},
+			expectErr: true,
+		},
+		{
+			name: "start time before the block time",
+			params: balancer.PoolParams{SwapFee: newSwapFee, ExitFee: defaultExitFee, SmoothWeightChangeParams: &balancer.SmoothWeightChangeParams{
+				StartTime:         defaultCurBlockTime.Add(-time.Second),
+				Duration:          100 * time.Second,
+				TargetPoolWeights: targetPoolWeights,
+			}},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pacc, err := balancer.NewBalancerPool(defaultPoolId, defaultBalancerPoolParams, initialPoolAssets, defaultFutureGovernor, defaultCurBlockTime)
+			require.NoError(t, err)
+
+			err = pacc.UpdatePoolParams(tc.params, defaultCurBlockTime)
+			if tc.expectErr {
+				require.Error(t, err)
+				require.Equal(t, defaultSwapFee, pacc.GetSwapFee(sdk.Context{}))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, newSwapFee, pacc.GetSwapFee(sdk.Context{}))
+
+			pacc.PokePool(tc.blockTime)
+			for i, asset := range pacc.GetAllPoolAssets() {
+				require.Equal(t, tc.expectedWeights[i], asset.Weight)
+			}
+		})
+	}
+}
+
+// TestBalancerPoolRescheduleWeightChange tests that a weight change scheduled while another is in progress
+// continues from the weights PokePool interpolated, rather than from the previous schedule's initial weights.
+func TestBalancerPoolRescheduleWeightChange(t *testing.T) {
+	defaultDuration := 100 * time.Second
+	initialPoolAssets := []balancer.PoolAsset{
+		{
+			Weight: sdk.NewInt(1),
+			Token:  sdk.NewCoin("asset1", sdk.NewInt(1000)),
+		},
+		{
+			Weight: sdk.NewInt(1),
+			Token:  sdk.NewCoin("asset2", sdk.NewInt(1000)),
+		},
+	}
+	targetPoolWeights := func(weight1, weight2 int64) []balancer.PoolAsset {
+		return []balancer.PoolAsset{
+			{
+				Weight: sdk.NewInt(weight1),
+				Token:  sdk.NewCoin("asset1", sdk.NewInt(0)),
+			},
+			{
+				Weight: sdk.NewInt(weight2),
+				Token:  sdk.NewCoin("asset2", sdk.NewInt(0)),
+			},
+		}
+	}
+	requireWeights := func(pacc balancer.Pool, weight1, weight2 sdk.Int) {
+		assets := pacc.GetAllPoolAssets()
+		require.Equal(t, weight1, assets[0].Weight)
+		require.Equal(t, weight2, assets[1].Weight)
+	}
+	precision := int64(balancer.GuaranteedWeightPrecision)
+
+	// 1:1 to 1:3 from the creation time.
+	pacc, err := balancer.NewBalancerPool(defaultPoolId, balancer.NewPoolParams(defaultSwapFee, defaultExitFee, &balancer.SmoothWeightChangeParams{
+		Duration:          defaultDuration,
+		TargetPoolWeights: targetPoolWeights(1, 3),
+	}), initialPoolAssets, defaultFutureGovernor, defaultCurBlockTime)
+	require.NoError(t, err)
+
+	halfway := defaultCurBlockTime.Add(defaultDuration / 2)
+	pacc.PokePool(halfway)
+	requireWeights(pacc, sdk.NewInt(precision), sdk.NewInt(2*precision))
+
+	// 1:2 to 3:1 from halfway through the first weight change.
+	err = pacc.UpdatePoolParams(balancer.NewPoolParams(defaultSwapFee, defaultExitFee, &balancer.SmoothWeightChangeParams{
+		Duration:          defaultDuration,
+		TargetPoolWeights: targetPoolWeights(3, 1),
+	}), halfway)
+	require.NoError(t, err)
+	require.Equal(t, halfway, pacc.PoolParams.SmoothWeightChangeParams.StartTime)
+	require.Equal(t, halfway.Add(defaultDuration), pacc.PoolParams.SmoothWeightChangeParams.EndTime())
+
+	// Poking at the first weight change's end time is halfway through the second one.
+	pacc.PokePool(defaultCurBlockTime.Add(defaultDuration))
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), pacc.PoolParams.SmoothWeightChangeParams.Progress(defaultCurBlockTime.Add(defaultDuration)))
+	requireWeights(pacc, sdk.NewInt(2*precision), sdk.NewInt(3*precision/2))
+
+	pacc.PokePool(halfway.Add(defaultDuration))
+	requireWeights(pacc, sdk.NewInt(3*precision), sdk.NewInt(precision))
+	pacc.PokePool(halfway.Add(defaultDuration + time.Second))
+	requireWeights(pacc, sdk.NewInt(3*precision), sdk.NewInt(precision))
+	require.Nil(t, pacc.PoolParams.SmoothWeightChangeParams)
+
+	// A weight change can be scheduled again once the previous one ended, starting later.
+	startTime := halfway.Add(2 * defaultDuration)
+	err = pacc.UpdatePoolParams(balancer.NewPoolParams(defaultSwapFee, defaultExitFee, &balancer.SmoothWeightChangeParams{
+		StartTime:         startTime,
+		Duration:          defaultDuration,
+		TargetPoolWeights: targetPoolWeights(1, 1),
+	}), halfway.Add(defaultDuration+time.Second))
+	require.NoError(t, err)
+
+	pacc.PokePool(startTime)
+	requireWeights(pacc, sdk.NewInt(3*precision), sdk.NewInt(precision))
+	require.Equal(t, sdk.ZeroDec(), pacc.PoolParams.SmoothWeightChangeParams.Progress(startTime))
+
+	pacc.PokePool(startTime.Add(defaultDuration / 4))
+	requireWeights(pacc, sdk.NewInt(5*precision/2), sdk.NewInt(precision))
+}
@@ -4,7 +4,10 @@ import (
 	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/osmosis-labs/osmosis/v7/osmomath"
+	"github.com/osmosis-labs/osmosis/v7/osmoutils"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/internal/cfmm_common"
 	types "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
@@ -259,6 +262,66 @@ func solveCfmmMulti(xReserve, yReserve, wSumSquares, yIn sdk.Dec) sdk.Dec {
 	return a
 }
 
+const cfmmNewtonMaxIterations = 255
+
+var (
+	// Newton's method converges quadratically, so once its step is at most 10^{-16},
+	// the estimate is within the rounding error of osmomath.BigDec of the root.
+	cfmmNewtonConvergenceThreshold = osmomath.NewDecWithPrec(1, 16)
+	// cfmmNewtonErrBound is subtracted from the amount out solved for by solveCfmmNewtonMulti,
+	// so that it is never more than the exact one, and it rounds in the pool's favor.
+	cfmmNewtonErrBound = osmomath.NewDecWithPrec(1, 12)
+)
+
+// solveCfmmNewton is solveCfmmNewtonMulti for a two asset pool, whose CFMM is xy(x^2 + y^2) = k.
+func solveCfmmNewton(xReserve, yReserve, yIn sdk.Dec) (sdk.Dec, error) {
+	return solveCfmmNewtonMulti(xReserve, yReserve, sdk.OneDec(), sdk.ZeroDec(), yIn)
+}
+
+// solveCfmmNewtonMulti solves the multi-asset CFMM xyu(x^2 + y^2 + w) = k for how many units `a` of x come out
+// of the pool, for a given addition of `b` units of y into it, as solveCfmmMulti does in closed form.
+// A negative b takes y out of the pool, for a negative a.
+// With y' = y + b, the new reserve x' = x - a is the root of
+// f(x') = y'u x'(x'^2 + y'^2 + w) - k, with f'(x') = y'u (3x'^2 + y'^2 + w),
+// which is increasing and convex for x' > 0. So Newton's method x' = x' - f(x') / f'(x'),
+// starting at an x' with f(x') >= 0, decreases to the root.
+// We start at x for b >= 0, and otherwise at k / (y'u (y'^2 + w)), which is at least x' as x'^3 >= 0.
+//
+// It computes in osmomath.BigDec, which can't overflow for reserves below 2^100,
+// and returns a that is less than the exact one by at most 10^{-12} + 10^{-16},
+// so that the pool never gives out more, or takes in less, than it should.
+func solveCfmmNewtonMulti(xReserve, yReserve, uReserve, wSumSquares, yIn sdk.Dec) (sdk.Dec, error) {
+	if !yReserve.Add(yIn).IsPositive() {
+		return sdk.Dec{}, errors.New("invalid yReserve, yIn combo")
+	}
+
+	x := osmomath.BigDecFromSDKDec(xReserve)
+	y := osmomath.BigDecFromSDKDec(yReserve)
+	u := osmomath.BigDecFromSDKDec(uReserve)
+	w := osmomath.BigDecFromSDKDec(wSumSquares)
+	yf := y.Add(osmomath.BigDecFromSDKDec(yIn))
+
+	k := x.Mul(y).Mul(u).Mul(x.Mul(x).Add(y.Mul(y)).Add(w))
+	c := yf.Mul(u)
+	yf2PlusW := yf.Mul(yf).Add(w)
+
+	xf := x
+	if yIn.IsNegative() {
+		xf = k.Quo(c.Mul(yf2PlusW))
+	}
+	for i := 0; i < cfmmNewtonMaxIterations; i++ {
+		xf2 := xf.Mul(xf)
+		f := c.Mul(xf).Mul(xf2.Add(yf2PlusW)).Sub(k)
+		fPrime := c.Mul(xf2.MulInt64(3).Add(yf2PlusW))
+		step := f.Quo(fPrime)
+		xf = xf.Sub(step)
+		if step.Abs().LTE(cfmmNewtonConvergenceThreshold) {
+			return x.Sub(xf).Sub(cfmmNewtonErrBound).SDKDec(), nil
+		}
+	}
+	return sdk.Dec{}, errors.New("stableswap cfmm did not converge")
+}
+
 func approxDecEqual(a, b, tol sdk.Dec) bool {
 	diff := a.Sub(b).Abs()
 	return diff.Quo(a).LTE(tol) && diff.Quo(b).LTE(tol)
@@ -315,7 +378,7 @@ func solveCFMMBinarySearchMulti(constantFunction func(sdk.Dec, sdk.Dec, sdk.Dec,
 	}
 }
 
-func spotPrice(baseReserve, quoteReserve sdk.Dec) sdk.Dec {
+func (p Pool) spotPrice(baseDenom, quoteDenom string) (sdk.Dec, error) {
 	// y = baseAsset, x = quoteAsset
 	// Define f_{y -> x}(a) as the function that outputs the amount of tokens X you'd get by
 	// trading "a" units of Y against the pool, assuming 0 swap fee, at the current liquidity.
@@ -324,7 +387,8 @@ func spotPrice(baseReserve, quoteReserve sdk.Dec) sdk.Dec {
 	// The spot price equation of y in terms of x is X_SUPPLY/Y_SUPPLY.
 	// You can work out that it follows from the above relation!
 	//
-	// Now we have to work this out for the much more complex CFMM xy(x^2 + y^2).
+	// Now we have to work this out for the much more complex CFMM xy(x^2 + y^2),
+	// or Curve's StableSwap CFMM.
 	// Or we can sidestep this, by just picking a small value a, and computing f_{y -> x}(a) / a,
 	// and accept the precision error.
 
@@ -332,44 +396,223 @@ func spotPrice(baseReserve, quoteReserve sdk.Dec) sdk.Dec {
 	// xReserve & yReserve.
 	a := sdk.OneDec()
 	// no need to divide by a, since a = 1.
-	return solveCfmm(baseReserve, quoteReserve, a)
+	return p.solveScaledCfmm(baseDenom, quoteDenom, a)
+}
+
+// Curve's StableSwap CFMM, for n assets with reserves x_i, is
+// A n^n sum(x_i) + D = A n^n D + D^(n+1) / (n^n prod(x_i))
+// where A is the amplification parameter, and D is the invariant,
+// which equals the sum of the reserves when they are all equal.
+// The higher A is, the closer the curve gets to the constant sum sum(x_i) = D around the peg.
+// Both D and the reserves that keep it are solved for with Newton's method, as in Curve's implementation.
+
+const curveMaxIterations = 255
+
+// Newton's method converges quadratically, so once successive estimates are within a factor of 10^{-15},
+// the estimate is correct well within the precision of sdk.Dec.
+var curveConvergenceThreshold = sdk.NewDecWithPrec(1, 15)
+
+// curveAnn returns A n^n, for n assets.
+func curveAnn(amplification uint64, n int) sdk.Dec {
+	ann := sdk.NewDecFromInt(sdk.NewIntFromUint64(amplification))
+	for i := 0; i < n; i++ {
+		ann = ann.MulInt64(int64(n))
+	}
+	return ann
+}
+
+// solveCurveInvariant returns the invariant D of the reserves under Curve's StableSwap CFMM.
+// Newton's method iterates D = (A n^n S + n D_P) D / ((A n^n - 1) D + (n + 1) D_P),
+// where S is the sum of the reserves, and D_P = D^(n+1) / (n^n prod(x_i)), starting at D = S.
+func solveCurveInvariant(amplification uint64, reserves []sdk.Dec) (sdk.Dec, error) {
+	n := len(reserves)
+	ann := curveAnn(amplification, n)
+	sum := sdk.ZeroDec()
+	for _, reserve := range reserves {
+		if !reserve.IsPositive() {
+			return sdk.Dec{}, errors.New("stableswap curve reserves must be positive")
+		}
+		sum = sum.Add(reserve)
+	}
+
+	d := sum
+	for i := 0; i < curveMaxIterations; i++ {
+		dP := d
+		for _, reserve := range reserves {
+			dP = dP.Mul(d).Quo(reserve.MulInt64(int64(n)))
+		}
+		prevD := d
+		numerator := ann.Mul(sum).Add(dP.MulInt64(int64(n))).Mul(d)
+		denominator := ann.Sub(sdk.OneDec()).Mul(d).Add(dP.MulInt64(int64(n + 1)))
+		d = numerator.Quo(denominator)
+		if approxDecEqual(d, prevD, curveConvergenceThreshold) {
+			return d, nil
+		}
+	}
+	return sdk.Dec{}, errors.New("stableswap curve invariant did not converge")
+}
+
+// solveCurveReserve returns the reserve y of an asset that keeps the invariant d under Curve's StableSwap CFMM,
+// given the reserves of all of the other assets.
+// With S' and P' being their sum and product, y solves y^2 + (b - D) y = c,
+// for b = S' + D / (A n^n) and c = D^(n+1) / (n^n P' A n^n).
+// Newton's method iterates y = (y^2 + c) / (2y + b - D), starting at y = D.
+func solveCurveReserve(amplification uint64, d sdk.Dec, otherReserves []sdk.Dec) (sdk.Dec, error) {
+	n := len(otherReserves) + 1
+	ann := curveAnn(amplification, n)
+	sum := sdk.ZeroDec()
+	c := d
+	for _, reserve := range otherReserves {
+		if !reserve.IsPositive() {
+			return sdk.Dec{}, errors.New("stableswap curve reserves must be positive")
+		}
+		sum = sum.Add(reserve)
+		c = c.Mul(d).Quo(reserve.MulInt64(int64(n)))
+	}
+	c = c.Mul(d).Quo(ann.MulInt64(int64(n)))
+	b := sum.Add(d.Quo(ann))
+
+	y := d
+	for i := 0; i < curveMaxIterations; i++ {
+		prevY := y
+		y = y.Mul(y).Add(c).Quo(y.MulInt64(2).Add(b).Sub(d))
+		if approxDecEqual(y, prevY, curveConvergenceThreshold) {
+			return y, nil
+		}
+	}
+	return sdk.Dec{}, errors.New("stableswap curve reserve did not converge")
+}
+
+// solveCurveCfmm returns how many units of x come out of the pool for yIn units of y in,
+// under Curve's StableSwap CFMM, where remReserves are the reserves of the pool's other assets.
+// It is the multi-asset, amplified counterpart of solveCfmmMulti.
+func solveCurveCfmm(amplification uint64, xReserve, yReserve sdk.Dec, remReserves []sdk.Dec, yIn sdk.Dec) (sdk.Dec, error) {
+	if !yReserve.Add(yIn).IsPositive() {
+		return sdk.Dec{}, errors.New("invalid yReserve, yIn combo")
+	}
+	reserves := append([]sdk.Dec{xReserve, yReserve}, remReserves...)
+	d, err := solveCurveInvariant(amplification, reserves)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	otherReserves := append([]sdk.Dec{yReserve.Add(yIn)}, remReserves...)
+	newXReserve, err := solveCurveReserve(amplification, d, otherReserves)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return xReserve.Sub(newXReserve), nil
+}
+
+// solveScaledCfmm returns how many scaled units of xDenom come out of the pool for yIn scaled units of yDenom in,
+// under the pool's CFMM, which is Curve's StableSwap CFMM if the pool has an amplification parameter,
+// and the Solidly CFMM otherwise.
+// A negative yIn takes yDenom out of the pool, for a negative amount of xDenom out.
+func (p Pool) solveScaledCfmm(xDenom, yDenom string, yIn sdk.Dec) (sdk.Dec, error) {
+	reserves, err := p.getScaledPoolAmts(xDenom, yDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	remDenoms := []string{}
+	for _, coin := range p.PoolLiquidity {
+		if coin.Denom != xDenom && coin.Denom != yDenom {
+			remDenoms = append(remDenoms, coin.Denom)
+		}
+	}
+	remReserves, err := p.getScaledPoolAmts(remDenoms...)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if p.PoolParams.AmplificationParameter == 0 {
+		uReserve, wSumSquares := sdk.OneDec(), sdk.ZeroDec()
+		for _, reserve := range remReserves {
+			uReserve = uReserve.Mul(reserve)
+			wSumSquares = wSumSquares.Add(reserve.Mul(reserve))
+		}
+		return solveCfmmNewtonMulti(reserves[0], reserves[1], uReserve, wSumSquares, yIn)
+	}
+	return solveCurveCfmm(p.PoolParams.AmplificationParameter, reserves[0], reserves[1], remReserves, yIn)
 }
 
 // returns outAmt as a decimal
 func (p *Pool) calcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, swapFee sdk.Dec) (sdk.Dec, error) {
-	reserves, err := p.getScaledPoolAmts(tokenIn.Denom, tokenOutDenom)
+	// We are solving for the amount of token out, hence x = tokenOutSupply, y = tokenInSupply
+	cfmmOut, err := p.solveScaledCfmm(tokenOutDenom, tokenIn.Denom, tokenIn.Amount.ToDec())
 	if err != nil {
 		return sdk.Dec{}, err
 	}
-	tokenInSupply, tokenOutSupply := reserves[0], reserves[1]
-	// We are solving for the amount of token out, hence x = tokenOutSupply, y = tokenInSupply
-	cfmmOut := solveCfmm(tokenOutSupply, tokenInSupply, tokenIn.Amount.ToDec())
 	outAmt := p.getDescaledPoolAmt(tokenOutDenom, cfmmOut)
 	return outAmt, nil
 }
 
 // returns inAmt as a decimal
 func (p *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, swapFee sdk.Dec) (sdk.Dec, error) {
-	reserves, err := p.getScaledPoolAmts(tokenInDenom, tokenOut.Denom)
+	// We are solving for the amount of token in, cfmm(x,y) = cfmm(x + x_in, y - y_out)
+	// x = tokenInSupply, y = tokenOutSupply, yIn = -tokenOutAmount
+	cfmmIn, err := p.solveScaledCfmm(tokenInDenom, tokenOut.Denom, tokenOut.Amount.ToDec().Neg())
 	if err != nil {
 		return sdk.Dec{}, err
 	}
-	tokenInSupply, tokenOutSupply := reserves[0], reserves[1]
-	// We are solving for the amount of token in, cfmm(x,y) = cfmm(x + x_in, y - y_out)
-	// x = tokenInSupply, y = tokenOutSupply, yIn = -tokenOutAmount
-	cfmmIn := solveCfmm(tokenInSupply, tokenOutSupply, tokenOut.Amount.ToDec().Neg())
 	inAmt := p.getDescaledPoolAmt(tokenInDenom, cfmmIn.NegMut())
 	return inAmt, nil
 }
 
+// calcSingleAssetJoinShares returns the LP shares for joining with tokenIn alone, charging swapFee on the
+// part of tokenIn that is implicitly swapped into the pool's other assets.
+// Like balancer's feeRatio, that part is the share of the pool's other assets in its scaled liquidity,
+// which is how much of tokenIn an exact ratio join would have held in them instead.
+// The shares are searched for tokenIn less the fee, while the pool keeps all of tokenIn,
+// so that the fee accrues to the existing LPs.
+// They are also searched for singleAssetJoinSearchTolerance fewer tokens, as the search may find shares
+// worth that many more tokens than it searched for, which would otherwise make joining and exiting
+// a cheaper swap than the pool's own.
 func (p *Pool) calcSingleAssetJoinShares(tokenIn sdk.Coin, swapFee sdk.Dec) (sdk.Int, error) {
 	poolWithAddedLiquidityAndShares := func(newLiquidity sdk.Coin, newShares sdk.Int) types.PoolI {
 		paCopy := p.Copy()
 		paCopy.updatePoolForJoin(sdk.NewCoins(tokenIn), newShares)
 		return &paCopy
 	}
-	// TODO: Correctly handle swap fee
-	return cfmm_common.BinarySearchSingleAssetJoin(p, tokenIn, poolWithAddedLiquidityAndShares)
+
+	tokenInAfterFee := tokenIn
+	if !swapFee.IsZero() {
+		feeRatio, err := p.singleAssetJoinFeeRatio(tokenIn.Denom, swapFee)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		tokenInAfterFee = sdk.NewCoin(tokenIn.Denom, feeRatio.MulInt(tokenIn.Amount).TruncateInt())
+	}
+	if tokenInAfterFee.Amount.GT(singleAssetJoinSearchTolerance) {
+		tokenInAfterFee.Amount = tokenInAfterFee.Amount.Sub(singleAssetJoinSearchTolerance)
+	}
+	return cfmm_common.BinarySearchSingleAssetJoin(p, tokenInAfterFee, poolWithAddedLiquidityAndShares)
+}
+
+// singleAssetJoinSearchTolerance is how many tokens more than it searches for
+// cfmm_common.BinarySearchSingleAssetJoin may find shares worth: the 2 tokens it searches within,
+// and 1 token that the swap back into the joined asset it values shares by truncates.
+var singleAssetJoinSearchTolerance = sdk.NewInt(3)
+
+// singleAssetJoinFeeRatio returns 1 - (1 - scaled liquidity of tokenInDenom / total scaled liquidity) * swapFee.
+func (p Pool) singleAssetJoinFeeRatio(tokenInDenom string, swapFee sdk.Dec) (sdk.Dec, error) {
+	denoms := make([]string, len(p.PoolLiquidity))
+	for i, coin := range p.PoolLiquidity {
+		denoms[i] = coin.Denom
+	}
+	reserves, err := p.getScaledPoolAmts(denoms...)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	tokenInReserve, err := p.getScaledPoolAmts(tokenInDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	totalReserves := sdk.ZeroDec()
+	for _, reserve := range reserves {
+		totalReserves = totalReserves.Add(reserve)
+	}
+	swappedRatio := sdk.OneDec().Sub(tokenInReserve[0].Quo(totalReserves))
+	return sdk.OneDec().Sub(swappedRatio.Mul(swapFee)), nil
 }
 
 // We can mutate pa here
@@ -377,8 +620,11 @@ func (p *Pool) calcSingleAssetJoinShares(tokenIn sdk.Coin, swapFee sdk.Dec) (sdk
 func (p *Pool) joinPoolSharesInternal(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, newLiquidity sdk.Coins, err error) {
 	if len(tokensIn) == 1 {
 		numShares, err = p.calcSingleAssetJoinShares(tokensIn[0], swapFee)
-		newLiquidity = tokensIn
-		return numShares, newLiquidity, err
+		if err != nil {
+			return sdk.ZeroInt(), sdk.NewCoins(), err
+		}
+		p.updatePoolForJoin(tokensIn, numShares)
+		return numShares, tokensIn, nil
 	} else if len(tokensIn) != p.NumAssets() {
 		return sdk.ZeroInt(), sdk.NewCoins(), errors.New(
 			"stableswap pool only supports LP'ing with one asset, or all assets in pool")
@@ -403,3 +649,141 @@ func (p *Pool) joinPoolSharesInternal(ctx sdk.Context, tokensIn sdk.Coins, swapF
 
 	return numShares, tokensIn, nil
 }
+
+// calcSingleAssetInGivenPoolSharesOut returns how much of tokenInDenom joining the pool alone must add,
+// for shareOutAmount LP shares, charging swapFee like calcSingleAssetJoinShares.
+// We binary search the amount, s.t. if we added it to the pool with shareOutAmount,
+// exited those shares and swapped all the tokens back to tokenInDenom,
+// we'd get at most the amount less its fee, so that calcSingleAssetJoinShares gives at least shareOutAmount for it.
+func (p *Pool) calcSingleAssetInGivenPoolSharesOut(tokenInDenom string, shareOutAmount sdk.Int, swapFee sdk.Dec) (sdk.Int, error) {
+	feeRatio, err := p.singleAssetJoinFeeRatio(tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	noFeeTokenInAmount, err := p.calcSingleAssetInGivenPoolSharesOutNoFee(tokenInDenom, shareOutAmount)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	// Returns how many more tokens than the joined amount less its fee
+	// exiting shareOutAmount for tokenInDenom right after the join yields.
+	estimateFeeSurplusGivenTokenIn := func(tokenInAmount sdk.Int) (sdk.Int, error) {
+		pCopy := p.Copy()
+		pCopy.updatePoolForJoin(sdk.NewCoins(sdk.NewCoin(tokenInDenom, tokenInAmount)), shareOutAmount)
+		exitedCoins, err := pCopy.ExitPool(sdk.Context{}, shareOutAmount, sdk.ZeroDec())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		tokenOutAmount, err := pCopy.swapAllCoinsToSingleAsset(exitedCoins, tokenInDenom)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		return feeRatio.MulInt(tokenInAmount).TruncateInt().Sub(tokenOutAmount), nil
+	}
+
+	// Without a swap fee, the amount is close to the one for the exact ratio join, then swap.
+	// The swap fee accrues to the pool, so the amount including it is never more than twice that.
+	correctnessThreshold := sdk.NewInt(2)
+	maxIterations := 300
+	tokenInUpperBound := noFeeTokenInAmount.ToDec().Quo(feeRatio).MulInt64(2).Ceil().TruncateInt().Add(correctnessThreshold)
+	errTolerance := osmoutils.ErrTolerance{AdditiveTolerance: correctnessThreshold, MultiplicativeTolerance: sdk.Dec{}}
+	return osmoutils.BinarySearch(
+		estimateFeeSurplusGivenTokenIn,
+		sdk.ZeroInt(), tokenInUpperBound, correctnessThreshold, errTolerance, maxIterations)
+}
+
+// calcSingleAssetInGivenPoolSharesOutNoFee returns how much of tokenInDenom joining the pool alone must add,
+// for shareOutAmount LP shares, without a swap fee.
+// By CFMM path-independence, such a join is an exact ratio join for shareOutAmount,
+// followed by swapping tokenInDenom into the pool for all of the other assets it added.
+func (p *Pool) calcSingleAssetInGivenPoolSharesOutNoFee(tokenInDenom string, shareOutAmount sdk.Int) (sdk.Int, error) {
+	if _, err := p.getPoolAmts(tokenInDenom); err != nil {
+		return sdk.Int{}, err
+	}
+
+	// round up the exact ratio join, so that the pool is never under-charged for the shares
+	shareRatio := shareOutAmount.ToDec().QuoInt(p.GetTotalShares())
+	exactRatioJoin := sdk.Coins{}
+	for _, coin := range p.PoolLiquidity {
+		exactRatioJoin = exactRatioJoin.Add(sdk.NewCoin(coin.Denom, shareRatio.MulInt(coin.Amount).Ceil().TruncateInt()))
+	}
+	pCopy := p.Copy()
+	pCopy.updatePoolForJoin(exactRatioJoin, shareOutAmount)
+
+	tokenInAmount := exactRatioJoin.AmountOf(tokenInDenom)
+	for _, coin := range exactRatioJoin {
+		if coin.Denom == tokenInDenom {
+			continue
+		}
+		inAmt, err := pCopy.calcInAmtGivenOut(coin, tokenInDenom, sdk.ZeroDec())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		tokenIn := sdk.NewCoin(tokenInDenom, inAmt.Ceil().TruncateInt())
+		pCopy.updatePoolLiquidityForSwap(sdk.NewCoins(tokenIn), sdk.NewCoins(coin))
+		tokenInAmount = tokenInAmount.Add(tokenIn.Amount)
+	}
+	return tokenInAmount, nil
+}
+
+// swapAllCoinsToSingleAsset swaps all of coins but those of swapToDenom to swapToDenom, without a swap fee,
+// and returns the amount of swapToDenom this adds up to along with them.
+func (p *Pool) swapAllCoinsToSingleAsset(coins sdk.Coins, swapToDenom string) (sdk.Int, error) {
+	tokenOutAmount := coins.AmountOf(swapToDenom)
+	for _, coin := range coins {
+		if coin.Denom == swapToDenom {
+			continue
+		}
+		outAmt, err := p.calcOutAmtGivenIn(coin, swapToDenom, sdk.ZeroDec())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		swappedOut := sdk.NewCoin(swapToDenom, outAmt.TruncateInt())
+		p.updatePoolLiquidityForSwap(sdk.NewCoins(coin), sdk.NewCoins(swappedOut))
+		tokenOutAmount = tokenOutAmount.Add(swappedOut.Amount)
+	}
+	return tokenOutAmount, nil
+}
+
+// calcPoolSharesInGivenSingleAssetOut returns how many LP shares exiting the pool for tokenOut alone must burn,
+// charging swapFee like calcSingleAssetInGivenPoolSharesOut, and exitFee on the shares.
+// We binary search the shares, s.t. exiting them and swapping all of the other exited assets to tokenOut.Denom
+// yields at least tokenOut plus its swap fee.
+func (p *Pool) calcPoolSharesInGivenSingleAssetOut(tokenOut sdk.Coin, swapFee, exitFee sdk.Dec) (sdk.Int, error) {
+	feeRatio, err := p.singleAssetJoinFeeRatio(tokenOut.Denom, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	tokenOutReserve := p.PoolLiquidity.AmountOf(tokenOut.Denom)
+	if tokenOut.Amount.GTE(tokenOutReserve) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "%s resulted tokens is larger than the pool's %s", tokenOut, tokenOutReserve)
+	}
+
+	estimateTokenOutGivenShares := func(sharesIn sdk.Int) (sdk.Int, error) {
+		pCopy := p.Copy()
+		exitedCoins, err := pCopy.ExitPool(sdk.Context{}, sharesIn, sdk.ZeroDec())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		return pCopy.swapAllCoinsToSingleAsset(exitedCoins, tokenOut.Denom)
+	}
+
+	// Exiting shares for tokenOut.Denom alone yields at least their exact ratio of its liquidity,
+	// which bounds the shares from above.
+	// We search for a few more tokens than the fee included tokenOut, so that the shares found are never too few.
+	correctnessThreshold := sdk.NewInt(2)
+	maxIterations := 300
+	tokenOutFeeIncluded := tokenOut.Amount.ToDec().Quo(feeRatio).Ceil().TruncateInt()
+	sharesUpperBound := p.GetTotalShares().Mul(tokenOutFeeIncluded.Add(correctnessThreshold)).ToDec().QuoInt(tokenOutReserve).Ceil().TruncateInt()
+	sharesUpperBound = sdk.MinInt(sharesUpperBound, p.GetTotalShares())
+	errTolerance := osmoutils.ErrTolerance{AdditiveTolerance: correctnessThreshold, MultiplicativeTolerance: sdk.Dec{}}
+	sharesIn, err := osmoutils.BinarySearch(
+		estimateTokenOutGivenShares,
+		sdk.ZeroInt(), sharesUpperBound, tokenOutFeeIncluded.Add(correctnessThreshold), errTolerance, maxIterations)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	// sharesIn * (1 - exitFee) are exited, so we round up the shares including the exit fee
+	return sharesIn.ToDec().Quo(sdk.OneDec().Sub(exitFee)).Ceil().TruncateInt(), nil
+}
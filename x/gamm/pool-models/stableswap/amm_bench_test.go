@@ -26,3 +26,24 @@ func runCalc(solve func(sdk.Dec, sdk.Dec, sdk.Dec) sdk.Dec) {
 	yIn := sdk.NewDec(rand.Int63n(100000))
 	solve(xReserve, yReserve, yIn)
 }
+
+func BenchmarkNewton(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		runCalc(func(xReserve, yReserve, yIn sdk.Dec) sdk.Dec {
+			xOut, _ := solveCfmmNewton(xReserve, yReserve, yIn)
+			return xOut
+		})
+	}
+}
+
+func BenchmarkNewtonMulti(b *testing.B) {
+	// represents a 4-asset pool with 100000 in each of the other two reserves
+	uReserve := sdk.NewDec(100000 * 100000)
+	wSumSquares := sdk.NewDec(2 * 100000 * 100000)
+	for i := 0; i < b.N; i++ {
+		runCalc(func(xReserve, yReserve, yIn sdk.Dec) sdk.Dec {
+			xOut, _ := solveCfmmNewtonMulti(xReserve, yReserve, uReserve, wSumSquares, yIn)
+			return xOut
+		})
+	}
+}
@@ -2,12 +2,15 @@ package stableswap
 
 import (
 	fmt "fmt"
+	"math/rand"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/osmosis-labs/osmosis/v7/osmomath"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/internal/test_helpers"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
 type StableSwapTestSuite struct {
@@ -107,6 +110,114 @@ func TestCFMMInvariantMultiAssets(t *testing.T) {
 	}
 }
 
+func TestCFMMNewtonMulti(t *testing.T) {
+	// solveCfmmNewtonMulti is within 10^{-12} + 10^{-16} under the exact amount out,
+	// and the closed form solutions are only accurate to ApproxRoot's precision.
+	errTolerance := sdk.NewDecWithPrec(1, 8)
+
+	tests := map[string]struct {
+		xReserve    sdk.Dec
+		yReserve    sdk.Dec
+		uReserve    sdk.Dec
+		wSumSquares sdk.Dec
+		yIn         sdk.Dec
+	}{
+		"two assets, small swap": {
+			xReserve:    sdk.NewDec(100),
+			yReserve:    sdk.NewDec(100),
+			uReserve:    sdk.OneDec(),
+			wSumSquares: sdk.ZeroDec(),
+			yIn:         sdk.NewDec(1),
+		},
+		"two assets, large swap": {
+			xReserve:    sdk.NewDec(100),
+			yReserve:    sdk.NewDec(100),
+			uReserve:    sdk.OneDec(),
+			wSumSquares: sdk.ZeroDec(),
+			yIn:         sdk.NewDec(1000),
+		},
+		"two assets, swap out": {
+			xReserve:    sdk.NewDec(100),
+			yReserve:    sdk.NewDec(100),
+			uReserve:    sdk.OneDec(),
+			wSumSquares: sdk.ZeroDec(),
+			yIn:         sdk.NewDec(-50),
+		},
+		"four assets, small swap": {
+			xReserve:    sdk.NewDec(100),
+			yReserve:    sdk.NewDec(100),
+			uReserve:    sdk.NewDec(10000),
+			wSumSquares: sdk.NewDec(20000),
+			yIn:         sdk.NewDec(1),
+		},
+		"four assets, large swap": {
+			xReserve:    sdk.NewDec(100),
+			yReserve:    sdk.NewDec(100),
+			uReserve:    sdk.NewDec(10000),
+			wSumSquares: sdk.NewDec(20000),
+			yIn:         sdk.NewDec(1000),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			xOut, err := solveCfmmNewtonMulti(test.xReserve, test.yReserve, test.uReserve, test.wSumSquares, test.yIn)
+			require.NoError(t, err)
+
+			var expectedXOut sdk.Dec
+			if test.uReserve.Equal(sdk.OneDec()) && test.wSumSquares.IsZero() {
+				expectedXOut = solveCfmm(test.xReserve, test.yReserve, test.yIn)
+				twoAssetXOut, err := solveCfmmNewton(test.xReserve, test.yReserve, test.yIn)
+				require.NoError(t, err)
+				require.Equal(t, xOut, twoAssetXOut)
+			} else {
+				// the product of the remaining reserves cancels out of solveCfmmMulti
+				expectedXOut = solveCfmmMulti(test.xReserve, test.yReserve, test.wSumSquares, test.yIn)
+			}
+			decApproxEq(t, expectedXOut, xOut, errTolerance)
+		})
+	}
+}
+
+func TestCFMMNewtonRoundsInPoolsFavor(t *testing.T) {
+	tests := map[string]struct {
+		xReserve sdk.Dec
+		yReserve sdk.Dec
+		yIn      sdk.Dec
+	}{
+		"small reserves": {sdk.NewDec(100), sdk.NewDec(100), sdk.NewDec(1)},
+		// overflows solveCfmm
+		"large reserves":      {sdk.NewDec(100000), sdk.NewDec(100000), sdk.NewDec(10000)},
+		"very large reserves": {sdk.NewDec(1_000_000_000_000), sdk.NewDec(2_000_000_000_000), sdk.NewDec(10_000_000_000)},
+		"swap out":            {sdk.NewDec(1_000_000_000_000), sdk.NewDec(1_000_000_000_000), sdk.NewDec(-10_000_000_000)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			xOut, err := solveCfmmNewton(test.xReserve, test.yReserve, test.yIn)
+			require.NoError(t, err)
+
+			// the invariant never decreases, and stays within the error bound
+			k0 := osmomath.BigDecFromSDKDec(cfmmConstant(test.xReserve, test.yReserve))
+			x1, y1 := test.xReserve.Sub(xOut), test.yReserve.Add(test.yIn)
+			k1 := osmomath.BigDecFromSDKDec(x1).Mul(osmomath.BigDecFromSDKDec(y1)).
+				Mul(osmomath.BigDecFromSDKDec(x1.Mul(x1).Add(y1.Mul(y1))))
+			require.True(t, k1.GTE(k0), "k1 %s < k0 %s", k1, k0)
+
+			xOutAtBound := xOut.Add(sdk.NewDecWithPrec(2, 12))
+			x2 := test.xReserve.Sub(xOutAtBound)
+			k2 := osmomath.BigDecFromSDKDec(x2).Mul(osmomath.BigDecFromSDKDec(y1)).
+				Mul(osmomath.BigDecFromSDKDec(x2.Mul(x2).Add(y1.Mul(y1))))
+			require.True(t, k2.LT(k0), "k2 %s >= k0 %s", k2, k0)
+		})
+	}
+}
+
+func TestCFMMNewtonInvalidInput(t *testing.T) {
+	_, err := solveCfmmNewton(sdk.NewDec(100), sdk.NewDec(100), sdk.NewDec(-100))
+	require.Error(t, err)
+}
+
 func (suite *StableSwapTestSuite) Test_StableSwap_CalculateAmountOutAndIn_InverseRelationship(t *testing.T) {
 	type testcase struct {
 		denomOut         string
@@ -207,3 +318,399 @@ func (suite *StableSwapTestSuite) Test_StableSwap_CalculateAmountOutAndIn_Invers
 		}
 	}
 }
+
+func TestCalcSingleAssetJoinSharesSwapFee(t *testing.T) {
+	tests := []struct {
+		name          string
+		poolLiquidity sdk.Coins
+		tokenIn       sdk.Coin
+	}{
+		{
+			name:          "even pool",
+			poolLiquidity: sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000)),
+			tokenIn:       sdk.NewInt64Coin("foo", 10_000),
+		},
+		{
+			name:          "uneven pool, joining with the scarce asset",
+			poolLiquidity: sdk.NewCoins(sdk.NewInt64Coin("foo", 500_000), sdk.NewInt64Coin("bar", 1_000_000)),
+			tokenIn:       sdk.NewInt64Coin("foo", 10_000),
+		},
+		{
+			name:          "uneven pool, joining with the abundant asset",
+			poolLiquidity: sdk.NewCoins(sdk.NewInt64Coin("foo", 500_000), sdk.NewInt64Coin("bar", 1_000_000)),
+			tokenIn:       sdk.NewInt64Coin("bar", 10_000),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := createScaledTestPool(t, tc.poolLiquidity, sdk.ZeroDec(), sdk.ZeroDec())
+			prevShares, err := p.calcSingleAssetJoinShares(tc.tokenIn, sdk.ZeroDec())
+			require.NoError(t, err)
+
+			for _, swapFee := range []string{"0.001", "0.01", "0.1", "0.5"} {
+				swapFeeDec := sdk.MustNewDecFromStr(swapFee)
+				shares, err := p.calcSingleAssetJoinShares(tc.tokenIn, swapFeeDec)
+				require.NoError(t, err)
+				require.True(t, shares.LT(prevShares), "swap fee %s: shares %s, not less than %s", swapFee, shares, prevShares)
+				prevShares = shares
+
+				// The fee is charged on the share of the other asset in the pool's liquidity only.
+				feeRatio, err := p.singleAssetJoinFeeRatio(tc.tokenIn.Denom, swapFeeDec)
+				require.NoError(t, err)
+				tokenInLiquidityRatio := tc.poolLiquidity.AmountOf(tc.tokenIn.Denom).ToDec().Quo(sumCoinAmounts(tc.poolLiquidity).ToDec())
+				expectedFeeRatio := sdk.OneDec().Sub(sdk.OneDec().Sub(tokenInLiquidityRatio).Mul(swapFeeDec))
+				require.Equal(t, expectedFeeRatio, feeRatio)
+			}
+		})
+	}
+}
+
+// TestSingleAssetJoinExitNeverBeatsSwap tests that joining a pool with a single asset, and exiting into all of its assets,
+// never gets more of the other asset than swapping the same net amount of the joined asset for it,
+// up to a rounding of one token.
+func TestSingleAssetJoinExitNeverBeatsSwap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	swapFees := []sdk.Dec{sdk.ZeroDec(), sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.01"), sdk.MustNewDecFromStr("0.1")}
+	roundingTolerance := sdk.OneInt()
+
+	for i := 0; i < 40; i++ {
+		// Stable pools are near balanced.
+		fooLiquidity := r.Int63n(1_000_000) + 1_000_000
+		barLiquidity := r.Int63n(1_000_000) + 1_000_000
+		poolLiquidity := sdk.NewCoins(sdk.NewInt64Coin("foo", fooLiquidity), sdk.NewInt64Coin("bar", barLiquidity))
+		// Join with up to half of the pool's liquidity in the joined asset.
+		tokenIn := sdk.NewInt64Coin("foo", r.Int63n(fooLiquidity/2)+1_000)
+		if r.Intn(2) == 0 {
+			tokenIn = sdk.NewInt64Coin("bar", r.Int63n(barLiquidity/2)+1_000)
+		}
+		otherDenom := "bar"
+		if tokenIn.Denom == "bar" {
+			otherDenom = "foo"
+		}
+
+		for _, swapFee := range swapFees {
+			name := fmt.Sprintf("liquidity %s, token in %s, swap fee %s", poolLiquidity, tokenIn, swapFee)
+
+			joinedPool := createScaledTestPool(t, poolLiquidity, swapFee, sdk.ZeroDec())
+			shares, err := joinedPool.JoinPool(sdk.Context{}, sdk.NewCoins(tokenIn), swapFee)
+			require.NoError(t, err, name)
+			exitedCoins, err := joinedPool.ExitPool(sdk.Context{}, shares, sdk.ZeroDec())
+			require.NoError(t, err, name)
+
+			netTokenIn := tokenIn.Sub(sdk.NewCoin(tokenIn.Denom, exitedCoins.AmountOf(tokenIn.Denom)))
+			swappedPool := createScaledTestPool(t, poolLiquidity, swapFee, sdk.ZeroDec())
+			swappedOut, err := swappedPool.CalcOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(netTokenIn), otherDenom, swapFee)
+			require.NoError(t, err, name)
+
+			require.True(t, exitedCoins.AmountOf(otherDenom).LTE(swappedOut.Amount.Add(roundingTolerance)),
+				"%s: join and exit got %s%s, swap got %s", name, exitedCoins.AmountOf(otherDenom), otherDenom, swappedOut)
+		}
+	}
+}
+
+// TestJoinPoolUpdatesPool tests that joins add their tokens and shares to the pool, including single asset joins,
+// so that a join with coins off the pool's ratio gets as many shares as joining with its ratio part,
+// and then with each remaining coin alone.
+func TestJoinPoolUpdatesPool(t *testing.T) {
+	poolLiquidity := sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+	ratioTokensIn := sdk.NewCoins(sdk.NewInt64Coin("foo", 10_000), sdk.NewInt64Coin("bar", 10_000))
+	remainingTokenIn := sdk.NewInt64Coin("bar", 20_000)
+	tokensIn := ratioTokensIn.Add(remainingTokenIn)
+
+	for _, swapFee := range []sdk.Dec{sdk.ZeroDec(), sdk.MustNewDecFromStr("0.01")} {
+		t.Run(fmt.Sprintf("swap fee %s", swapFee), func(t *testing.T) {
+			p := createScaledTestPool(t, poolLiquidity, swapFee, sdk.ZeroDec())
+			initialShares := p.GetTotalShares()
+			shares, err := p.JoinPool(sdk.Context{}, tokensIn, swapFee)
+			require.NoError(t, err)
+			require.Equal(t, poolLiquidity.Add(tokensIn...), p.GetTotalPoolLiquidity(sdk.Context{}))
+			require.Equal(t, initialShares.Add(shares), p.GetTotalShares())
+
+			stepwisePool := createScaledTestPool(t, poolLiquidity, swapFee, sdk.ZeroDec())
+			ratioShares, err := stepwisePool.JoinPool(sdk.Context{}, ratioTokensIn, swapFee)
+			require.NoError(t, err)
+			singleAssetShares, err := stepwisePool.JoinPool(sdk.Context{}, sdk.NewCoins(remainingTokenIn), swapFee)
+			require.NoError(t, err)
+			require.Equal(t, shares, ratioShares.Add(singleAssetShares))
+			require.Equal(t, p.GetTotalPoolLiquidity(sdk.Context{}), stepwisePool.GetTotalPoolLiquidity(sdk.Context{}))
+			require.Equal(t, p.GetTotalShares(), stepwisePool.GetTotalShares())
+		})
+	}
+}
+
+func TestCalcSingleAssetInAndOut_InverseRelationship(t *testing.T) {
+	tests := []struct {
+		name          string
+		poolLiquidity sdk.Coins
+		denom         string
+		amount        sdk.Int
+	}{
+		{
+			name:          "even pool",
+			poolLiquidity: sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000)),
+			denom:         "foo",
+			amount:        sdk.NewInt(10_000),
+		},
+		{
+			name:          "uneven pool, scarce asset",
+			poolLiquidity: sdk.NewCoins(sdk.NewInt64Coin("foo", 500_000), sdk.NewInt64Coin("bar", 1_000_000)),
+			denom:         "foo",
+			amount:        sdk.NewInt(10_000),
+		},
+		{
+			name:          "uneven pool, abundant asset",
+			poolLiquidity: sdk.NewCoins(sdk.NewInt64Coin("foo", 500_000), sdk.NewInt64Coin("bar", 1_000_000)),
+			denom:         "bar",
+			amount:        sdk.NewInt(100_000),
+		},
+	}
+
+	for _, tc := range tests {
+		for _, swapFee := range []string{"0", "0.001", "0.1"} {
+			swapFeeDec := sdk.MustNewDecFromStr(swapFee)
+			t.Run(fmt.Sprintf("%s, swap fee %s", tc.name, swapFee), func(t *testing.T) {
+				p := createScaledTestPool(t, tc.poolLiquidity, swapFeeDec, sdk.ZeroDec())
+				shareOutAmount := p.GetTotalShares().Mul(tc.amount).Quo(tc.poolLiquidity.AmountOf(tc.denom))
+
+				tokenInAmount, err := p.CalcTokenInShareAmountOut(sdk.Context{}, tc.denom, shareOutAmount, swapFeeDec)
+				require.NoError(t, err)
+				joinedShares, err := p.calcSingleAssetJoinShares(sdk.NewCoin(tc.denom, tokenInAmount), swapFeeDec)
+				require.NoError(t, err)
+				// The binary search of the join is correct within 2 tokens, and the token in amount
+				// is searched for up to 4 tokens more than needed, so that it's never too few.
+				sharesPerToken := p.GetTotalShares().Quo(tc.poolLiquidity.AmountOf(tc.denom))
+				require.True(t, joinedShares.GTE(shareOutAmount.Sub(sharesPerToken.MulRaw(2))),
+					"joined shares %s, expected %s", joinedShares, shareOutAmount)
+				require.True(t, joinedShares.LTE(shareOutAmount.Add(sharesPerToken.MulRaw(6))),
+					"joined shares %s, expected %s", joinedShares, shareOutAmount)
+
+				// the pool isn't updated
+				require.Equal(t, tc.poolLiquidity, p.GetTotalPoolLiquidity(sdk.Context{}))
+
+				tokenOut := sdk.NewCoin(tc.denom, tc.amount)
+				sharesIn, err := p.calcPoolSharesInGivenSingleAssetOut(tokenOut, swapFeeDec, sdk.ZeroDec())
+				require.NoError(t, err)
+				// exiting the shares and swapping back yields at least tokenOut and its swap fee
+				feeRatio, err := p.singleAssetJoinFeeRatio(tc.denom, swapFeeDec)
+				require.NoError(t, err)
+				exitedPool := p.Copy()
+				exitedCoins, err := exitedPool.ExitPool(sdk.Context{}, sharesIn, sdk.ZeroDec())
+				require.NoError(t, err)
+				exitedAmount := exitedCoins.AmountOf(tc.denom)
+				for _, coin := range exitedCoins {
+					if coin.Denom == tc.denom {
+						continue
+					}
+					swappedOut, err := exitedPool.SwapOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(coin), tc.denom, sdk.ZeroDec())
+					require.NoError(t, err)
+					exitedAmount = exitedAmount.Add(swappedOut.Amount)
+				}
+				require.True(t, exitedAmount.ToDec().GTE(tokenOut.Amount.ToDec().Quo(feeRatio)),
+					"exited %s, wanted %s", exitedAmount, tokenOut)
+				// exiting for the amount burns about as many shares as joining with it mints
+				joinedSharesForAmount, err := p.calcSingleAssetJoinShares(tokenOut, sdk.ZeroDec())
+				require.NoError(t, err)
+				require.True(t, sharesIn.Sub(joinedSharesForAmount).Abs().LTE(joinedSharesForAmount.QuoRaw(5)),
+					"shares in %s, joined shares %s", sharesIn, joinedSharesForAmount)
+			})
+		}
+	}
+}
+
+func TestJoinPoolTokenInMaxShareAmountOut(t *testing.T) {
+	poolLiquidity := sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+	swapFee := sdk.MustNewDecFromStr("0.01")
+	p := createScaledTestPool(t, poolLiquidity, swapFee, sdk.ZeroDec())
+	shareOutAmount := types.OneShare.MulRaw(1)
+
+	expectedTokenIn, err := p.CalcTokenInShareAmountOut(sdk.Context{}, "foo", shareOutAmount, swapFee)
+	require.NoError(t, err)
+	tokenInAmount, err := p.JoinPoolTokenInMaxShareAmountOut(sdk.Context{}, "foo", shareOutAmount)
+	require.NoError(t, err)
+	require.Equal(t, expectedTokenIn, tokenInAmount)
+	require.Equal(t, poolLiquidity.Add(sdk.NewCoin("foo", tokenInAmount)), p.GetTotalPoolLiquidity(sdk.Context{}))
+	require.Equal(t, types.InitPoolSharesSupply.Add(shareOutAmount), p.GetTotalShares())
+
+	_, err = p.JoinPoolTokenInMaxShareAmountOut(sdk.Context{}, "baz", shareOutAmount)
+	require.Error(t, err)
+}
+
+func TestExitSwapExactAmountOut(t *testing.T) {
+	poolLiquidity := sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+	swapFee := sdk.MustNewDecFromStr("0.01")
+	tokenOut := sdk.NewInt64Coin("foo", 10_000)
+
+	tests := []struct {
+		name             string
+		exitFee          sdk.Dec
+		tokenOut         sdk.Coin
+		shareInMaxAmount sdk.Int
+		expectPass       bool
+	}{
+		{
+			name:             "no exit fee",
+			exitFee:          sdk.ZeroDec(),
+			tokenOut:         tokenOut,
+			shareInMaxAmount: types.InitPoolSharesSupply,
+			expectPass:       true,
+		},
+		{
+			name:             "exit fee",
+			exitFee:          sdk.MustNewDecFromStr("0.01"),
+			tokenOut:         tokenOut,
+			shareInMaxAmount: types.InitPoolSharesSupply,
+			expectPass:       true,
+		},
+		{
+			name:             "shares larger than max",
+			exitFee:          sdk.ZeroDec(),
+			tokenOut:         tokenOut,
+			shareInMaxAmount: types.OneShare.QuoRaw(10),
+			expectPass:       false,
+		},
+		{
+			name:             "all of the pool's liquidity of the denom",
+			exitFee:          sdk.ZeroDec(),
+			tokenOut:         sdk.NewInt64Coin("foo", 1_000_000),
+			shareInMaxAmount: types.InitPoolSharesSupply,
+			expectPass:       false,
+		},
+		{
+			name:             "denom not in pool",
+			exitFee:          sdk.ZeroDec(),
+			tokenOut:         sdk.NewInt64Coin("baz", 10_000),
+			shareInMaxAmount: types.InitPoolSharesSupply,
+			expectPass:       false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := createScaledTestPool(t, poolLiquidity, swapFee, tc.exitFee)
+			sharesIn, err := p.ExitSwapExactAmountOut(sdk.Context{}, tc.tokenOut, tc.shareInMaxAmount)
+			if !tc.expectPass {
+				require.Error(t, err)
+				require.Equal(t, poolLiquidity, p.GetTotalPoolLiquidity(sdk.Context{}))
+				require.Equal(t, types.InitPoolSharesSupply, p.GetTotalShares())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, poolLiquidity.Sub(sdk.NewCoins(tc.tokenOut)), p.GetTotalPoolLiquidity(sdk.Context{}))
+			require.Equal(t, types.InitPoolSharesSupply.Sub(sharesIn), p.GetTotalShares())
+
+			// the exit fee is charged on top of the shares without it
+			noExitFeePool := createScaledTestPool(t, poolLiquidity, swapFee, sdk.ZeroDec())
+			sharesInNoExitFee, err := noExitFeePool.calcPoolSharesInGivenSingleAssetOut(tc.tokenOut, swapFee, sdk.ZeroDec())
+			require.NoError(t, err)
+			expectedSharesIn := sharesInNoExitFee.ToDec().Quo(sdk.OneDec().Sub(tc.exitFee)).Ceil().TruncateInt()
+			require.Equal(t, expectedSharesIn, sharesIn)
+		})
+	}
+}
+
+func TestSolveCurveInvariant(t *testing.T) {
+	tests := []struct {
+		name          string
+		amplification uint64
+		reserves      []sdk.Dec
+	}{
+		{"balanced, low amplification", 1, []sdk.Dec{sdk.NewDec(1_000_000), sdk.NewDec(1_000_000)}},
+		{"balanced, high amplification", MaxAmplificationParameter, []sdk.Dec{sdk.NewDec(1_000_000), sdk.NewDec(1_000_000)}},
+		{"balanced, three assets", 100, []sdk.Dec{sdk.NewDec(1_000_000), sdk.NewDec(1_000_000), sdk.NewDec(1_000_000)}},
+		{"unbalanced", 100, []sdk.Dec{sdk.NewDec(1_000_000), sdk.NewDec(3_000_000)}},
+		{"unbalanced, large reserves", 100, []sdk.Dec{sdk.NewDec(1_000_000_000_000), sdk.NewDec(2_000_000_000_000)}},
+		{"unbalanced, three assets", 100, []sdk.Dec{sdk.NewDec(1_000_000), sdk.NewDec(2_000_000), sdk.NewDec(500_000)}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := solveCurveInvariant(tc.amplification, tc.reserves)
+			require.NoError(t, err)
+
+			sum, prod := sdk.ZeroDec(), sdk.OneDec()
+			n := int64(len(tc.reserves))
+			nPowN := sdk.OneDec()
+			for _, reserve := range tc.reserves {
+				sum = sum.Add(reserve)
+				prod = prod.Mul(reserve)
+				nPowN = nPowN.MulInt64(n)
+			}
+			// D is at most the sum of the reserves, which it equals when they are balanced.
+			require.True(t, d.LTE(sum), "D %s, sum %s", d, sum)
+
+			// A n^n S + D = A n^n D + D^(n+1) / (n^n P)
+			ann := nPowN.MulInt64(int64(tc.amplification))
+			dPowNPlusOne := d.Power(uint64(n + 1))
+			lhs := ann.Mul(sum).Add(d)
+			rhs := ann.Mul(d).Add(dPowNPlusOne.Quo(nPowN.Mul(prod)))
+			require.True(t, approxDecEqual(lhs, rhs, sdk.NewDecWithPrec(1, 12)), "lhs %s, rhs %s", lhs, rhs)
+		})
+	}
+}
+
+func TestSolveCurveCfmm(t *testing.T) {
+	xReserve, yReserve := sdk.NewDec(1_000_000), sdk.NewDec(1_000_000)
+	yIn := sdk.NewDec(100_000)
+
+	prevXOut := sdk.ZeroDec()
+	for _, amplification := range []uint64{1, 10, 100, 1_000, MaxAmplificationParameter} {
+		xOut, err := solveCurveCfmm(amplification, xReserve, yReserve, []sdk.Dec{}, yIn)
+		require.NoError(t, err)
+		// the higher the amplification, the less slippage there is around the peg
+		require.True(t, xOut.LT(yIn), "amplification %d, x out %s", amplification, xOut)
+		require.True(t, xOut.GT(prevXOut), "amplification %d, x out %s, previous x out %s", amplification, xOut, prevXOut)
+		prevXOut = xOut
+
+		// the swap keeps the invariant
+		dBefore, err := solveCurveInvariant(amplification, []sdk.Dec{xReserve, yReserve})
+		require.NoError(t, err)
+		dAfter, err := solveCurveInvariant(amplification, []sdk.Dec{xReserve.Sub(xOut), yReserve.Add(yIn)})
+		require.NoError(t, err)
+		require.True(t, approxDecEqual(dBefore, dAfter, sdk.NewDecWithPrec(1, 12)), "D before %s, D after %s", dBefore, dAfter)
+
+		// swapping back in the other direction returns yIn
+		yOut, err := solveCurveCfmm(amplification, yReserve.Add(yIn), xReserve.Sub(xOut), []sdk.Dec{}, xOut)
+		require.NoError(t, err)
+		require.True(t, approxDecEqual(yIn, yOut, sdk.NewDecWithPrec(1, 12)), "y in %s, y out %s", yIn, yOut)
+	}
+
+	// the max amplification is within 0.01% of the constant sum near the peg
+	require.True(t, approxDecEqual(prevXOut, yIn, sdk.NewDecWithPrec(1, 4)), "x out %s", prevXOut)
+
+	_, err := solveCurveCfmm(100, xReserve, yReserve, []sdk.Dec{}, yReserve.Neg())
+	require.Error(t, err)
+}
+
+func TestAmplifiedPoolSwaps(t *testing.T) {
+	poolLiquidity := sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+	solidlyPool := createScaledTestPool(t, poolLiquidity, sdk.ZeroDec(), sdk.ZeroDec())
+	amplifiedPool := createScaledTestPool(t, poolLiquidity, sdk.ZeroDec(), sdk.ZeroDec())
+	amplifiedPool.PoolParams.AmplificationParameter = 1_000
+
+	tokenIn := sdk.NewCoins(sdk.NewInt64Coin("foo", 100_000))
+	solidlyOut, err := solidlyPool.CalcOutAmtGivenIn(sdk.Context{}, tokenIn, "bar", sdk.ZeroDec())
+	require.NoError(t, err)
+	amplifiedOut, err := amplifiedPool.CalcOutAmtGivenIn(sdk.Context{}, tokenIn, "bar", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.True(t, amplifiedOut.Amount.GT(solidlyOut.Amount), "amplified out %s, solidly out %s", amplifiedOut, solidlyOut)
+	require.True(t, amplifiedOut.Amount.LT(tokenIn[0].Amount))
+
+	// CalcInAmtGivenOut is the inverse of CalcOutAmtGivenIn, rounded up
+	amplifiedIn, err := amplifiedPool.CalcInAmtGivenOut(sdk.Context{}, sdk.NewCoins(amplifiedOut), "foo", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.True(t, amplifiedIn.Amount.LTE(tokenIn[0].Amount), "amplified in %s", amplifiedIn)
+	require.True(t, amplifiedIn.Amount.GTE(tokenIn[0].Amount.SubRaw(1)), "amplified in %s", amplifiedIn)
+
+	spotPrice, err := amplifiedPool.SpotPrice(sdk.Context{}, "bar", "foo")
+	require.NoError(t, err)
+	require.True(t, approxDecEqual(spotPrice, sdk.OneDec(), sdk.NewDecWithPrec(1, 6)), "spot price %s", spotPrice)
+
+	// single asset joins use the amplified curve too, so a join that unbalances the pool mints more shares
+	joinTokenIn := sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000))
+	amplifiedShares, _, err := amplifiedPool.CalcJoinPoolShares(sdk.Context{}, joinTokenIn, sdk.ZeroDec())
+	require.NoError(t, err)
+	solidlyShares, _, err := solidlyPool.CalcJoinPoolShares(sdk.Context{}, joinTokenIn, sdk.ZeroDec())
+	require.NoError(t, err)
+	require.True(t, amplifiedShares.GT(solidlyShares), "amplified shares %s, solidly shares %s", amplifiedShares, solidlyShares)
+}
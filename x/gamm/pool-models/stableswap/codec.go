@@ -15,6 +15,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Pool{}, "osmosis/gamm/StableswapPool", nil)
 	cdc.RegisterConcrete(&MsgCreateStableswapPool{}, "osmosis/gamm/create-stableswap-pool", nil)
 	cdc.RegisterConcrete(&MsgStableSwapAdjustScalingFactors{}, "osmosis/gamm/stableswap-adjust-scaling-factors", nil)
+	cdc.RegisterConcrete(&MsgStableSwapRampAmplification{}, "osmosis/gamm/stableswap-ramp-amplification", nil)
+	cdc.RegisterConcrete(&MsgStableSwapTransferScalingFactorGovernor{}, "osmosis/gamm/stableswap-transfer-scaling-factor-governor", nil)
+	cdc.RegisterConcrete(&MsgStableSwapAcceptScalingFactorGovernor{}, "osmosis/gamm/stableswap-accept-scaling-factor-governor", nil)
 	cdc.RegisterConcrete(&PoolParams{}, "osmosis/gamm/StableswapPoolParams", nil)
 }
 
@@ -28,6 +31,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreateStableswapPool{},
 		&MsgStableSwapAdjustScalingFactors{},
+		&MsgStableSwapRampAmplification{},
+		&MsgStableSwapTransferScalingFactorGovernor{},
+		&MsgStableSwapAcceptScalingFactorGovernor{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -1,6 +1,8 @@
 package stableswap
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -10,6 +12,10 @@ import (
 const (
 	TypeMsgCreateStableswapPool           = "create_stableswap_pool"
 	TypeMsgStableSwapAdjustScalingFactors = "stable_swap_adjust_scaling_factors"
+	TypeMsgStableSwapRampAmplification    = "stable_swap_ramp_amplification"
+
+	TypeMsgStableSwapTransferScalingFactorGovernor = "stable_swap_transfer_scaling_factor_governor"
+	TypeMsgStableSwapAcceptScalingFactorGovernor   = "stable_swap_accept_scaling_factor_governor"
 )
 
 var (
@@ -43,6 +49,10 @@ func (msg MsgCreateStableswapPool) ValidateBasic() error {
 	if err != nil {
 		return err
 	}
+	if msg.PoolParams.AmplificationRampParams != nil {
+		return sdkerrors.Wrap(types.ErrInvalidStableswapAmplification,
+			"can't ramp the amplification parameter on pool creation")
+	}
 
 	// validation for pool initial liquidity
 	// TO DO: expand this check to accommodate multi-asset pools for stableswap
@@ -105,10 +115,14 @@ var _ sdk.Msg = &MsgStableSwapAdjustScalingFactors{}
 func NewMsgStableSwapAdjustScalingFactors(
 	sender string,
 	poolID uint64,
+	scalingFactors []uint64,
+	duration time.Duration,
 ) MsgStableSwapAdjustScalingFactors {
 	return MsgStableSwapAdjustScalingFactors{
-		Sender: sender,
-		PoolID: poolID,
+		Sender:         sender,
+		PoolID:         poolID,
+		ScalingFactors: scalingFactors,
+		Duration:       duration,
 	}
 }
 
@@ -116,17 +130,24 @@ func (msg MsgStableSwapAdjustScalingFactors) Route() string {
 	return types.RouterKey
 }
 
-func (msg MsgStableSwapAdjustScalingFactors) Type() string { return TypeMsgCreateStableswapPool }
-func (msg MsgStableSwapAdjustScalingFactors) ValidateBasic() error {
-	if msg.Sender == "" {
-		return nil
-	}
+func (msg MsgStableSwapAdjustScalingFactors) Type() string {
+	return TypeMsgStableSwapAdjustScalingFactors
+}
 
+func (msg MsgStableSwapAdjustScalingFactors) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
 	}
 
+	if err := ValidateScalingFactors(msg.ScalingFactors, len(msg.ScalingFactors)); err != nil {
+		return err
+	}
+	if msg.Duration < 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidStableswapScalingFactors,
+			"ramp duration %s can't be negative", msg.Duration)
+	}
+
 	return nil
 }
 
@@ -142,3 +163,128 @@ func (msg MsgStableSwapAdjustScalingFactors) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{scalingFactorGovernor}
 }
+
+var _ sdk.Msg = &MsgStableSwapRampAmplification{}
+
+func NewMsgStableSwapRampAmplification(
+	sender string,
+	poolID uint64,
+	targetAmplification uint64,
+	duration time.Duration,
+) MsgStableSwapRampAmplification {
+	return MsgStableSwapRampAmplification{
+		Sender:                       sender,
+		PoolID:                       poolID,
+		TargetAmplificationParameter: targetAmplification,
+		Duration:                     duration,
+	}
+}
+
+func (msg MsgStableSwapRampAmplification) Route() string { return types.RouterKey }
+func (msg MsgStableSwapRampAmplification) Type() string  { return TypeMsgStableSwapRampAmplification }
+func (msg MsgStableSwapRampAmplification) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return ValidateTargetAmplification(msg.TargetAmplificationParameter, msg.Duration)
+}
+
+func (msg MsgStableSwapRampAmplification) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapRampAmplification) GetSigners() []sdk.AccAddress {
+	scalingFactorGovernor, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorGovernor}
+}
+
+var _ sdk.Msg = &MsgStableSwapTransferScalingFactorGovernor{}
+
+func NewMsgStableSwapTransferScalingFactorGovernor(
+	sender string,
+	poolID uint64,
+	newScalingFactorGovernor string,
+) MsgStableSwapTransferScalingFactorGovernor {
+	return MsgStableSwapTransferScalingFactorGovernor{
+		Sender:                   sender,
+		PoolID:                   poolID,
+		NewScalingFactorGovernor: newScalingFactorGovernor,
+	}
+}
+
+func (msg MsgStableSwapTransferScalingFactorGovernor) Route() string { return types.RouterKey }
+func (msg MsgStableSwapTransferScalingFactorGovernor) Type() string {
+	return TypeMsgStableSwapTransferScalingFactorGovernor
+}
+
+func (msg MsgStableSwapTransferScalingFactorGovernor) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.NewScalingFactorGovernor)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid new scaling factor governor address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgStableSwapTransferScalingFactorGovernor) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapTransferScalingFactorGovernor) GetSigners() []sdk.AccAddress {
+	scalingFactorGovernor, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorGovernor}
+}
+
+var _ sdk.Msg = &MsgStableSwapAcceptScalingFactorGovernor{}
+
+func NewMsgStableSwapAcceptScalingFactorGovernor(
+	sender string,
+	poolID uint64,
+) MsgStableSwapAcceptScalingFactorGovernor {
+	return MsgStableSwapAcceptScalingFactorGovernor{
+		Sender: sender,
+		PoolID: poolID,
+	}
+}
+
+func (msg MsgStableSwapAcceptScalingFactorGovernor) Route() string { return types.RouterKey }
+func (msg MsgStableSwapAcceptScalingFactorGovernor) Type() string {
+	return TypeMsgStableSwapAcceptScalingFactorGovernor
+}
+
+func (msg MsgStableSwapAcceptScalingFactorGovernor) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgStableSwapAcceptScalingFactorGovernor) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapAcceptScalingFactorGovernor) GetSigners() []sdk.AccAddress {
+	pendingScalingFactorGovernor, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{pendingScalingFactorGovernor}
+}
@@ -2,6 +2,7 @@ package stableswap
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -179,6 +180,189 @@ func TestMsgCreateStableswapPool(t *testing.T) {
 			}),
 			expectPass: true,
 		},
+		{
+			name: "amplification parameter",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.PoolParams.AmplificationParameter = 100
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "amplification parameter larger than max",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.PoolParams.AmplificationParameter = MaxAmplificationParameter + 1
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "amplification ramp on creation",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.PoolParams.AmplificationParameter = 100
+				msg.PoolParams.AmplificationRampParams = &AmplificationRampParams{
+					Duration:                      MinAmplificationRampDuration,
+					InitialAmplificationParameter: 100,
+					TargetAmplificationParameter:  200,
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgStableSwapRampAmplification(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+
+	defaultMsg := NewMsgStableSwapRampAmplification(addr1, 1, 200, MinAmplificationRampDuration)
+	require.Equal(t, types.RouterKey, defaultMsg.Route())
+	require.Equal(t, "stable_swap_ramp_amplification", defaultMsg.Type())
+	signers := defaultMsg.GetSigners()
+	require.Equal(t, 1, len(signers))
+	require.Equal(t, addr1, signers[0].String())
+
+	tests := []struct {
+		name       string
+		msg        MsgStableSwapRampAmplification
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        defaultMsg,
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msg:        NewMsgStableSwapRampAmplification(sdk.AccAddress("invalid").String(), 1, 200, MinAmplificationRampDuration),
+			expectPass: false,
+		},
+		{
+			name:       "zero target",
+			msg:        NewMsgStableSwapRampAmplification(addr1, 1, 0, MinAmplificationRampDuration),
+			expectPass: false,
+		},
+		{
+			name:       "max target",
+			msg:        NewMsgStableSwapRampAmplification(addr1, 1, MaxAmplificationParameter, MinAmplificationRampDuration),
+			expectPass: true,
+		},
+		{
+			name:       "target larger than max",
+			msg:        NewMsgStableSwapRampAmplification(addr1, 1, MaxAmplificationParameter+1, MinAmplificationRampDuration),
+			expectPass: false,
+		},
+		{
+			name:       "duration shorter than min",
+			msg:        NewMsgStableSwapRampAmplification(addr1, 1, 200, MinAmplificationRampDuration-time.Second),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgStableSwapAdjustScalingFactors(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+
+	defaultMsg := NewMsgStableSwapAdjustScalingFactors(addr1, 1, []uint64{1, 100}, time.Hour)
+	require.Equal(t, types.RouterKey, defaultMsg.Route())
+	require.Equal(t, "stable_swap_adjust_scaling_factors", defaultMsg.Type())
+	signers := defaultMsg.GetSigners()
+	require.Equal(t, 1, len(signers))
+	require.Equal(t, addr1, signers[0].String())
+
+	tests := []struct {
+		name       string
+		msg        MsgStableSwapAdjustScalingFactors
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        defaultMsg,
+			expectPass: true,
+		},
+		{
+			name:       "no duration",
+			msg:        NewMsgStableSwapAdjustScalingFactors(addr1, 1, []uint64{1, 100}, 0),
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msg:        NewMsgStableSwapAdjustScalingFactors(sdk.AccAddress("invalid").String(), 1, []uint64{1, 100}, time.Hour),
+			expectPass: false,
+		},
+		{
+			name:       "zero scaling factor",
+			msg:        NewMsgStableSwapAdjustScalingFactors(addr1, 1, []uint64{0, 100}, time.Hour),
+			expectPass: false,
+		},
+		{
+			name:       "negative duration",
+			msg:        NewMsgStableSwapAdjustScalingFactors(addr1, 1, []uint64{1, 100}, -time.Hour),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgStableSwapTransferScalingFactorGovernor(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	defaultMsg := NewMsgStableSwapTransferScalingFactorGovernor(addr1, 1, addr2)
+	require.Equal(t, types.RouterKey, defaultMsg.Route())
+	require.Equal(t, "stable_swap_transfer_scaling_factor_governor", defaultMsg.Type())
+	signers := defaultMsg.GetSigners()
+	require.Equal(t, 1, len(signers))
+	require.Equal(t, addr1, signers[0].String())
+
+	tests := []struct {
+		name       string
+		msg        MsgStableSwapTransferScalingFactorGovernor
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        defaultMsg,
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msg:        NewMsgStableSwapTransferScalingFactorGovernor(sdk.AccAddress("invalid").String(), 1, addr2),
+			expectPass: false,
+		},
+		{
+			name:       "invalid new scaling factor governor",
+			msg:        NewMsgStableSwapTransferScalingFactorGovernor(addr1, 1, ""),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -189,3 +373,19 @@ func TestMsgCreateStableswapPool(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgStableSwapAcceptScalingFactorGovernor(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	msg := NewMsgStableSwapAcceptScalingFactorGovernor(addr1, 1)
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, "stable_swap_accept_scaling_factor_governor", msg.Type())
+	signers := msg.GetSigners()
+	require.Equal(t, 1, len(signers))
+	require.Equal(t, addr1, signers[0].String())
+	require.NoError(t, msg.ValidateBasic())
+
+	invalidMsg := NewMsgStableSwapAcceptScalingFactorGovernor(sdk.AccAddress("invalid").String(), 1)
+	require.Error(t, invalidMsg.ValidateBasic())
+}
@@ -13,7 +13,10 @@ import (
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
-var _ types.PoolI = &Pool{}
+var (
+	_ types.PoolI                  = &Pool{}
+	_ types.PoolAmountOutExtension = &Pool{}
+)
 
 // NewStableswapPool returns a stableswap pool
 // Invariants that are assumed to be satisfied and not checked:
@@ -226,11 +229,10 @@ func (p *Pool) SwapInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDen
 }
 
 func (p Pool) SpotPrice(ctx sdk.Context, baseAssetDenom string, quoteAssetDenom string) (sdk.Dec, error) {
-	reserves, err := p.getScaledPoolAmts(baseAssetDenom, quoteAssetDenom)
+	scaledSpotPrice, err := p.spotPrice(baseAssetDenom, quoteAssetDenom)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
-	scaledSpotPrice := spotPrice(reserves[0], reserves[1])
 	spotPrice := p.getDescaledPoolAmt(baseAssetDenom, scaledSpotPrice)
 
 	return spotPrice, nil
@@ -268,5 +270,108 @@ func (p Pool) CalcExitPoolCoinsFromShares(ctx sdk.Context, exitingShares sdk.Int
 	return cfmm_common.CalcExitPool(ctx, &p, exitingShares, exitFee)
 }
 
-// no-op for stableswap
-func (p *Pool) PokePool(blockTime time.Time) {}
+// PokePool updates the pool's amplification parameter and scaling factors along their ramps,
+// and clears each ramp once it has ended.
+func (p *Pool) PokePool(blockTime time.Time) {
+	if ramp := p.PoolParams.AmplificationRampParams; ramp != nil {
+		p.PoolParams.AmplificationParameter = ramp.AmplificationParameter(blockTime)
+		if !blockTime.Before(ramp.EndTime()) {
+			p.PoolParams.AmplificationRampParams = nil
+		}
+	}
+
+	if ramp := p.ScalingFactorRampParams; ramp != nil {
+		p.ScalingFactor = ramp.ScalingFactors(blockTime)
+		if !blockTime.Before(ramp.EndTime()) {
+			p.ScalingFactorRampParams = nil
+		}
+	}
+}
+
+// SetScalingFactors ramps the pool's scaling factors from their current ones to scalingFactors over duration,
+// starting at blockTime, or sets them immediately if duration is zero.
+// This replaces any ramp in progress, so the pool must be poked beforehand.
+func (p *Pool) SetScalingFactors(scalingFactors []uint64, duration time.Duration, blockTime time.Time) error {
+	if err := ValidateScalingFactors(scalingFactors, p.NumAssets()); err != nil {
+		return err
+	}
+	if duration == 0 {
+		p.ScalingFactor = scalingFactors
+		p.ScalingFactorRampParams = nil
+		return nil
+	}
+
+	ramp := ScalingFactorRampParams{
+		StartTime:             blockTime,
+		Duration:              duration,
+		InitialScalingFactors: append([]uint64{}, p.ScalingFactor...),
+		TargetScalingFactors:  scalingFactors,
+	}
+	if err := ramp.Validate(); err != nil {
+		return err
+	}
+
+	p.ScalingFactorRampParams = &ramp
+	return nil
+}
+
+// SetAmplificationRamp ramps the pool's amplification parameter from its current one to targetAmplification
+// over duration, starting at blockTime. This replaces any ramp in progress, so the pool must be poked beforehand.
+func (p *Pool) SetAmplificationRamp(targetAmplification uint64, duration time.Duration, blockTime time.Time) error {
+	ramp := AmplificationRampParams{
+		StartTime:                     blockTime,
+		Duration:                      duration,
+		InitialAmplificationParameter: p.PoolParams.AmplificationParameter,
+		TargetAmplificationParameter:  targetAmplification,
+	}
+	if err := ramp.Validate(); err != nil {
+		return err
+	}
+
+	p.PoolParams.AmplificationRampParams = &ramp
+	return nil
+}
+
+func (p *Pool) CalcTokenInShareAmountOut(ctx sdk.Context, tokenInDenom string, shareOutAmount sdk.Int, swapFee sdk.Dec) (tokenInAmount sdk.Int, err error) {
+	tokenInAmount, err = p.calcSingleAssetInGivenPoolSharesOut(tokenInDenom, shareOutAmount, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if !tokenInAmount.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+	}
+	return tokenInAmount, nil
+}
+
+func (p *Pool) JoinPoolTokenInMaxShareAmountOut(ctx sdk.Context, tokenInDenom string, shareOutAmount sdk.Int) (tokenInAmount sdk.Int, err error) {
+	tokenInAmount, err = p.CalcTokenInShareAmountOut(ctx, tokenInDenom, shareOutAmount, p.GetSwapFee(ctx))
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	p.updatePoolForJoin(sdk.NewCoins(sdk.NewCoin(tokenInDenom, tokenInAmount)), shareOutAmount)
+	return tokenInAmount, nil
+}
+
+func (p *Pool) ExitSwapExactAmountOut(ctx sdk.Context, tokenOut sdk.Coin, shareInMaxAmount sdk.Int) (shareInAmount sdk.Int, err error) {
+	shareInAmount, err = p.calcPoolSharesInGivenSingleAssetOut(tokenOut, p.GetSwapFee(ctx), p.GetExitFee(ctx))
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if !shareInAmount.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "shares amount must be positive")
+	}
+	if shareInAmount.GT(shareInMaxAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "%s resulted shares is larger than the max amount of %s", shareInAmount, shareInMaxAmount)
+	}
+
+	p.TotalShares.Amount = p.TotalShares.Amount.Sub(shareInAmount)
+	p.updatePoolLiquidityForExit(sdk.NewCoins(tokenOut))
+	return shareInAmount, nil
+}
+
+func (p *Pool) IncreaseLiquidity(sharesOut sdk.Int, coinsIn sdk.Coins) {
+	p.updatePoolForJoin(coinsIn, sharesOut)
+}
@@ -1,11 +1,25 @@
 package stableswap
 
 import (
+	"math"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
+const (
+	// MaxAmplificationParameter is the highest amplification parameter a pool can have, as in Curve.
+	MaxAmplificationParameter = 1_000_000
+	// MaxAmplificationChange is the highest factor a ramp can change the amplification parameter by, as in Curve.
+	MaxAmplificationChange = 10
+	// MinAmplificationRampDuration is the shortest duration a ramp can change the amplification parameter over,
+	// so that LPs can react to it, as in Curve.
+	MinAmplificationRampDuration = 24 * time.Hour
+)
+
 func (params PoolParams) Validate() error {
 	if params.ExitFee.IsNegative() {
 		return types.ErrNegativeExitFee
@@ -22,5 +36,123 @@ func (params PoolParams) Validate() error {
 	if params.SwapFee.GTE(sdk.OneDec()) {
 		return types.ErrTooMuchSwapFee
 	}
+
+	if params.AmplificationParameter > MaxAmplificationParameter {
+		return sdkerrors.Wrapf(types.ErrInvalidStableswapAmplification,
+			"amplification parameter %d is larger than the max of %d", params.AmplificationParameter, MaxAmplificationParameter)
+	}
+
+	if params.AmplificationRampParams != nil {
+		return params.AmplificationRampParams.Validate()
+	}
+	return nil
+}
+
+// Validate checks that the ramp changes a positive amplification parameter to another one,
+// by at most a factor of MaxAmplificationChange, over at least MinAmplificationRampDuration.
+func (params AmplificationRampParams) Validate() error {
+	initial, target := params.InitialAmplificationParameter, params.TargetAmplificationParameter
+	if initial == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidStableswapAmplification,
+			"can't ramp the amplification parameter of a pool without one")
+	}
+	if err := ValidateTargetAmplification(target, params.Duration); err != nil {
+		return err
+	}
+	if target > initial*MaxAmplificationChange || initial > target*MaxAmplificationChange {
+		return sdkerrors.Wrapf(types.ErrInvalidStableswapAmplification,
+			"can't ramp the amplification parameter from %d to %d, by more than a factor of %d", initial, target, MaxAmplificationChange)
+	}
+	return nil
+}
+
+// ValidateTargetAmplification checks that the target amplification parameter of a ramp over duration is positive,
+// at most MaxAmplificationParameter, and that duration is at least MinAmplificationRampDuration.
+func ValidateTargetAmplification(target uint64, duration time.Duration) error {
+	if target == 0 || target > MaxAmplificationParameter {
+		return sdkerrors.Wrapf(types.ErrInvalidStableswapAmplification,
+			"target amplification parameter %d must be between 1 and %d", target, MaxAmplificationParameter)
+	}
+	if duration < MinAmplificationRampDuration {
+		return sdkerrors.Wrapf(types.ErrInvalidStableswapAmplification,
+			"ramp duration %s is shorter than the min of %s", duration, MinAmplificationRampDuration)
+	}
+	return nil
+}
+
+// EndTime returns the time at which the pool reaches the target amplification parameter.
+func (params AmplificationRampParams) EndTime() time.Time {
+	return params.StartTime.Add(params.Duration)
+}
+
+// AmplificationParameter returns the amplification parameter at blockTime,
+// linearly interpolated from the initial one at the start time to the target one at the end time.
+func (params AmplificationRampParams) AmplificationParameter(blockTime time.Time) uint64 {
+	if !blockTime.After(params.StartTime) {
+		return params.InitialAmplificationParameter
+	}
+	if !blockTime.Before(params.EndTime()) {
+		return params.TargetAmplificationParameter
+	}
+	initial := sdk.NewIntFromUint64(params.InitialAmplificationParameter).ToDec()
+	target := sdk.NewIntFromUint64(params.TargetAmplificationParameter).ToDec()
+	progress := sdk.NewDec(blockTime.Sub(params.StartTime).Milliseconds()).QuoInt64(params.Duration.Milliseconds())
+	return initial.Add(target.Sub(initial).Mul(progress)).TruncateInt().Uint64()
+}
+
+// ValidateScalingFactors checks that there is a scaling factor for each of the numAssets assets of a pool,
+// and that each of them is positive and fits in an int64.
+func ValidateScalingFactors(scalingFactors []uint64, numAssets int) error {
+	if len(scalingFactors) != numAssets {
+		return sdkerrors.Wrapf(types.ErrInvalidStableswapScalingFactors,
+			"got %d scaling factors for %d assets", len(scalingFactors), numAssets)
+	}
+	for _, scalingFactor := range scalingFactors {
+		if scalingFactor == 0 || scalingFactor > math.MaxInt64 {
+			return sdkerrors.Wrapf(types.ErrInvalidStableswapScalingFactors,
+				"scaling factor %d must be between 1 and %d", scalingFactor, uint64(math.MaxInt64))
+		}
+	}
 	return nil
 }
+
+// Validate checks that the ramp changes valid scaling factors to valid ones, over a positive duration.
+func (params ScalingFactorRampParams) Validate() error {
+	if err := ValidateScalingFactors(params.InitialScalingFactors, len(params.TargetScalingFactors)); err != nil {
+		return err
+	}
+	if err := ValidateScalingFactors(params.TargetScalingFactors, len(params.InitialScalingFactors)); err != nil {
+		return err
+	}
+	if params.Duration <= 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidStableswapScalingFactors,
+			"ramp duration %s must be positive", params.Duration)
+	}
+	return nil
+}
+
+// EndTime returns the time at which the pool reaches the target scaling factors.
+func (params ScalingFactorRampParams) EndTime() time.Time {
+	return params.StartTime.Add(params.Duration)
+}
+
+// ScalingFactors returns the scaling factors at blockTime,
+// linearly interpolated from the initial ones at the start time to the target ones at the end time.
+func (params ScalingFactorRampParams) ScalingFactors(blockTime time.Time) []uint64 {
+	scalingFactors := make([]uint64, len(params.TargetScalingFactors))
+	if !blockTime.After(params.StartTime) {
+		copy(scalingFactors, params.InitialScalingFactors)
+		return scalingFactors
+	}
+	if !blockTime.Before(params.EndTime()) {
+		copy(scalingFactors, params.TargetScalingFactors)
+		return scalingFactors
+	}
+	progress := sdk.NewDec(blockTime.Sub(params.StartTime).Milliseconds()).QuoInt64(params.Duration.Milliseconds())
+	for i := range scalingFactors {
+		initial := sdk.NewIntFromUint64(params.InitialScalingFactors[i]).ToDec()
+		target := sdk.NewIntFromUint64(params.TargetScalingFactors[i]).ToDec()
+		scalingFactors[i] = initial.Add(target.Sub(initial).Mul(progress)).TruncateInt().Uint64()
+	}
+	return scalingFactors
+}
@@ -0,0 +1,196 @@
+package stableswap
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAmplificationRampParamsValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		initial    uint64
+		target     uint64
+		duration   time.Duration
+		expectPass bool
+	}{
+		{"ramp up", 100, 200, MinAmplificationRampDuration, true},
+		{"ramp down", 200, 100, MinAmplificationRampDuration, true},
+		{"ramp up by the max change", 100, 100 * MaxAmplificationChange, MinAmplificationRampDuration, true},
+		{"ramp up by more than the max change", 100, 100*MaxAmplificationChange + 1, MinAmplificationRampDuration, false},
+		{"ramp down by more than the max change", 100*MaxAmplificationChange + 1, 100, MinAmplificationRampDuration, false},
+		{"ramp from no amplification", 0, 10, MinAmplificationRampDuration, false},
+		{"ramp to no amplification", 10, 0, MinAmplificationRampDuration, false},
+		{"ramp above the max", MaxAmplificationParameter, MaxAmplificationParameter + 1, MinAmplificationRampDuration, false},
+		{"duration shorter than min", 100, 200, MinAmplificationRampDuration - time.Second, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := AmplificationRampParams{
+				Duration:                      tc.duration,
+				InitialAmplificationParameter: tc.initial,
+				TargetAmplificationParameter:  tc.target,
+			}
+			err := params.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPokePoolAmplificationRamp(t *testing.T) {
+	poolLiquidity := sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+	p := createScaledTestPool(t, poolLiquidity, sdk.ZeroDec(), sdk.ZeroDec())
+	startTime := time.Unix(1650000000, 0).UTC()
+	duration := 2 * MinAmplificationRampDuration
+
+	// a pool without an amplification parameter can't be ramped
+	require.Error(t, p.SetAmplificationRamp(100, duration, startTime))
+
+	p.PoolParams.AmplificationParameter = 100
+	require.NoError(t, p.SetAmplificationRamp(300, duration, startTime))
+	require.Equal(t, &AmplificationRampParams{
+		StartTime:                     startTime,
+		Duration:                      duration,
+		InitialAmplificationParameter: 100,
+		TargetAmplificationParameter:  300,
+	}, p.PoolParams.AmplificationRampParams)
+
+	tests := []struct {
+		name                      string
+		blockTime                 time.Time
+		expectedAmplification     uint64
+		expectRampParamsToBeClear bool
+	}{
+		{"before start", startTime.Add(-time.Hour), 100, false},
+		{"at start", startTime, 100, false},
+		{"quarter way", startTime.Add(duration / 4), 150, false},
+		{"half way", startTime.Add(duration / 2), 200, false},
+		{"one second before end", startTime.Add(duration - time.Second), 299, false},
+		{"at end", startTime.Add(duration), 300, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pCopy := p.Copy()
+			ramp := *p.PoolParams.AmplificationRampParams
+			pCopy.PoolParams.AmplificationRampParams = &ramp
+			pCopy.PokePool(tc.blockTime)
+			require.Equal(t, tc.expectedAmplification, pCopy.PoolParams.AmplificationParameter)
+			if tc.expectRampParamsToBeClear {
+				require.Nil(t, pCopy.PoolParams.AmplificationRampParams)
+			} else {
+				require.NotNil(t, pCopy.PoolParams.AmplificationRampParams)
+			}
+		})
+	}
+
+	// ramping again replaces the ramp, starting from the poked amplification parameter
+	p.PokePool(startTime.Add(duration / 2))
+	require.NoError(t, p.SetAmplificationRamp(1_000, duration, startTime.Add(duration/2)))
+	require.Equal(t, uint64(200), p.PoolParams.AmplificationRampParams.InitialAmplificationParameter)
+	require.Error(t, p.SetAmplificationRamp(10, duration, startTime.Add(duration/2)))
+}
+
+func TestScalingFactorRampParamsValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		initial    []uint64
+		target     []uint64
+		duration   time.Duration
+		expectPass bool
+	}{
+		{"ramp", []uint64{1, 1}, []uint64{1, 2}, time.Hour, true},
+		{"zero initial scaling factor", []uint64{0, 1}, []uint64{1, 2}, time.Hour, false},
+		{"zero target scaling factor", []uint64{1, 1}, []uint64{0, 2}, time.Hour, false},
+		{"scaling factor larger than max int64", []uint64{1, 1}, []uint64{1, 1 << 63}, time.Hour, false},
+		{"mismatched lengths", []uint64{1, 1}, []uint64{1, 2, 3}, time.Hour, false},
+		{"zero duration", []uint64{1, 1}, []uint64{1, 2}, 0, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := ScalingFactorRampParams{
+				Duration:              tc.duration,
+				InitialScalingFactors: tc.initial,
+				TargetScalingFactors:  tc.target,
+			}
+			err := params.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPokePoolScalingFactorRamp(t *testing.T) {
+	poolLiquidity := sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+	p := createScaledTestPool(t, poolLiquidity, sdk.ZeroDec(), sdk.ZeroDec())
+	p.ScalingFactor = []uint64{1_000, 1_000}
+	startTime := time.Unix(1650000000, 0).UTC()
+	duration := 4 * time.Hour
+
+	require.Error(t, p.SetScalingFactors([]uint64{1_000}, duration, startTime))
+	require.Error(t, p.SetScalingFactors([]uint64{1_000, 0}, duration, startTime))
+	require.Error(t, p.SetScalingFactors([]uint64{1_000, 1_000}, -time.Hour, startTime))
+
+	require.NoError(t, p.SetScalingFactors([]uint64{1_000, 2_000}, duration, startTime))
+	require.Equal(t, []uint64{1_000, 1_000}, p.ScalingFactor)
+	require.Equal(t, &ScalingFactorRampParams{
+		StartTime:             startTime,
+		Duration:              duration,
+		InitialScalingFactors: []uint64{1_000, 1_000},
+		TargetScalingFactors:  []uint64{1_000, 2_000},
+	}, p.ScalingFactorRampParams)
+
+	tests := []struct {
+		name                      string
+		blockTime                 time.Time
+		expectedScalingFactors    []uint64
+		expectRampParamsToBeClear bool
+	}{
+		{"before start", startTime.Add(-time.Hour), []uint64{1_000, 1_000}, false},
+		{"at start", startTime, []uint64{1_000, 1_000}, false},
+		{"quarter way", startTime.Add(duration / 4), []uint64{1_000, 1_250}, false},
+		{"half way", startTime.Add(duration / 2), []uint64{1_000, 1_500}, false},
+		{"one second before end", startTime.Add(duration - time.Second), []uint64{1_000, 1_999}, false},
+		{"at end", startTime.Add(duration), []uint64{1_000, 2_000}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pCopy := p.Copy()
+			ramp := *p.ScalingFactorRampParams
+			pCopy.ScalingFactorRampParams = &ramp
+			pCopy.PokePool(tc.blockTime)
+			require.Equal(t, tc.expectedScalingFactors, pCopy.ScalingFactor)
+			if tc.expectRampParamsToBeClear {
+				require.Nil(t, pCopy.ScalingFactorRampParams)
+			} else {
+				require.NotNil(t, pCopy.ScalingFactorRampParams)
+			}
+		})
+	}
+
+	// doubling the scaling factor of foo makes bar cost more foo
+	halfway := p.Copy()
+	halfway.PokePool(startTime.Add(duration / 2))
+	spotPrice, err := halfway.SpotPrice(sdk.Context{}, "bar", "foo")
+	require.NoError(t, err)
+	initialSpotPrice, err := p.SpotPrice(sdk.Context{}, "bar", "foo")
+	require.NoError(t, err)
+	require.True(t, spotPrice.GT(initialSpotPrice), "spot price %s, initial spot price %s", spotPrice, initialSpotPrice)
+
+	// setting scaling factors without a duration replaces the ramp immediately
+	require.NoError(t, p.SetScalingFactors([]uint64{3_000, 1_000}, 0, startTime.Add(duration/2)))
+	require.Equal(t, []uint64{3_000, 1_000}, p.ScalingFactor)
+	require.Nil(t, p.ScalingFactorRampParams)
+}
@@ -7,20 +7,23 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,6 +38,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type PoolParams struct {
 	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
 	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
+	// amplification_parameter is the amplification coefficient A of the Curve
+	// StableSwap invariant. The higher it is, the more liquidity concentrates
+	// around the peg. If it is 0, the pool uses the Solidly invariant
+	// xy(x^2 + y^2) = k instead.
+	AmplificationParameter uint64 `protobuf:"varint,3,opt,name=amplification_parameter,json=amplificationParameter,proto3" json:"amplification_parameter,omitempty" yaml:"amplification_parameter"`
+	// amplification_ramp_params, if set, linearly ramp the amplification
+	// parameter over time.
+	AmplificationRampParams *AmplificationRampParams `protobuf:"bytes,4,opt,name=amplification_ramp_params,json=amplificationRampParams,proto3" json:"amplification_ramp_params,omitempty" yaml:"amplification_ramp_params"`
 }
 
 func (m *PoolParams) Reset()         { *m = PoolParams{} }
@@ -70,6 +81,172 @@ func (m *PoolParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PoolParams proto.InternalMessageInfo
 
+func (m *PoolParams) GetAmplificationParameter() uint64 {
+	if m != nil {
+		return m.AmplificationParameter
+	}
+	return 0
+}
+
+func (m *PoolParams) GetAmplificationRampParams() *AmplificationRampParams {
+	if m != nil {
+		return m.AmplificationRampParams
+	}
+	return nil
+}
+
+// AmplificationRampParams defines a linear change of a stableswap pool's
+// amplification parameter over time.
+type AmplificationRampParams struct {
+	// The start time for beginning the ramp.
+	// If a parameter change / pool instantiation leaves this blank,
+	// it should be generated by the state_machine as the current time.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// Duration for the amplification parameter to change over
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+	// The amplification parameter at the start time. This is set by the state
+	// machine to the pool's amplification parameter when the ramp is set.
+	InitialAmplificationParameter uint64 `protobuf:"varint,3,opt,name=initial_amplification_parameter,json=initialAmplificationParameter,proto3" json:"initial_amplification_parameter,omitempty" yaml:"initial_amplification_parameter"`
+	// The amplification parameter at the end of the ramp.
+	TargetAmplificationParameter uint64 `protobuf:"varint,4,opt,name=target_amplification_parameter,json=targetAmplificationParameter,proto3" json:"target_amplification_parameter,omitempty" yaml:"target_amplification_parameter"`
+}
+
+func (m *AmplificationRampParams) Reset()         { *m = AmplificationRampParams{} }
+func (m *AmplificationRampParams) String() string { return proto.CompactTextString(m) }
+func (*AmplificationRampParams) ProtoMessage()    {}
+func (*AmplificationRampParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae0f054436f9999a, []int{1}
+}
+func (m *AmplificationRampParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AmplificationRampParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AmplificationRampParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AmplificationRampParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AmplificationRampParams.Merge(m, src)
+}
+func (m *AmplificationRampParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *AmplificationRampParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_AmplificationRampParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AmplificationRampParams proto.InternalMessageInfo
+
+func (m *AmplificationRampParams) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *AmplificationRampParams) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *AmplificationRampParams) GetInitialAmplificationParameter() uint64 {
+	if m != nil {
+		return m.InitialAmplificationParameter
+	}
+	return 0
+}
+
+func (m *AmplificationRampParams) GetTargetAmplificationParameter() uint64 {
+	if m != nil {
+		return m.TargetAmplificationParameter
+	}
+	return 0
+}
+
+// ScalingFactorRampParams defines a linear change of a stableswap pool's
+// scaling factors over time.
+type ScalingFactorRampParams struct {
+	// The start time for beginning the ramp.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// Duration for the scaling factors to change over
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+	// The scaling factors at the start time. This is set by the state machine
+	// to the pool's scaling factors when the ramp is set.
+	InitialScalingFactors []uint64 `protobuf:"varint,3,rep,packed,name=initial_scaling_factors,json=initialScalingFactors,proto3" json:"initial_scaling_factors,omitempty" yaml:"initial_scaling_factors"`
+	// The scaling factors at the end of the ramp.
+	TargetScalingFactors []uint64 `protobuf:"varint,4,rep,packed,name=target_scaling_factors,json=targetScalingFactors,proto3" json:"target_scaling_factors,omitempty" yaml:"target_scaling_factors"`
+}
+
+func (m *ScalingFactorRampParams) Reset()         { *m = ScalingFactorRampParams{} }
+func (m *ScalingFactorRampParams) String() string { return proto.CompactTextString(m) }
+func (*ScalingFactorRampParams) ProtoMessage()    {}
+func (*ScalingFactorRampParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae0f054436f9999a, []int{2}
+}
+func (m *ScalingFactorRampParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScalingFactorRampParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScalingFactorRampParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScalingFactorRampParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalingFactorRampParams.Merge(m, src)
+}
+func (m *ScalingFactorRampParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScalingFactorRampParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalingFactorRampParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalingFactorRampParams proto.InternalMessageInfo
+
+func (m *ScalingFactorRampParams) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *ScalingFactorRampParams) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *ScalingFactorRampParams) GetInitialScalingFactors() []uint64 {
+	if m != nil {
+		return m.InitialScalingFactors
+	}
+	return nil
+}
+
+func (m *ScalingFactorRampParams) GetTargetScalingFactors() []uint64 {
+	if m != nil {
+		return m.TargetScalingFactors
+	}
+	return nil
+}
+
 // Pool is the stableswap Pool struct
 type Pool struct {
 	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
@@ -85,19 +262,26 @@ type Pool struct {
 	// would need to be locked up to count in governance. 0w means no lockup.
 	FuturePoolGovernor string `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
 	// sum of all LP shares
-	TotalShares types.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+	TotalShares types1.Coin `protobuf:"bytes,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
 	// assets in the pool
 	PoolLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=pool_liquidity,json=poolLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_liquidity"`
 	// for calculation amognst assets with different precisions
 	ScalingFactor []uint64 `protobuf:"varint,7,rep,packed,name=scaling_factor,json=scalingFactor,proto3" json:"scaling_factor,omitempty" yaml:"stableswap_scaling_factor"`
 	// scaling_factor_governor is the address can adjust pool scaling factors
 	ScalingFactorGovernor string `protobuf:"bytes,8,opt,name=scaling_factor_governor,json=scalingFactorGovernor,proto3" json:"scaling_factor_governor,omitempty" yaml:"scaling_factor_governor"`
+	// pending_scaling_factor_governor is the address the scaling factor governor
+	// has transferred the role to. It becomes the scaling factor governor once it
+	// accepts the role.
+	PendingScalingFactorGovernor string `protobuf:"bytes,9,opt,name=pending_scaling_factor_governor,json=pendingScalingFactorGovernor,proto3" json:"pending_scaling_factor_governor,omitempty" yaml:"pending_scaling_factor_governor"`
+	// scaling_factor_ramp_params, if set, linearly ramp the scaling factors over
+	// time.
+	ScalingFactorRampParams *ScalingFactorRampParams `protobuf:"bytes,10,opt,name=scaling_factor_ramp_params,json=scalingFactorRampParams,proto3" json:"scaling_factor_ramp_params,omitempty" yaml:"scaling_factor_ramp_params"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
 func (*Pool) ProtoMessage() {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae0f054436f9999a, []int{1}
+	return fileDescriptor_ae0f054436f9999a, []int{3}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -128,6 +312,8 @@ var xxx_messageInfo_Pool proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*PoolParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.PoolParams")
+	proto.RegisterType((*AmplificationRampParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.AmplificationRampParams")
+	proto.RegisterType((*ScalingFactorRampParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.ScalingFactorRampParams")
 	proto.RegisterType((*Pool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.Pool")
 }
 
@@ -136,47 +322,68 @@ func init() {
 }
 
 var fileDescriptor_ae0f054436f9999a = []byte{
-	// 632 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0xdb, 0xb4, 0x29, 0x5b, 0x1a, 0x84, 0x29, 0xc2, 0x6d, 0x85, 0x37, 0x5a, 0x09, 0x14,
-	0x21, 0x62, 0x53, 0x90, 0x40, 0xf4, 0x04, 0x01, 0x15, 0x21, 0x21, 0x51, 0xcc, 0x89, 0x0a, 0x29,
-	0x5a, 0xdb, 0x1b, 0x77, 0x85, 0x9d, 0x35, 0xde, 0x75, 0x69, 0x2f, 0x9c, 0x39, 0x72, 0xe4, 0xd8,
-	0x13, 0x07, 0xce, 0x7c, 0x44, 0xc5, 0xa9, 0x47, 0xc4, 0xc1, 0xa0, 0xf6, 0x0f, 0xf2, 0x05, 0x68,
-	0xd7, 0xeb, 0x26, 0x29, 0x55, 0x55, 0x89, 0x53, 0x76, 0x66, 0xde, 0xbc, 0x99, 0x79, 0x33, 0x31,
-	0x78, 0xc8, 0x78, 0xc2, 0x38, 0xe5, 0x6e, 0x84, 0x93, 0xc4, 0x4d, 0x19, 0x8b, 0x3b, 0x09, 0x0b,
-	0x49, 0xcc, 0x5d, 0x2e, 0xb0, 0x1f, 0x13, 0xfe, 0x01, 0xa7, 0x63, 0xcf, 0x9e, 0x44, 0x38, 0x69,
-	0xc6, 0x04, 0x33, 0x6f, 0xe9, 0x54, 0x47, 0xa6, 0x3a, 0x32, 0x50, 0x66, 0x3a, 0x23, 0xb8, 0xb3,
-	0xbd, 0xea, 0x13, 0x81, 0x57, 0x97, 0x97, 0x02, 0x05, 0xee, 0xa9, 0x4c, 0xb7, 0x34, 0x4a, 0x9a,
-	0xe5, 0xc5, 0x88, 0x45, 0xac, 0xf4, 0xcb, 0x97, 0xf6, 0xda, 0x11, 0x63, 0x51, 0x4c, 0x5c, 0x65,
-	0xf9, 0x79, 0xdf, 0x0d, 0xf3, 0x0c, 0x0b, 0xca, 0x06, 0x3a, 0x0e, 0x4f, 0xc6, 0x05, 0x4d, 0x08,
-	0x17, 0x38, 0x49, 0x2b, 0x82, 0xb2, 0x88, 0x8b, 0x73, 0xb1, 0xe5, 0xea, 0x36, 0x94, 0x71, 0x22,
-	0xee, 0x63, 0x4e, 0x8e, 0xe3, 0x01, 0xa3, 0xba, 0x00, 0xda, 0x37, 0x00, 0xd8, 0x60, 0x2c, 0xde,
-	0xc0, 0x19, 0x4e, 0xb8, 0xf9, 0x16, 0xcc, 0xa9, 0xf9, 0xfb, 0x84, 0x58, 0x46, 0xcb, 0x68, 0x5f,
-	0xe8, 0x3e, 0xde, 0x2f, 0x60, 0xed, 0x57, 0x01, 0x6f, 0x46, 0x54, 0x6c, 0xe5, 0xbe, 0x13, 0xb0,
-	0x44, 0x0f, 0xa6, 0x7f, 0x3a, 0x3c, 0x7c, 0xe7, 0x8a, 0xdd, 0x94, 0x70, 0xe7, 0x29, 0x09, 0x86,
-	0x05, 0xbc, 0xb4, 0x8b, 0x93, 0x78, 0x0d, 0x55, 0x3c, 0xc8, 0x6b, 0xc8, 0xe7, 0x3a, 0x21, 0x92,
-	0x9d, 0xec, 0x50, 0xa1, 0xd8, 0xa7, 0xfe, 0x8f, 0xbd, 0xe2, 0x41, 0x5e, 0x43, 0x3e, 0xd7, 0x09,
-	0x41, 0x5f, 0x67, 0x40, 0x5d, 0x8e, 0x62, 0xde, 0x06, 0x0d, 0x1c, 0x86, 0x19, 0xe1, 0x5c, 0xcf,
-	0x60, 0x0e, 0x0b, 0xd8, 0x2c, 0xf3, 0x74, 0x00, 0x79, 0x15, 0xc4, 0x6c, 0x82, 0x29, 0x1a, 0xaa,
-	0x76, 0xea, 0xde, 0x14, 0x0d, 0xcd, 0x8f, 0x60, 0x5e, 0x2e, 0xb9, 0x97, 0x2a, 0x45, 0xac, 0xe9,
-	0x96, 0xd1, 0x9e, 0xbf, 0x7b, 0xdf, 0x39, 0xff, 0x15, 0x38, 0x23, 0x3d, 0xbb, 0x37, 0xe4, 0x7c,
-	0xc3, 0x02, 0x5e, 0xd7, 0x9a, 0x4c, 0x5e, 0x98, 0xae, 0x81, 0x3c, 0x90, 0x8e, 0x56, 0xf0, 0x0a,
-	0x2c, 0xf6, 0x73, 0x91, 0x67, 0xa4, 0x84, 0x44, 0x6c, 0x9b, 0x64, 0x03, 0x96, 0x59, 0x75, 0x35,
-	0x0a, 0x1c, 0x16, 0x70, 0xa5, 0x24, 0x3b, 0x0d, 0x85, 0x3c, 0xb3, 0x74, 0xcb, 0x1e, 0x9e, 0x69,
-	0xa7, 0xf9, 0x06, 0x5c, 0x14, 0x4c, 0xe0, 0xb8, 0xc7, 0xb7, 0x70, 0x46, 0xb8, 0x35, 0xa3, 0x66,
-	0x5a, 0x72, 0xf4, 0x81, 0xca, 0xdb, 0x38, 0x6e, 0xfe, 0x09, 0xa3, 0x83, 0xee, 0x8a, 0x6e, 0xfb,
-	0x4a, 0x59, 0x69, 0x3c, 0x19, 0x79, 0xf3, 0xca, 0x7c, 0xad, 0x2c, 0x33, 0x03, 0x4d, 0xd5, 0x40,
-	0x4c, 0xdf, 0xe7, 0x34, 0xa4, 0x62, 0xd7, 0x9a, 0x6d, 0x4d, 0x9f, 0x4d, 0x7e, 0x47, 0x92, 0x7f,
-	0xfb, 0x0d, 0xdb, 0xe7, 0xd8, 0xb9, 0x4c, 0xe0, 0xde, 0x82, 0x2c, 0xf1, 0xa2, 0xaa, 0x60, 0xbe,
-	0x04, 0x4d, 0x1e, 0xe0, 0x98, 0x0e, 0xa2, 0x5e, 0x1f, 0x07, 0x82, 0x65, 0x56, 0xa3, 0x35, 0xdd,
-	0xae, 0x77, 0xdb, 0xba, 0xeb, 0xd6, 0x3f, 0x62, 0x4f, 0xc2, 0x91, 0xb7, 0xa0, 0x1d, 0xeb, 0xca,
-	0x36, 0x37, 0xc1, 0xb5, 0x49, 0xc4, 0x48, 0xf5, 0x39, 0xa5, 0x3a, 0x1a, 0x16, 0xd0, 0xd6, 0xac,
-	0xa7, 0x03, 0x91, 0x77, 0x75, 0x82, 0xb3, 0xd2, 0x7e, 0xed, 0xf2, 0xa7, 0x3d, 0x58, 0xfb, 0xb2,
-	0x07, 0x6b, 0x3f, 0xbe, 0x77, 0x66, 0xe4, 0x56, 0x9e, 0x77, 0x37, 0xf7, 0x0f, 0x6d, 0xe3, 0xe0,
-	0xd0, 0x36, 0xfe, 0x1c, 0xda, 0xc6, 0xe7, 0x23, 0xbb, 0x76, 0x70, 0x64, 0xd7, 0x7e, 0x1e, 0xd9,
-	0xb5, 0xcd, 0x47, 0x63, 0x92, 0xe8, 0x83, 0xeb, 0xc4, 0xd8, 0xe7, 0x95, 0xe1, 0x6e, 0x3f, 0x70,
-	0x77, 0xce, 0xfa, 0x86, 0xf9, 0xb3, 0xea, 0x6f, 0x7d, 0xef, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x44, 0x51, 0xea, 0xde, 0xf1, 0x04, 0x00, 0x00,
+	// 973 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc6, 0x6e, 0x9d, 0x8c, 0x69, 0x50, 0x97, 0xb4, 0x76, 0xd2, 0xc6, 0xe3, 0x8e, 0xd4,
+	0xca, 0xad, 0x9a, 0x5d, 0x12, 0x24, 0x10, 0x3d, 0x91, 0x6d, 0x55, 0x84, 0x84, 0x44, 0xd8, 0x20,
+	0x01, 0x01, 0xc9, 0x1a, 0xdb, 0x63, 0x67, 0xc4, 0xae, 0x67, 0xbb, 0x33, 0x0e, 0xcd, 0x85, 0x33,
+	0xc7, 0x8a, 0x53, 0x8f, 0xbd, 0x02, 0x57, 0xfe, 0x02, 0x2e, 0x44, 0x9c, 0xca, 0x0d, 0x71, 0xd8,
+	0xa2, 0xe4, 0xc6, 0x71, 0xff, 0x02, 0x34, 0x3f, 0xd6, 0xde, 0x4d, 0xec, 0xb4, 0x15, 0x37, 0x4e,
+	0xd9, 0x99, 0xf7, 0xbd, 0xef, 0xbd, 0x79, 0xef, 0xf3, 0x7b, 0x01, 0xef, 0x33, 0x1e, 0x32, 0x4e,
+	0xb9, 0x3b, 0xc4, 0x61, 0xe8, 0x46, 0x8c, 0x05, 0x1b, 0x21, 0xeb, 0x93, 0x80, 0xbb, 0x5c, 0xe0,
+	0x6e, 0x40, 0xf8, 0xb7, 0x38, 0xca, 0x7d, 0x76, 0x24, 0xc2, 0x89, 0x62, 0x26, 0x98, 0x7d, 0xc7,
+	0xb8, 0x3a, 0xd2, 0xd5, 0x91, 0x06, 0xed, 0xe9, 0x4c, 0xe1, 0xce, 0xc1, 0x66, 0x97, 0x08, 0xbc,
+	0xb9, 0xb6, 0xda, 0x53, 0xe0, 0x8e, 0xf2, 0x74, 0xf5, 0x41, 0xd3, 0xac, 0xad, 0x0c, 0xd9, 0x90,
+	0xe9, 0x7b, 0xf9, 0x65, 0x6e, 0x9b, 0x43, 0xc6, 0x86, 0x01, 0x71, 0xd5, 0xa9, 0x3b, 0x1e, 0xb8,
+	0xfd, 0x71, 0x8c, 0x05, 0x65, 0x23, 0x63, 0x87, 0xa7, 0xed, 0x82, 0x86, 0x84, 0x0b, 0x1c, 0x46,
+	0x19, 0x81, 0x0e, 0xe2, 0xe2, 0xb1, 0xd8, 0x77, 0x4d, 0x1a, 0xea, 0x70, 0xca, 0xde, 0xc5, 0x9c,
+	0x4c, 0xec, 0x3d, 0x46, 0x4d, 0x00, 0xf4, 0x47, 0x19, 0x80, 0x1d, 0xc6, 0x82, 0x1d, 0x1c, 0xe3,
+	0x90, 0xdb, 0x5f, 0x83, 0x45, 0xf5, 0xfe, 0x01, 0x21, 0x0d, 0xab, 0x65, 0xb5, 0x97, 0xbc, 0xed,
+	0xa3, 0x04, 0x96, 0xfe, 0x4a, 0xe0, 0xad, 0x21, 0x15, 0xfb, 0xe3, 0xae, 0xd3, 0x63, 0xa1, 0x79,
+	0x98, 0xf9, 0xb3, 0xc1, 0xfb, 0xdf, 0xb8, 0xe2, 0x30, 0x22, 0xdc, 0x79, 0x40, 0x7a, 0x69, 0x02,
+	0xdf, 0x3c, 0xc4, 0x61, 0x70, 0x0f, 0x65, 0x3c, 0xc8, 0xaf, 0xca, 0xcf, 0x87, 0x84, 0x48, 0x76,
+	0xf2, 0x98, 0x0a, 0xc5, 0xbe, 0xf0, 0xdf, 0xd8, 0x33, 0x1e, 0xe4, 0x57, 0xe5, 0xa7, 0x64, 0xff,
+	0x0a, 0xd4, 0x71, 0x18, 0x05, 0x74, 0x40, 0x7b, 0xaa, 0x84, 0x9d, 0x48, 0xbe, 0x89, 0x08, 0x12,
+	0x37, 0xca, 0x2d, 0xab, 0x5d, 0xf1, 0x50, 0x9a, 0xc0, 0xa6, 0x76, 0x9f, 0x03, 0x44, 0xfe, 0xd5,
+	0x82, 0x65, 0x27, 0x33, 0xd8, 0x3f, 0x5a, 0x60, 0xb5, 0xe8, 0x14, 0xe3, 0x30, 0xd2, 0x9e, 0xbc,
+	0x51, 0x69, 0x59, 0xed, 0xda, 0xd6, 0x7d, 0xe7, 0xd5, 0xa5, 0xe2, 0x6c, 0xe7, 0xc9, 0x7c, 0x1c,
+	0x46, 0xba, 0x03, 0x5e, 0xfb, 0x28, 0x81, 0x56, 0x9a, 0xc0, 0xd6, 0xac, 0x44, 0x73, 0x31, 0x91,
+	0x5f, 0xc7, 0xb3, 0x29, 0xd0, 0xaf, 0x65, 0x50, 0x9f, 0x43, 0x6f, 0x7f, 0x01, 0x00, 0x17, 0x38,
+	0x16, 0x1d, 0x29, 0x24, 0xd5, 0xe2, 0xda, 0xd6, 0x9a, 0xa3, 0x55, 0xe6, 0x64, 0x2a, 0x73, 0x3e,
+	0xcb, 0x54, 0xe6, 0xad, 0xcb, 0x06, 0xa5, 0x09, 0xbc, 0x6c, 0x9a, 0x3a, 0xf1, 0x45, 0x4f, 0x5e,
+	0x40, 0xcb, 0x5f, 0x52, 0x17, 0x12, 0x6e, 0xef, 0x83, 0xc5, 0x4c, 0xbc, 0xaa, 0xb9, 0xb5, 0xad,
+	0xd5, 0x33, 0xbc, 0x0f, 0x0c, 0xc0, 0xdb, 0x94, 0xb4, 0xff, 0x24, 0xd0, 0xce, 0x5c, 0xee, 0xb2,
+	0x90, 0x0a, 0x12, 0x46, 0xe2, 0x70, 0xda, 0xe3, 0xcc, 0x86, 0x9e, 0xca, 0x50, 0x13, 0x76, 0x3b,
+	0x06, 0x90, 0x8e, 0xa8, 0xa0, 0x38, 0xe8, 0x9c, 0xdf, 0xf0, 0x3b, 0x69, 0x02, 0x6f, 0x69, 0xae,
+	0x97, 0x38, 0x20, 0x7f, 0xdd, 0x20, 0xb6, 0x67, 0xf7, 0x9f, 0x81, 0xa6, 0xc0, 0xf1, 0x90, 0x88,
+	0xb9, 0x21, 0x2b, 0x2a, 0xe4, 0xed, 0x34, 0x81, 0x37, 0x75, 0xc8, 0xf3, 0xf1, 0xc8, 0xbf, 0xae,
+	0x01, 0xb3, 0x03, 0xa2, 0x1f, 0xca, 0xa0, 0xbe, 0xdb, 0xc3, 0x01, 0x1d, 0x0d, 0x1f, 0xe2, 0x9e,
+	0x60, 0xf1, 0xff, 0xac, 0x89, 0x7b, 0xa0, 0x9e, 0xf5, 0x84, 0xeb, 0x67, 0x76, 0x06, 0xea, 0x9d,
+	0xbc, 0x51, 0x6e, 0x95, 0x8b, 0xbf, 0xd6, 0x39, 0x40, 0xe4, 0x5f, 0x31, 0x96, 0x42, 0xa1, 0xb8,
+	0xfd, 0x39, 0xb8, 0x6a, 0x8a, 0x7f, 0x9a, 0xba, 0xa2, 0xa8, 0x6f, 0xa4, 0x09, 0x5c, 0x2f, 0x34,
+	0xe9, 0x0c, 0xf3, 0x8a, 0x36, 0x14, 0x89, 0xd1, 0x6f, 0x55, 0x50, 0x91, 0xd3, 0xd2, 0xbe, 0x0b,
+	0xaa, 0xb8, 0xdf, 0x8f, 0x09, 0xe7, 0x66, 0x4c, 0xda, 0x69, 0x02, 0x97, 0xcd, 0x4f, 0x56, 0x1b,
+	0x90, 0x9f, 0x41, 0xec, 0x65, 0xb0, 0x40, 0xfb, 0xaa, 0x9e, 0x15, 0x7f, 0x81, 0xf6, 0xed, 0xef,
+	0x40, 0x4d, 0x0e, 0x87, 0x6c, 0x7a, 0x94, 0x55, 0xa1, 0xdf, 0x7d, 0x9d, 0xe9, 0x31, 0x1d, 0xd9,
+	0xde, 0x4d, 0xd3, 0xdc, 0xf5, 0x49, 0x73, 0xf3, 0x4b, 0x6c, 0x32, 0x2d, 0x40, 0x34, 0x9d, 0xf2,
+	0x9f, 0x82, 0x95, 0xc1, 0x58, 0x8c, 0x63, 0xa2, 0x21, 0x43, 0x76, 0x40, 0xe2, 0x11, 0xd3, 0x12,
+	0x5e, 0xf2, 0x60, 0x9a, 0xc0, 0x6b, 0x9a, 0x6c, 0x16, 0x0a, 0xf9, 0xb6, 0xbe, 0x96, 0x39, 0x7c,
+	0x68, 0x2e, 0xed, 0x2f, 0xc1, 0x1b, 0x82, 0x09, 0xd9, 0xa3, 0x7d, 0x1c, 0x13, 0xde, 0xb8, 0x60,
+	0xc4, 0x63, 0x76, 0xa0, 0x5c, 0x3f, 0x93, 0xe4, 0xef, 0x33, 0x3a, 0xf2, 0xae, 0x99, 0xb4, 0xdf,
+	0x32, 0x7d, 0xc8, 0x39, 0x23, 0xbf, 0xa6, 0x8e, 0xbb, 0xea, 0x64, 0xc7, 0x60, 0x59, 0x25, 0x10,
+	0xd0, 0x47, 0x63, 0xda, 0xa7, 0xe2, 0xb0, 0x71, 0xb1, 0x55, 0x3e, 0x9f, 0xfc, 0x6d, 0x49, 0xfe,
+	0xd3, 0x0b, 0xd8, 0x7e, 0x85, 0xb5, 0x22, 0x1d, 0xb8, 0x7f, 0x49, 0x86, 0xf8, 0x38, 0x8b, 0x60,
+	0x7f, 0x02, 0x96, 0x8b, 0x92, 0x68, 0x54, 0x95, 0x72, 0xda, 0x26, 0xeb, 0xd6, 0x99, 0x62, 0x17,
+	0xe1, 0xc8, 0xbf, 0xc4, 0xf3, 0xd2, 0x91, 0x72, 0x2f, 0x22, 0xa6, 0x55, 0x5f, 0x54, 0x55, 0xcf,
+	0xc9, 0x7d, 0x0e, 0x10, 0xf9, 0x57, 0x0a, 0x9c, 0x93, 0xda, 0x3f, 0x02, 0x30, 0x22, 0xa3, 0xbe,
+	0x74, 0x99, 0x17, 0x63, 0x49, 0xc5, 0xc8, 0xcd, 0xc3, 0x97, 0x38, 0x20, 0xff, 0xba, 0x41, 0xec,
+	0xce, 0x0c, 0xf9, 0xb3, 0x05, 0xd6, 0x4e, 0xb9, 0xe6, 0xf7, 0x21, 0x78, 0xfd, 0x7d, 0x38, 0x67,
+	0xd6, 0x79, 0xb7, 0xcd, 0x3e, 0xbc, 0x31, 0xb3, 0x36, 0xc5, 0x85, 0xc8, 0x67, 0x73, 0xdc, 0xbb,
+	0xfc, 0xfd, 0x33, 0x58, 0x7a, 0xfa, 0x0c, 0x96, 0x7e, 0xff, 0x65, 0xe3, 0x82, 0x94, 0xed, 0x47,
+	0xde, 0xde, 0xd1, 0x71, 0xd3, 0x7a, 0x7e, 0xdc, 0xb4, 0xfe, 0x3e, 0x6e, 0x5a, 0x4f, 0x4e, 0x9a,
+	0xa5, 0xe7, 0x27, 0xcd, 0xd2, 0x9f, 0x27, 0xcd, 0xd2, 0xde, 0x07, 0x39, 0xcd, 0x98, 0xfc, 0x37,
+	0x02, 0xdc, 0xe5, 0xd9, 0xc1, 0x3d, 0x78, 0xcf, 0x7d, 0x7c, 0xde, 0xff, 0x91, 0xdd, 0x8b, 0x6a,
+	0x54, 0xbe, 0xf3, 0xef, 0x00, 0xf9, 0x78, 0x24, 0xae, 0x75, 0x0a, 0x00, 0x00,
 }
 
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
@@ -199,6 +406,23 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AmplificationRampParams != nil {
+		{
+			size, err := m.AmplificationRampParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AmplificationParameter != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.AmplificationParameter))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.ExitFee.Size()
 		i -= size
@@ -222,6 +446,130 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AmplificationRampParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AmplificationRampParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AmplificationRampParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetAmplificationParameter != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.TargetAmplificationParameter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InitialAmplificationParameter != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.InitialAmplificationParameter))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScalingFactorRampParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingFactorRampParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalingFactorRampParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetScalingFactors) > 0 {
+		dAtA5 := make([]byte, len(m.TargetScalingFactors)*10)
+		var j4 int
+		for _, num := range m.TargetScalingFactors {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InitialScalingFactors) > 0 {
+		dAtA7 := make([]byte, len(m.InitialScalingFactors)*10)
+		var j6 int
+		for _, num := range m.InitialScalingFactors {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Pool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -242,6 +590,25 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ScalingFactorRampParams != nil {
+		{
+			size, err := m.ScalingFactorRampParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.PendingScalingFactorGovernor) > 0 {
+		i -= len(m.PendingScalingFactorGovernor)
+		copy(dAtA[i:], m.PendingScalingFactorGovernor)
+		i = encodeVarintStableswapPool(dAtA, i, uint64(len(m.PendingScalingFactorGovernor)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.ScalingFactorGovernor) > 0 {
 		i -= len(m.ScalingFactorGovernor)
 		copy(dAtA[i:], m.ScalingFactorGovernor)
@@ -250,20 +617,20 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if len(m.ScalingFactor) > 0 {
-		dAtA2 := make([]byte, len(m.ScalingFactor)*10)
-		var j1 int
+		dAtA12 := make([]byte, len(m.ScalingFactor)*10)
+		var j11 int
 		for _, num := range m.ScalingFactor {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j11++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA12[j11] = uint8(num)
+			j11++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintStableswapPool(dAtA, i, uint64(j1))
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j11))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -344,6 +711,59 @@ func (m *PoolParams) Size() (n int) {
 	n += 1 + l + sovStableswapPool(uint64(l))
 	l = m.ExitFee.Size()
 	n += 1 + l + sovStableswapPool(uint64(l))
+	if m.AmplificationParameter != 0 {
+		n += 1 + sovStableswapPool(uint64(m.AmplificationParameter))
+	}
+	if m.AmplificationRampParams != nil {
+		l = m.AmplificationRampParams.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	return n
+}
+
+func (m *AmplificationRampParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	if m.InitialAmplificationParameter != 0 {
+		n += 1 + sovStableswapPool(uint64(m.InitialAmplificationParameter))
+	}
+	if m.TargetAmplificationParameter != 0 {
+		n += 1 + sovStableswapPool(uint64(m.TargetAmplificationParameter))
+	}
+	return n
+}
+
+func (m *ScalingFactorRampParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	if len(m.InitialScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.InitialScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
+	if len(m.TargetScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.TargetScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
 	return n
 }
 
@@ -385,6 +805,14 @@ func (m *Pool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStableswapPool(uint64(l))
 	}
+	l = len(m.PendingScalingFactorGovernor)
+	if l > 0 {
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	if m.ScalingFactorRampParams != nil {
+		l = m.ScalingFactorRampParams.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
 	return n
 }
 
@@ -491,6 +919,61 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmplificationParameter", wireType)
+			}
+			m.AmplificationParameter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmplificationParameter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmplificationRampParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AmplificationRampParams == nil {
+				m.AmplificationRampParams = &AmplificationRampParams{}
+			}
+			if err := m.AmplificationRampParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
@@ -512,7 +995,7 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *AmplificationRampParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -535,17 +1018,17 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: AmplificationRampParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AmplificationRampParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStableswapPool
@@ -555,7 +1038,429 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmplificationParameter", wireType)
+			}
+			m.InitialAmplificationParameter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialAmplificationParameter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmplificationParameter", wireType)
+			}
+			m.TargetAmplificationParameter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetAmplificationParameter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScalingFactorRampParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScalingFactorRampParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScalingFactorRampParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.InitialScalingFactors = append(m.InitialScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.InitialScalingFactors) == 0 {
+					m.InitialScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.InitialScalingFactors = append(m.InitialScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialScalingFactors", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TargetScalingFactors) == 0 {
+					m.TargetScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetScalingFactors", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -719,7 +1624,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolLiquidity = append(m.PoolLiquidity, types.Coin{})
+			m.PoolLiquidity = append(m.PoolLiquidity, types1.Coin{})
 			if err := m.PoolLiquidity[len(m.PoolLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -832,6 +1737,74 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			}
 			m.ScalingFactorGovernor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingScalingFactorGovernor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingScalingFactorGovernor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorRampParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScalingFactorRampParams == nil {
+				m.ScalingFactorRampParams = &ScalingFactorRampParams{}
+			}
+			if err := m.ScalingFactorRampParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
@@ -11,18 +11,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -148,6 +152,9 @@ type MsgStableSwapAdjustScalingFactors struct {
 	Sender         string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	PoolID         uint64   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	ScalingFactors []uint64 `protobuf:"varint,3,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factor"`
+	// The duration to ramp to the scaling factors over, starting at the current
+	// time. The scaling factors change immediately if it is zero.
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
 }
 
 func (m *MsgStableSwapAdjustScalingFactors) Reset()         { *m = MsgStableSwapAdjustScalingFactors{} }
@@ -204,6 +211,13 @@ func (m *MsgStableSwapAdjustScalingFactors) GetScalingFactors() []uint64 {
 	return nil
 }
 
+func (m *MsgStableSwapAdjustScalingFactors) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
 type MsgStableSwapAdjustScalingFactorsResponse struct {
 }
 
@@ -244,11 +258,331 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStableSwapAdjustScalingFactorsResponse proto.InternalMessageInfo
 
+type MsgStableSwapRampAmplification struct {
+	// Sender must be the pool's scaling_factor_governor in order for the tx to
+	// succeed
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// The amplification parameter to ramp to from the pool's current one,
+	// starting at the current time
+	TargetAmplificationParameter uint64        `protobuf:"varint,3,opt,name=target_amplification_parameter,json=targetAmplificationParameter,proto3" json:"target_amplification_parameter,omitempty" yaml:"target_amplification_parameter"`
+	Duration                     time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+}
+
+func (m *MsgStableSwapRampAmplification) Reset()         { *m = MsgStableSwapRampAmplification{} }
+func (m *MsgStableSwapRampAmplification) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampAmplification) ProtoMessage()    {}
+func (*MsgStableSwapRampAmplification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{4}
+}
+func (m *MsgStableSwapRampAmplification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampAmplification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampAmplification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampAmplification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampAmplification.Merge(m, src)
+}
+func (m *MsgStableSwapRampAmplification) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampAmplification) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampAmplification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampAmplification proto.InternalMessageInfo
+
+func (m *MsgStableSwapRampAmplification) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapRampAmplification) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgStableSwapRampAmplification) GetTargetAmplificationParameter() uint64 {
+	if m != nil {
+		return m.TargetAmplificationParameter
+	}
+	return 0
+}
+
+func (m *MsgStableSwapRampAmplification) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type MsgStableSwapRampAmplificationResponse struct {
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) Reset() {
+	*m = MsgStableSwapRampAmplificationResponse{}
+}
+func (m *MsgStableSwapRampAmplificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampAmplificationResponse) ProtoMessage()    {}
+func (*MsgStableSwapRampAmplificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{5}
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampAmplificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampAmplificationResponse.Merge(m, src)
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampAmplificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampAmplificationResponse proto.InternalMessageInfo
+
+type MsgStableSwapTransferScalingFactorGovernor struct {
+	// Sender must be the pool's scaling_factor_governor in order for the tx to
+	// succeed
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// The address to transfer the scaling factor governor role to. It only
+	// becomes the scaling factor governor once it accepts the role.
+	NewScalingFactorGovernor string `protobuf:"bytes,3,opt,name=new_scaling_factor_governor,json=newScalingFactorGovernor,proto3" json:"new_scaling_factor_governor,omitempty" yaml:"new_scaling_factor_governor"`
+}
+
+func (m *MsgStableSwapTransferScalingFactorGovernor) Reset() {
+	*m = MsgStableSwapTransferScalingFactorGovernor{}
+}
+func (m *MsgStableSwapTransferScalingFactorGovernor) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgStableSwapTransferScalingFactorGovernor) ProtoMessage() {}
+func (*MsgStableSwapTransferScalingFactorGovernor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{6}
+}
+func (m *MsgStableSwapTransferScalingFactorGovernor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapTransferScalingFactorGovernor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapTransferScalingFactorGovernor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapTransferScalingFactorGovernor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapTransferScalingFactorGovernor.Merge(m, src)
+}
+func (m *MsgStableSwapTransferScalingFactorGovernor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapTransferScalingFactorGovernor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapTransferScalingFactorGovernor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapTransferScalingFactorGovernor proto.InternalMessageInfo
+
+func (m *MsgStableSwapTransferScalingFactorGovernor) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapTransferScalingFactorGovernor) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgStableSwapTransferScalingFactorGovernor) GetNewScalingFactorGovernor() string {
+	if m != nil {
+		return m.NewScalingFactorGovernor
+	}
+	return ""
+}
+
+type MsgStableSwapTransferScalingFactorGovernorResponse struct {
+}
+
+func (m *MsgStableSwapTransferScalingFactorGovernorResponse) Reset() {
+	*m = MsgStableSwapTransferScalingFactorGovernorResponse{}
+}
+func (m *MsgStableSwapTransferScalingFactorGovernorResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgStableSwapTransferScalingFactorGovernorResponse) ProtoMessage() {}
+func (*MsgStableSwapTransferScalingFactorGovernorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{7}
+}
+func (m *MsgStableSwapTransferScalingFactorGovernorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapTransferScalingFactorGovernorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapTransferScalingFactorGovernorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapTransferScalingFactorGovernorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapTransferScalingFactorGovernorResponse.Merge(m, src)
+}
+func (m *MsgStableSwapTransferScalingFactorGovernorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapTransferScalingFactorGovernorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapTransferScalingFactorGovernorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapTransferScalingFactorGovernorResponse proto.InternalMessageInfo
+
+type MsgStableSwapAcceptScalingFactorGovernor struct {
+	// Sender must be the pool's pending_scaling_factor_governor in order for the
+	// tx to succeed
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *MsgStableSwapAcceptScalingFactorGovernor) Reset() {
+	*m = MsgStableSwapAcceptScalingFactorGovernor{}
+}
+func (m *MsgStableSwapAcceptScalingFactorGovernor) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapAcceptScalingFactorGovernor) ProtoMessage()    {}
+func (*MsgStableSwapAcceptScalingFactorGovernor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{8}
+}
+func (m *MsgStableSwapAcceptScalingFactorGovernor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapAcceptScalingFactorGovernor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapAcceptScalingFactorGovernor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapAcceptScalingFactorGovernor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapAcceptScalingFactorGovernor.Merge(m, src)
+}
+func (m *MsgStableSwapAcceptScalingFactorGovernor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapAcceptScalingFactorGovernor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapAcceptScalingFactorGovernor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapAcceptScalingFactorGovernor proto.InternalMessageInfo
+
+func (m *MsgStableSwapAcceptScalingFactorGovernor) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapAcceptScalingFactorGovernor) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+type MsgStableSwapAcceptScalingFactorGovernorResponse struct {
+}
+
+func (m *MsgStableSwapAcceptScalingFactorGovernorResponse) Reset() {
+	*m = MsgStableSwapAcceptScalingFactorGovernorResponse{}
+}
+func (m *MsgStableSwapAcceptScalingFactorGovernorResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgStableSwapAcceptScalingFactorGovernorResponse) ProtoMessage() {}
+func (*MsgStableSwapAcceptScalingFactorGovernorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{9}
+}
+func (m *MsgStableSwapAcceptScalingFactorGovernorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapAcceptScalingFactorGovernorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapAcceptScalingFactorGovernorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapAcceptScalingFactorGovernorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapAcceptScalingFactorGovernorResponse.Merge(m, src)
+}
+func (m *MsgStableSwapAcceptScalingFactorGovernorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapAcceptScalingFactorGovernorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapAcceptScalingFactorGovernorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapAcceptScalingFactorGovernorResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateStableswapPool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPool")
 	proto.RegisterType((*MsgCreateStableswapPoolResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPoolResponse")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactors)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactors")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactorsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactorsResponse")
+	proto.RegisterType((*MsgStableSwapRampAmplification)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampAmplification")
+	proto.RegisterType((*MsgStableSwapRampAmplificationResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampAmplificationResponse")
+	proto.RegisterType((*MsgStableSwapTransferScalingFactorGovernor)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapTransferScalingFactorGovernor")
+	proto.RegisterType((*MsgStableSwapTransferScalingFactorGovernorResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapTransferScalingFactorGovernorResponse")
+	proto.RegisterType((*MsgStableSwapAcceptScalingFactorGovernor)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAcceptScalingFactorGovernor")
+	proto.RegisterType((*MsgStableSwapAcceptScalingFactorGovernorResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAcceptScalingFactorGovernorResponse")
 }
 
 func init() {
@@ -256,44 +590,60 @@ func init() {
 }
 
 var fileDescriptor_46b7c8a0f24de97c = []byte{
-	// 583 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4d, 0x6f, 0xd3, 0x3e,
-	0x1c, 0xc7, 0x9b, 0xb6, 0xea, 0x5f, 0x7f, 0x57, 0x80, 0x88, 0xaa, 0x51, 0x8a, 0x94, 0x94, 0x70,
-	0xe9, 0x80, 0xc6, 0xac, 0x48, 0x20, 0x38, 0x41, 0x8a, 0x86, 0x26, 0xa8, 0xb4, 0xa5, 0xe2, 0xb2,
-	0x4b, 0xe5, 0x34, 0x5e, 0x30, 0x24, 0x71, 0x88, 0xdd, 0x6e, 0x3d, 0x72, 0xe7, 0xc0, 0xcb, 0x40,
-	0xbc, 0x90, 0x69, 0xc7, 0x1d, 0x39, 0x05, 0xd4, 0xbe, 0x83, 0xde, 0xb8, 0x21, 0xc7, 0xe9, 0xc3,
-	0xa4, 0x75, 0x5b, 0xa5, 0x9d, 0xe2, 0xfc, 0xf2, 0xf1, 0xf7, 0xfb, 0x7b, 0x70, 0x0c, 0x1e, 0x53,
-	0x16, 0x50, 0x46, 0x18, 0xf4, 0x50, 0x10, 0xc0, 0x88, 0x52, 0xbf, 0x19, 0x50, 0x17, 0xfb, 0x0c,
-	0x32, 0x8e, 0x1c, 0x1f, 0xb3, 0x43, 0x14, 0x41, 0x7e, 0x64, 0x46, 0x31, 0xe5, 0x54, 0x7d, 0x98,
-	0xd1, 0xa6, 0xa0, 0x4d, 0x41, 0x4b, 0xd8, 0x5c, 0xc0, 0xe6, 0x70, 0xcb, 0xc1, 0x1c, 0x6d, 0xd5,
-	0xb4, 0x7e, 0x0a, 0x43, 0x07, 0x31, 0x0c, 0xb3, 0x20, 0xec, 0x53, 0x12, 0x4a, 0xad, 0x5a, 0xc5,
-	0xa3, 0x1e, 0x4d, 0x97, 0x50, 0xac, 0xb2, 0xe8, 0x8b, 0xab, 0xe4, 0xb3, 0x58, 0xf6, 0x04, 0x21,
-	0xb7, 0x1a, 0xdf, 0x0a, 0xe0, 0x4e, 0x87, 0x79, 0xed, 0x18, 0x23, 0x8e, 0xbb, 0x73, 0x64, 0x97,
-	0x52, 0x5f, 0xdd, 0x04, 0x25, 0x86, 0x43, 0x17, 0xc7, 0x55, 0xa5, 0xae, 0x34, 0xfe, 0xb7, 0x6e,
-	0x4f, 0x13, 0xfd, 0xc6, 0x08, 0x05, 0xfe, 0x4b, 0x43, 0xc6, 0x0d, 0x3b, 0x03, 0x54, 0x0a, 0xca,
-	0x42, 0xb4, 0x17, 0xa1, 0x18, 0x05, 0xac, 0x9a, 0xaf, 0x2b, 0x8d, 0x72, 0xeb, 0x99, 0x79, 0xf5,
-	0xca, 0x4d, 0xe1, 0xb8, 0x9b, 0xee, 0xb6, 0x36, 0xa6, 0x89, 0xae, 0x4a, 0x9f, 0x25, 0x51, 0xc3,
-	0x06, 0xd1, 0x9c, 0x51, 0xbf, 0x2a, 0x60, 0x83, 0x84, 0x84, 0x13, 0xe4, 0xa7, 0xe5, 0xf4, 0x7c,
-	0xf2, 0x65, 0x40, 0x5c, 0xc2, 0x47, 0xd5, 0x42, 0xbd, 0xd0, 0x28, 0xb7, 0xee, 0x9a, 0xb2, 0x95,
-	0xa6, 0x68, 0xe5, 0xdc, 0xa5, 0x4d, 0x49, 0x68, 0x3d, 0x39, 0x49, 0xf4, 0xdc, 0xcf, 0xdf, 0x7a,
-	0xc3, 0x23, 0xfc, 0xe3, 0xc0, 0x31, 0xfb, 0x34, 0x80, 0x59, 0xdf, 0xe5, 0xa3, 0xc9, 0xdc, 0xcf,
-	0x90, 0x8f, 0x22, 0xcc, 0xd2, 0x0d, 0xcc, 0xae, 0x64, 0x56, 0x22, 0xc9, 0xf7, 0x33, 0x23, 0x75,
-	0x0f, 0x54, 0x0e, 0x06, 0x7c, 0x10, 0x63, 0x99, 0x81, 0x47, 0x87, 0x38, 0x0e, 0x69, 0x5c, 0x2d,
-	0xa6, 0xdd, 0xd2, 0xa7, 0x89, 0x7e, 0x4f, 0x56, 0x71, 0x1e, 0x65, 0xd8, 0xaa, 0x0c, 0x0b, 0xcd,
-	0xb7, 0xb3, 0xe0, 0x36, 0xd0, 0x57, 0x4c, 0xc3, 0xc6, 0x2c, 0xa2, 0x21, 0xc3, 0xea, 0x03, 0xf0,
-	0x5f, 0x2a, 0x44, 0xdc, 0x74, 0x2c, 0x45, 0x0b, 0x8c, 0x13, 0xbd, 0x24, 0x90, 0x9d, 0x37, 0x76,
-	0x49, 0x7c, 0xda, 0x71, 0x8d, 0x63, 0x05, 0xdc, 0xef, 0x30, 0x4f, 0x4a, 0x74, 0x0f, 0x51, 0xf4,
-	0xda, 0xfd, 0x34, 0x60, 0xbc, 0xdb, 0x47, 0x3e, 0x09, 0xbd, 0x6d, 0xd4, 0xe7, 0x34, 0x66, 0xeb,
-	0x0c, 0x78, 0xc9, 0x35, 0xbf, 0xca, 0x55, 0xdd, 0x03, 0xb7, 0x98, 0x74, 0xe8, 0x1d, 0x48, 0x8b,
-	0x74, 0x18, 0x45, 0xab, 0x21, 0x3a, 0x3e, 0x4d, 0xf4, 0x7a, 0x26, 0xbe, 0x38, 0x8a, 0x67, 0x79,
-	0xc3, 0xbe, 0xc9, 0xce, 0xa4, 0x68, 0x3c, 0x02, 0x9b, 0x97, 0xd6, 0x31, 0x6b, 0x4d, 0xeb, 0x6f,
-	0x1e, 0x14, 0x3a, 0xcc, 0x53, 0x7f, 0x28, 0xa0, 0x72, 0xee, 0x89, 0x6e, 0xaf, 0x73, 0x22, 0x57,
-	0x0c, 0xa2, 0xf6, 0xee, 0x1a, 0x44, 0xe6, 0xd3, 0x3c, 0x56, 0x80, 0x76, 0xc9, 0x94, 0x3a, 0x6b,
-	0xfa, 0x5d, 0x2c, 0x57, 0xfb, 0x70, 0xad, 0x72, 0xb3, 0x42, 0xac, 0xfd, 0x93, 0xb1, 0xa6, 0x9c,
-	0x8e, 0x35, 0xe5, 0xcf, 0x58, 0x53, 0xbe, 0x4f, 0xb4, 0xdc, 0xe9, 0x44, 0xcb, 0xfd, 0x9a, 0x68,
-	0xb9, 0xfd, 0x57, 0x4b, 0xbf, 0x59, 0x66, 0xdd, 0xf4, 0x91, 0xc3, 0x66, 0x2f, 0x70, 0xf8, 0x1c,
-	0x1e, 0x5d, 0x74, 0x75, 0x39, 0xa5, 0xf4, 0xae, 0x7a, 0xfa, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x28,
-	0x03, 0x33, 0x73, 0x78, 0x05, 0x00, 0x00,
+	// 846 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x31, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x25, 0x43, 0x6e, 0xcf, 0x68, 0x8d, 0x12, 0x82, 0xab, 0x4a, 0x05, 0xa9, 0xb2, 0xb0,
+	0x2b, 0xbb, 0x35, 0x69, 0xab, 0x45, 0x8b, 0x76, 0xaa, 0x65, 0xc3, 0x85, 0xdb, 0x0a, 0xb0, 0x69,
+	0xb7, 0x83, 0x51, 0x54, 0x38, 0x91, 0x27, 0x86, 0x09, 0xc9, 0x63, 0x78, 0x27, 0xcb, 0xce, 0x96,
+	0x3d, 0x43, 0xc6, 0x20, 0xbf, 0x20, 0xc8, 0x9e, 0x31, 0x6b, 0xe0, 0xd1, 0x63, 0x80, 0x00, 0x74,
+	0x22, 0xfd, 0x03, 0xad, 0x59, 0x02, 0xf2, 0x48, 0x4a, 0x4a, 0x2c, 0x59, 0x36, 0xe4, 0x4c, 0xa2,
+	0x8e, 0xdf, 0xfb, 0xbe, 0xf7, 0xbe, 0x77, 0xef, 0x8e, 0xe0, 0x07, 0x4c, 0x6c, 0x4c, 0x4c, 0xa2,
+	0x18, 0xd0, 0xb6, 0x15, 0x17, 0x63, 0x6b, 0xd5, 0xc6, 0x3a, 0xb2, 0x88, 0x42, 0x28, 0x6c, 0x58,
+	0x88, 0xb4, 0xa1, 0xab, 0xd0, 0x63, 0xd9, 0xf5, 0x30, 0xc5, 0xfc, 0x4a, 0x84, 0x96, 0x03, 0xb4,
+	0x1c, 0xa0, 0x19, 0x58, 0xee, 0x83, 0xe5, 0xa3, 0xf5, 0x06, 0xa2, 0x70, 0xbd, 0x20, 0x68, 0x21,
+	0x58, 0x69, 0x40, 0x82, 0x94, 0x68, 0x51, 0xd1, 0xb0, 0xe9, 0x30, 0xae, 0x42, 0xce, 0xc0, 0x06,
+	0x0e, 0x1f, 0x95, 0xe0, 0x29, 0x5a, 0x15, 0x0c, 0x8c, 0x0d, 0x0b, 0x29, 0xe1, 0xbf, 0x46, 0xab,
+	0xa9, 0xe8, 0x2d, 0x0f, 0x52, 0x13, 0xc7, 0x51, 0xbf, 0x4e, 0x92, 0x6f, 0xff, 0xb1, 0x1e, 0x20,
+	0x58, 0xa8, 0xf4, 0x20, 0x03, 0xbe, 0xac, 0x11, 0x63, 0xd3, 0x43, 0x90, 0xa2, 0xfd, 0x04, 0xb2,
+	0x8b, 0xb1, 0xc5, 0x2f, 0x83, 0x2c, 0x41, 0x8e, 0x8e, 0xbc, 0x3c, 0x57, 0xe2, 0xca, 0x9f, 0x56,
+	0xbf, 0xe8, 0xf9, 0xe2, 0x67, 0x27, 0xd0, 0xb6, 0x7e, 0x93, 0xd8, 0xba, 0xa4, 0x46, 0x00, 0x1e,
+	0x83, 0xb9, 0x80, 0xb4, 0xee, 0x42, 0x0f, 0xda, 0x24, 0x9f, 0x2e, 0x71, 0xe5, 0xb9, 0xca, 0xcf,
+	0xf2, 0xe4, 0xce, 0xc8, 0x81, 0xe2, 0x6e, 0x18, 0x5d, 0x5d, 0xe8, 0xf9, 0x22, 0xcf, 0x74, 0x06,
+	0x48, 0x25, 0x15, 0xb8, 0x09, 0x86, 0xbf, 0xcf, 0x81, 0x05, 0xd3, 0x31, 0xa9, 0x09, 0xad, 0xb0,
+	0x9c, 0xba, 0x65, 0xde, 0x6d, 0x99, 0xba, 0x49, 0x4f, 0xf2, 0x99, 0x52, 0xa6, 0x3c, 0x57, 0xf9,
+	0x4a, 0x66, 0x56, 0xcb, 0x81, 0xd5, 0x89, 0xca, 0x26, 0x36, 0x9d, 0xea, 0xda, 0xa9, 0x2f, 0xa6,
+	0x9e, 0x9e, 0x8b, 0x65, 0xc3, 0xa4, 0xb7, 0x5a, 0x0d, 0x59, 0xc3, 0xb6, 0x12, 0xf5, 0x85, 0xfd,
+	0xac, 0x12, 0xfd, 0x8e, 0x42, 0x4f, 0x5c, 0x44, 0xc2, 0x00, 0xa2, 0xe6, 0x22, 0xa9, 0x20, 0xc9,
+	0xbf, 0x63, 0x21, 0x7e, 0x0f, 0xe4, 0x9a, 0x2d, 0xda, 0xf2, 0x10, 0xcb, 0xc0, 0xc0, 0x47, 0xc8,
+	0x73, 0xb0, 0x97, 0x9f, 0x09, 0xdd, 0x12, 0x7b, 0xbe, 0x58, 0x64, 0x55, 0x5c, 0x84, 0x92, 0x54,
+	0x9e, 0x2d, 0x07, 0x9c, 0x7f, 0xc4, 0x8b, 0xdb, 0x40, 0x1c, 0xd1, 0x0d, 0x15, 0x11, 0x17, 0x3b,
+	0x04, 0xf1, 0xdf, 0x82, 0xd9, 0x90, 0xc8, 0xd4, 0xc3, 0xb6, 0xcc, 0x54, 0x41, 0xc7, 0x17, 0xb3,
+	0x01, 0x64, 0x67, 0x4b, 0xcd, 0x06, 0xaf, 0x76, 0x74, 0xe9, 0x71, 0x1a, 0x7c, 0x53, 0x23, 0x06,
+	0xa3, 0xd8, 0x6f, 0x43, 0x77, 0x43, 0xbf, 0xdd, 0x22, 0x74, 0x5f, 0x83, 0x96, 0xe9, 0x18, 0xdb,
+	0x50, 0xa3, 0xd8, 0x23, 0x57, 0x69, 0xf0, 0x80, 0x6a, 0x7a, 0x94, 0x2a, 0xbf, 0x07, 0xe6, 0x09,
+	0x53, 0xa8, 0x37, 0x99, 0x44, 0xd8, 0x8c, 0x99, 0x6a, 0x39, 0x70, 0xbc, 0xe7, 0x8b, 0xa5, 0x88,
+	0xbc, 0xbf, 0x15, 0x87, 0xf1, 0x92, 0xfa, 0x39, 0x19, 0x4e, 0x51, 0x05, 0x9f, 0xc4, 0x9b, 0x3d,
+	0xf4, 0x35, 0x68, 0x2c, 0x9b, 0x06, 0x39, 0x9e, 0x06, 0x79, 0x2b, 0x02, 0x54, 0x8b, 0x91, 0xcc,
+	0x3c, 0x93, 0x89, 0x03, 0xa5, 0x47, 0xe7, 0x22, 0xa7, 0x26, 0x3c, 0xd2, 0xf7, 0x60, 0xf9, 0x52,
+	0x6f, 0x62, 0xbb, 0xa5, 0x67, 0x69, 0x20, 0x0c, 0xa1, 0x55, 0x68, 0xbb, 0x1b, 0xb6, 0x6b, 0x99,
+	0x4d, 0x53, 0x0b, 0xf9, 0xa6, 0x6e, 0x23, 0x06, 0x02, 0x85, 0x9e, 0x81, 0x68, 0x1d, 0x0e, 0xea,
+	0xb0, 0x39, 0x40, 0x14, 0x79, 0xf9, 0x4c, 0x18, 0xbb, 0xdc, 0xf3, 0xc5, 0x45, 0xa6, 0x33, 0x1e,
+	0x2f, 0xa9, 0x5f, 0x33, 0xc0, 0x50, 0xde, 0xbb, 0xf1, 0xeb, 0x1b, 0x31, 0xb9, 0x0c, 0x96, 0xc6,
+	0xdb, 0x96, 0x38, 0xdc, 0xe5, 0xc0, 0xca, 0x10, 0xf4, 0xc0, 0x83, 0x0e, 0x69, 0x22, 0x6f, 0xa8,
+	0x23, 0xf1, 0x88, 0x4c, 0xdd, 0x6d, 0x04, 0x8a, 0x0e, 0x6a, 0xbf, 0xb7, 0x11, 0xfb, 0xc3, 0x9c,
+	0x09, 0x45, 0x96, 0x7a, 0xbe, 0x28, 0x31, 0x91, 0x31, 0x60, 0x49, 0xcd, 0x3b, 0xa8, 0x7d, 0x61,
+	0xda, 0xd2, 0x4f, 0xa0, 0x32, 0x79, 0x91, 0x89, 0x37, 0xf7, 0x40, 0x79, 0x78, 0xab, 0x6a, 0x1a,
+	0x72, 0xe9, 0x47, 0x31, 0x46, 0xaa, 0x80, 0xb5, 0x49, 0xb5, 0xe3, 0x7c, 0x2b, 0x6f, 0x67, 0x41,
+	0xa6, 0x46, 0x0c, 0xfe, 0x09, 0x07, 0x72, 0x17, 0xde, 0x29, 0x9b, 0x57, 0xb9, 0x13, 0x46, 0x1c,
+	0x85, 0x85, 0xbf, 0xa6, 0x40, 0x92, 0x9c, 0xa7, 0x2f, 0x38, 0x20, 0x5c, 0x72, 0x4e, 0xd6, 0xae,
+	0xa8, 0x37, 0x9e, 0xae, 0xf0, 0xcf, 0x54, 0xe9, 0x92, 0x42, 0x9e, 0x73, 0xa0, 0x38, 0xee, 0x98,
+	0xfa, 0xf3, 0xda, 0xb2, 0x1f, 0x70, 0x15, 0xd4, 0xe9, 0x71, 0x25, 0xf9, 0xbf, 0xe1, 0xc0, 0x77,
+	0x93, 0x1e, 0x02, 0xff, 0x5e, 0x5b, 0x7f, 0x2c, 0x6f, 0xe1, 0xff, 0x9b, 0xe1, 0x4d, 0x6a, 0x7c,
+	0xc5, 0x81, 0xc5, 0xc9, 0xa6, 0xf9, 0xe0, 0xfa, 0x9b, 0x64, 0x34, 0x6b, 0xe1, 0xbf, 0x9b, 0x60,
+	0x8d, 0xab, 0xab, 0x1e, 0x9e, 0x76, 0x04, 0xee, 0xac, 0x23, 0x70, 0xaf, 0x3b, 0x02, 0xf7, 0xb0,
+	0x2b, 0xa4, 0xce, 0xba, 0x42, 0xea, 0x65, 0x57, 0x48, 0x1d, 0xfe, 0x3e, 0xf0, 0xa9, 0x15, 0x65,
+	0xb0, 0x6a, 0xc1, 0x06, 0x89, 0xff, 0x28, 0x47, 0xbf, 0x28, 0xc7, 0xe3, 0x3e, 0x5f, 0x1b, 0xd9,
+	0xf0, 0x26, 0xfa, 0xf1, 0xdd, 0x00, 0xc5, 0xb8, 0xe5, 0xc8, 0x9c, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -310,6 +660,9 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateStableswapPool(ctx context.Context, in *MsgCreateStableswapPool, opts ...grpc.CallOption) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(ctx context.Context, in *MsgStableSwapAdjustScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapRampAmplification(ctx context.Context, in *MsgStableSwapRampAmplification, opts ...grpc.CallOption) (*MsgStableSwapRampAmplificationResponse, error)
+	StableSwapTransferScalingFactorGovernor(ctx context.Context, in *MsgStableSwapTransferScalingFactorGovernor, opts ...grpc.CallOption) (*MsgStableSwapTransferScalingFactorGovernorResponse, error)
+	StableSwapAcceptScalingFactorGovernor(ctx context.Context, in *MsgStableSwapAcceptScalingFactorGovernor, opts ...grpc.CallOption) (*MsgStableSwapAcceptScalingFactorGovernorResponse, error)
 }
 
 type msgClient struct {
@@ -338,10 +691,40 @@ func (c *msgClient) StableSwapAdjustScalingFactors(ctx context.Context, in *MsgS
 	return out, nil
 }
 
+func (c *msgClient) StableSwapRampAmplification(ctx context.Context, in *MsgStableSwapRampAmplification, opts ...grpc.CallOption) (*MsgStableSwapRampAmplificationResponse, error) {
+	out := new(MsgStableSwapRampAmplificationResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampAmplification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StableSwapTransferScalingFactorGovernor(ctx context.Context, in *MsgStableSwapTransferScalingFactorGovernor, opts ...grpc.CallOption) (*MsgStableSwapTransferScalingFactorGovernorResponse, error) {
+	out := new(MsgStableSwapTransferScalingFactorGovernorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapTransferScalingFactorGovernor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StableSwapAcceptScalingFactorGovernor(ctx context.Context, in *MsgStableSwapAcceptScalingFactorGovernor, opts ...grpc.CallOption) (*MsgStableSwapAcceptScalingFactorGovernorResponse, error) {
+	out := new(MsgStableSwapAcceptScalingFactorGovernorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapAcceptScalingFactorGovernor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateStableswapPool(context.Context, *MsgCreateStableswapPool) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(context.Context, *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapRampAmplification(context.Context, *MsgStableSwapRampAmplification) (*MsgStableSwapRampAmplificationResponse, error)
+	StableSwapTransferScalingFactorGovernor(context.Context, *MsgStableSwapTransferScalingFactorGovernor) (*MsgStableSwapTransferScalingFactorGovernorResponse, error)
+	StableSwapAcceptScalingFactorGovernor(context.Context, *MsgStableSwapAcceptScalingFactorGovernor) (*MsgStableSwapAcceptScalingFactorGovernorResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -354,6 +737,15 @@ func (*UnimplementedMsgServer) CreateStableswapPool(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) StableSwapAdjustScalingFactors(ctx context.Context, req *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StableSwapAdjustScalingFactors not implemented")
 }
+func (*UnimplementedMsgServer) StableSwapRampAmplification(ctx context.Context, req *MsgStableSwapRampAmplification) (*MsgStableSwapRampAmplificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapRampAmplification not implemented")
+}
+func (*UnimplementedMsgServer) StableSwapTransferScalingFactorGovernor(ctx context.Context, req *MsgStableSwapTransferScalingFactorGovernor) (*MsgStableSwapTransferScalingFactorGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapTransferScalingFactorGovernor not implemented")
+}
+func (*UnimplementedMsgServer) StableSwapAcceptScalingFactorGovernor(ctx context.Context, req *MsgStableSwapAcceptScalingFactorGovernor) (*MsgStableSwapAcceptScalingFactorGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapAcceptScalingFactorGovernor not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -395,39 +787,105 @@ func _Msg_StableSwapAdjustScalingFactors_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.gamm.poolmodels.stableswap.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateStableswapPool",
-			Handler:    _Msg_CreateStableswapPool_Handler,
-		},
-		{
-			MethodName: "StableSwapAdjustScalingFactors",
-			Handler:    _Msg_StableSwapAdjustScalingFactors_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/gamm/pool-models/stableswap/tx.proto",
-}
-
-func (m *MsgCreateStableswapPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_StableSwapRampAmplification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapRampAmplification)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateStableswapPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapRampAmplification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampAmplification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapRampAmplification(ctx, req.(*MsgStableSwapRampAmplification))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateStableswapPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func _Msg_StableSwapTransferScalingFactorGovernor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapTransferScalingFactorGovernor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapTransferScalingFactorGovernor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapTransferScalingFactorGovernor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapTransferScalingFactorGovernor(ctx, req.(*MsgStableSwapTransferScalingFactorGovernor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StableSwapAcceptScalingFactorGovernor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapAcceptScalingFactorGovernor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapAcceptScalingFactorGovernor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapAcceptScalingFactorGovernor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapAcceptScalingFactorGovernor(ctx, req.(*MsgStableSwapAcceptScalingFactorGovernor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.gamm.poolmodels.stableswap.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateStableswapPool",
+			Handler:    _Msg_CreateStableswapPool_Handler,
+		},
+		{
+			MethodName: "StableSwapAdjustScalingFactors",
+			Handler:    _Msg_StableSwapAdjustScalingFactors_Handler,
+		},
+		{
+			MethodName: "StableSwapRampAmplification",
+			Handler:    _Msg_StableSwapRampAmplification_Handler,
+		},
+		{
+			MethodName: "StableSwapTransferScalingFactorGovernor",
+			Handler:    _Msg_StableSwapTransferScalingFactorGovernor_Handler,
+		},
+		{
+			MethodName: "StableSwapAcceptScalingFactorGovernor",
+			Handler:    _Msg_StableSwapAcceptScalingFactorGovernor_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/gamm/pool-models/stableswap/tx.proto",
+}
+
+func (m *MsgCreateStableswapPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStableswapPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStableswapPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -523,21 +981,29 @@ func (m *MsgStableSwapAdjustScalingFactors) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
 	if len(m.ScalingFactors) > 0 {
-		dAtA3 := make([]byte, len(m.ScalingFactors)*10)
-		var j2 int
+		dAtA4 := make([]byte, len(m.ScalingFactors)*10)
+		var j3 int
 		for _, num := range m.ScalingFactors {
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j3++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -579,6 +1045,200 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgStableSwapRampAmplification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRampAmplification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampAmplification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if m.TargetAmplificationParameter != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TargetAmplificationParameter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapTransferScalingFactorGovernor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapTransferScalingFactorGovernor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapTransferScalingFactorGovernor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewScalingFactorGovernor) > 0 {
+		i -= len(m.NewScalingFactorGovernor)
+		copy(dAtA[i:], m.NewScalingFactorGovernor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewScalingFactorGovernor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapTransferScalingFactorGovernorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapTransferScalingFactorGovernorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapTransferScalingFactorGovernorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapAcceptScalingFactorGovernor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapAcceptScalingFactorGovernor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapAcceptScalingFactorGovernor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapAcceptScalingFactorGovernorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapAcceptScalingFactorGovernorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapAcceptScalingFactorGovernorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -626,48 +1286,850 @@ func (m *MsgCreateStableswapPoolResponse) Size() (n int) {
 	if m.PoolID != 0 {
 		n += 1 + sovTx(uint64(m.PoolID))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgStableSwapAdjustScalingFactors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if len(m.ScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.ScalingFactors {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStableSwapAdjustScalingFactorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStableSwapRampAmplification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if m.TargetAmplificationParameter != 0 {
+		n += 1 + sovTx(uint64(m.TargetAmplificationParameter))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStableSwapTransferScalingFactorGovernor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	l = len(m.NewScalingFactorGovernor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStableSwapTransferScalingFactorGovernorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStableSwapAcceptScalingFactorGovernor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	return n
+}
+
+func (m *MsgStableSwapAcceptScalingFactorGovernorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateStableswapPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStableswapPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStableswapPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PoolParams == nil {
+				m.PoolParams = &PoolParams{}
+			}
+			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialPoolLiquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialPoolLiquidity = append(m.InitialPoolLiquidity, types.Coin{})
+			if err := m.InitialPoolLiquidity[len(m.InitialPoolLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuturePoolGovernor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FuturePoolGovernor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateStableswapPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStableswapPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStableswapPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapAdjustScalingFactors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapAdjustScalingFactors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapAdjustScalingFactors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ScalingFactors = append(m.ScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ScalingFactors) == 0 {
+					m.ScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ScalingFactors = append(m.ScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactors", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapAdjustScalingFactorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapAdjustScalingFactorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapAdjustScalingFactorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapRampAmplification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAmplificationParameter", wireType)
+			}
+			m.TargetAmplificationParameter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetAmplificationParameter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgStableSwapAdjustScalingFactors) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolID != 0 {
-		n += 1 + sovTx(uint64(m.PoolID))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.ScalingFactors) > 0 {
-		l = 0
-		for _, e := range m.ScalingFactors {
-			l += sovTx(uint64(e))
+	return nil
+}
+func (m *MsgStableSwapRampAmplificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovTx(uint64(l)) + l
 	}
-	return n
-}
 
-func (m *MsgStableSwapAdjustScalingFactorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateStableswapPool) Unmarshal(dAtA []byte) error {
+func (m *MsgStableSwapTransferScalingFactorGovernor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -690,10 +2152,10 @@ func (m *MsgCreateStableswapPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateStableswapPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStableSwapTransferScalingFactorGovernor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateStableswapPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStableSwapTransferScalingFactorGovernor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -729,10 +2191,10 @@ func (m *MsgCreateStableswapPool) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
-			var msglen int
+			m.PoolID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -742,65 +2204,14 @@ func (m *MsgCreateStableswapPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PoolParams == nil {
-				m.PoolParams = &PoolParams{}
-			}
-			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialPoolLiquidity", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InitialPoolLiquidity = append(m.InitialPoolLiquidity, types.Coin{})
-			if err := m.InitialPoolLiquidity[len(m.InitialPoolLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuturePoolGovernor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewScalingFactorGovernor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -828,7 +2239,7 @@ func (m *MsgCreateStableswapPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FuturePoolGovernor = string(dAtA[iNdEx:postIndex])
+			m.NewScalingFactorGovernor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -851,7 +2262,7 @@ func (m *MsgCreateStableswapPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateStableswapPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgStableSwapTransferScalingFactorGovernorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -874,31 +2285,12 @@ func (m *MsgCreateStableswapPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateStableswapPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStableSwapTransferScalingFactorGovernorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateStableswapPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStableSwapTransferScalingFactorGovernorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
-			}
-			m.PoolID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -920,7 +2312,7 @@ func (m *MsgCreateStableswapPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStableSwapAdjustScalingFactors) Unmarshal(dAtA []byte) error {
+func (m *MsgStableSwapAcceptScalingFactorGovernor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -943,10 +2335,10 @@ func (m *MsgStableSwapAdjustScalingFactors) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStableSwapAdjustScalingFactors: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStableSwapAcceptScalingFactorGovernor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStableSwapAdjustScalingFactors: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStableSwapAcceptScalingFactorGovernor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1000,82 +2392,6 @@ func (m *MsgStableSwapAdjustScalingFactors) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ScalingFactors = append(m.ScalingFactors, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ScalingFactors) == 0 {
-					m.ScalingFactors = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ScalingFactors = append(m.ScalingFactors, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactors", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1097,7 +2413,7 @@ func (m *MsgStableSwapAdjustScalingFactors) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStableSwapAdjustScalingFactorsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgStableSwapAcceptScalingFactorGovernorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1120,10 +2436,10 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStableSwapAdjustScalingFactorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStableSwapAcceptScalingFactorGovernorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStableSwapAdjustScalingFactorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStableSwapAcceptScalingFactorGovernorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
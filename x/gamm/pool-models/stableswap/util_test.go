@@ -19,3 +19,21 @@ func createTestPool(t *testing.T, poolLiquidity sdk.Coins, swapFee, exitFee sdk.
 
 	return &pool
 }
+
+// createScaledTestPool returns a stableswap pool with a scaling factor of 1 for every asset.
+func createScaledTestPool(t *testing.T, poolLiquidity sdk.Coins, swapFee, exitFee sdk.Dec) *Pool {
+	pool := createTestPool(t, poolLiquidity, swapFee, exitFee).(*Pool)
+	pool.ScalingFactor = make([]uint64, poolLiquidity.Len())
+	for i := range pool.ScalingFactor {
+		pool.ScalingFactor[i] = 1
+	}
+	return pool
+}
+
+func sumCoinAmounts(coins sdk.Coins) sdk.Int {
+	sum := sdk.ZeroInt()
+	for _, coin := range coins {
+		sum = sum.Add(coin.Amount)
+	}
+	return sum
+}
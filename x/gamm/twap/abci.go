@@ -2,12 +2,10 @@ package twap
 
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
-func (k twapkeeper) endBlockLogic(ctx sdk.Context) {
-	// TODO: Update TWAP entries
-	// step 1: Get all altered pool ids
-	changedPoolIds := k.getChangedPools(ctx)
-	if len(changedPoolIds) == 0 {
-		return
+// EndBlock updates the records of all pools that had a potential spot price change in this block.
+// The 'altered pool ids' are tracked in the transient store, so they are automatically cleared.
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	if err := k.updateRecords(ctx); err != nil {
+		panic(err)
 	}
-	// 'altered pool ids' should be automatically cleared
 }
@@ -0,0 +1,140 @@
+package twap
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/twap/types"
+)
+
+// twapComputeFunc computes a twap between two records, given the quote asset.
+type twapComputeFunc func(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec
+
+// GetArithmeticTwap returns an arithmetic time weighted average price.
+// The returned twap is the time weighted average price (TWAP) of:
+// * the pool's spot price for (baseAssetDenom, quoteAssetDenom), as given by gamm's CalculateSpotPrice
+// * from (startTime, endTime),
+// * as determined by prices from AMM pool poolId.
+//
+// startTime and endTime do not have to be real block times that occurred,
+// the accumulators are interpolated from the last record at or before each of them.
+// If endTime is the current block time, this is GetArithmeticTwapToNow.
+//
+// This function will error if:
+// * startTime > endTime
+// * endTime in the future
+// * startTime is older than the oldest record stored for the pool
+// * spot price data for the pool errored at any point between startTime and endTime
+func (k Keeper) GetArithmeticTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, computeArithmeticTwap)
+}
+
+// GetArithmeticTwapToNow returns GetArithmeticTwap on the input, with endTime being fixed to ctx.BlockTime()
+func (k Keeper) GetArithmeticTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, computeArithmeticTwap)
+}
+
+// GetGeometricTwap returns a geometric time weighted average price.
+// The returned twap is the time weighted geometric mean of the same spot price
+// as GetArithmeticTwap, over the same time range, and errors in the same cases.
+//
+// The geometric mean is never larger than the arithmetic mean, and is less
+// sensitive to short-lived price spikes. It also satisfies
+// GetGeometricTwap(base, quote) = 1 / GetGeometricTwap(quote, base),
+// up to rounding.
+func (k Keeper) GetGeometricTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, computeGeometricTwap)
+}
+
+// GetGeometricTwapToNow returns GetGeometricTwap on the input, with endTime being fixed to ctx.BlockTime()
+func (k Keeper) GetGeometricTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (sdk.Dec, error) {
+	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, computeGeometricTwap)
+}
+
+func (k Keeper) getTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+	computeFn twapComputeFunc,
+) (sdk.Dec, error) {
+	if startTime.After(endTime) {
+		return sdk.Dec{}, types.StartTimeAfterEndTimeError(startTime, endTime)
+	}
+	if endTime.Equal(ctx.BlockTime()) {
+		return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, computeFn)
+	} else if endTime.After(ctx.BlockTime()) {
+		return sdk.Dec{}, types.EndTimeInFutureError(endTime, ctx.BlockTime())
+	}
+	startRecord, err := k.getInterpolatedRecord(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	endRecord, err := k.getInterpolatedRecord(ctx, poolId, endTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return computeTwap(startRecord, endRecord, quoteAssetDenom, computeFn)
+}
+
+func (k Keeper) getTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	computeFn twapComputeFunc,
+) (sdk.Dec, error) {
+	if startTime.After(ctx.BlockTime()) {
+		return sdk.Dec{}, types.StartTimeAfterEndTimeError(startTime, ctx.BlockTime())
+	}
+	startRecord, err := k.getInterpolatedRecord(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	endRecord, err := k.getMostRecentRecord(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return computeTwap(startRecord, endRecord, quoteAssetDenom, computeFn)
+}
+
+// computeTwap computes the twap between the two records with computeFn,
+// erroring if the spot price errored at any point after the start record.
+func computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string, computeFn twapComputeFunc) (sdk.Dec, error) {
+	if !endRecord.LastErrorTime.Before(startRecord.Time) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrSpotPriceAffected,
+			"pool %d had a spot price error at %s", endRecord.PoolId, endRecord.LastErrorTime)
+	}
+	return computeFn(startRecord, endRecord, quoteAsset), nil
+}
@@ -0,0 +1,260 @@
+package twap_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/osmomath"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/twap/types"
+)
+
+// weightedAverage returns sum(prices[i] * durations[i]) / sum(durations),
+// using the millisecond granularity of the twap accumulators.
+func weightedAverage(prices []sdk.Dec, durations []time.Duration) sdk.Dec {
+	total := sdk.ZeroDec()
+	totalMs := int64(0)
+	for i := range prices {
+		total = total.Add(prices[i].MulInt64(durations[i].Milliseconds()))
+		totalMs += durations[i].Milliseconds()
+	}
+	return total.QuoInt64(totalMs)
+}
+
+// weightedGeometricAverage returns 2^(sum(log2(prices[i]) * durations[i]) / sum(durations)).
+func weightedGeometricAverage(prices []sdk.Dec, durations []time.Duration) sdk.Dec {
+	logPrices := make([]sdk.Dec, len(prices))
+	for i, p := range prices {
+		logPrices[i] = osmomath.BigDecFromSDKDec(p).LogBase2().SDKDec()
+	}
+	exponent := weightedAverage(logPrices, durations)
+	return osmomath.Exp2(osmomath.BigDecFromSDKDec(exponent)).SDKDec()
+}
+
+func (s *TestSuite) TestGetArithmeticTwap() {
+	s.SetupTest()
+	poolId := s.preparePool()
+	t0 := s.Ctx.BlockTime()
+	sp0 := s.spotPrice(poolId, denom0, denom1)
+	invSp0 := s.spotPrice(poolId, denom1, denom0)
+
+	// price stays at sp0 for 10s, then changes to sp1 for 20s.
+	s.advanceTime(10 * time.Second)
+	s.swapAndEndBlock(poolId, sdk.NewCoin(denom0, sdk.NewInt(100_000)), denom1)
+	sp1 := s.spotPrice(poolId, denom0, denom1)
+	invSp1 := s.spotPrice(poolId, denom1, denom0)
+	s.Require().NotEqual(sp0, sp1)
+	s.advanceTime(20 * time.Second)
+
+	tests := map[string]struct {
+		base      string
+		quote     string
+		startTime time.Time
+		endTime   time.Time
+		expTwap   sdk.Dec
+	}{
+		"full range": {
+			base: denom0, quote: denom1,
+			startTime: t0, endTime: t0.Add(30 * time.Second),
+			expTwap: weightedAverage([]sdk.Dec{sp0, sp1}, []time.Duration{10 * time.Second, 20 * time.Second}),
+		},
+		"full range, inverted pair": {
+			base: denom1, quote: denom0,
+			startTime: t0, endTime: t0.Add(30 * time.Second),
+			expTwap: weightedAverage([]sdk.Dec{invSp0, invSp1}, []time.Duration{10 * time.Second, 20 * time.Second}),
+		},
+		"interpolated start and end": {
+			base: denom0, quote: denom1,
+			startTime: t0.Add(5 * time.Second), endTime: t0.Add(20 * time.Second),
+			expTwap: weightedAverage([]sdk.Dec{sp0, sp1}, []time.Duration{5 * time.Second, 10 * time.Second}),
+		},
+		"range before the price change": {
+			base: denom0, quote: denom1,
+			startTime: t0.Add(time.Second), endTime: t0.Add(9 * time.Second),
+			expTwap: sp0,
+		},
+		"start time equals end time": {
+			base: denom0, quote: denom1,
+			startTime: t0.Add(15 * time.Second), endTime: t0.Add(15 * time.Second),
+			expTwap: sp1,
+		},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			twap, err := s.App.TwapKeeper.GetArithmeticTwap(s.Ctx, poolId, tc.base, tc.quote, tc.startTime, tc.endTime)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expTwap, twap)
+
+			res, err := s.queryClient.ArithmeticTwap(sdk.WrapSDKContext(s.Ctx), &types.QueryArithmeticTwapRequest{
+				PoolId:     poolId,
+				BaseAsset:  tc.base,
+				QuoteAsset: tc.quote,
+				StartTime:  tc.startTime,
+				EndTime:    &tc.endTime,
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expTwap, res.ArithmeticTwap)
+		})
+	}
+}
+
+func (s *TestSuite) TestGetArithmeticTwapToNow() {
+	s.SetupTest()
+	poolId := s.preparePool()
+	t0 := s.Ctx.BlockTime()
+	sp0 := s.spotPrice(poolId, denom0, denom1)
+
+	s.advanceTime(time.Minute)
+	s.swapAndEndBlock(poolId, sdk.NewCoin(denom1, sdk.NewInt(300_000)), denom0)
+	sp1 := s.spotPrice(poolId, denom0, denom1)
+	s.advanceTime(3 * time.Minute)
+
+	expTwap := weightedAverage([]sdk.Dec{sp0, sp1}, []time.Duration{time.Minute, 3 * time.Minute})
+
+	twap, err := s.App.TwapKeeper.GetArithmeticTwapToNow(s.Ctx, poolId, denom0, denom1, t0)
+	s.Require().NoError(err)
+	s.Require().Equal(expTwap, twap)
+
+	// GetArithmeticTwap with end time now should give the same result.
+	twap, err = s.App.TwapKeeper.GetArithmeticTwap(s.Ctx, poolId, denom0, denom1, t0, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().Equal(expTwap, twap)
+
+	// query without an end time uses the current block time.
+	res, err := s.queryClient.ArithmeticTwap(sdk.WrapSDKContext(s.Ctx), &types.QueryArithmeticTwapRequest{
+		PoolId:     poolId,
+		BaseAsset:  denom0,
+		QuoteAsset: denom1,
+		StartTime:  t0,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(expTwap, res.ArithmeticTwap)
+}
+
+func (s *TestSuite) TestGetGeometricTwap() {
+	s.SetupTest()
+	poolId := s.preparePool()
+	t0 := s.Ctx.BlockTime()
+	sp0 := s.spotPrice(poolId, denom0, denom1)
+
+	// price stays at sp0 for 10s, at sp1 for 20s, then at sp2 for 30s.
+	s.advanceTime(10 * time.Second)
+	s.swapAndEndBlock(poolId, sdk.NewCoin(denom0, sdk.NewInt(200_000)), denom1)
+	sp1 := s.spotPrice(poolId, denom0, denom1)
+	s.advanceTime(20 * time.Second)
+	s.swapAndEndBlock(poolId, sdk.NewCoin(denom1, sdk.NewInt(700_000)), denom0)
+	sp2 := s.spotPrice(poolId, denom0, denom1)
+	s.advanceTime(30 * time.Second)
+
+	prices := []sdk.Dec{sp0, sp1, sp2}
+	durations := []time.Duration{10 * time.Second, 20 * time.Second, 30 * time.Second}
+	expGeomTwap := weightedGeometricAverage(prices, durations)
+	tolerance := sdk.NewDecWithPrec(1, 12)
+
+	geomTwap, err := s.App.TwapKeeper.GetGeometricTwap(s.Ctx, poolId, denom0, denom1, t0, t0.Add(time.Minute))
+	s.Require().NoError(err)
+	s.Require().True(expGeomTwap.Sub(geomTwap).Abs().LTE(tolerance), "expected %s, got %s", expGeomTwap, geomTwap)
+
+	// the geometric twap is below the arithmetic twap, as the price moved.
+	arithTwap, err := s.App.TwapKeeper.GetArithmeticTwap(s.Ctx, poolId, denom0, denom1, t0, t0.Add(time.Minute))
+	s.Require().NoError(err)
+	s.Require().Equal(weightedAverage(prices, durations), arithTwap)
+	s.Require().True(geomTwap.LT(arithTwap))
+
+	// the geometric twap of the inverted pair is the reciprocal.
+	invGeomTwap, err := s.App.TwapKeeper.GetGeometricTwapToNow(s.Ctx, poolId, denom1, denom0, t0)
+	s.Require().NoError(err)
+	s.Require().True(sdk.OneDec().Quo(geomTwap).Sub(invGeomTwap).Abs().LTE(tolerance),
+		"expected %s, got %s", sdk.OneDec().Quo(geomTwap), invGeomTwap)
+
+	// a range with a constant price gives that price.
+	geomTwap, err = s.App.TwapKeeper.GetGeometricTwap(s.Ctx, poolId, denom0, denom1, t0.Add(11*time.Second), t0.Add(29*time.Second))
+	s.Require().NoError(err)
+	s.Require().True(sp1.Sub(geomTwap).Abs().LTE(tolerance), "expected %s, got %s", sp1, geomTwap)
+
+	res, err := s.queryClient.GeometricTwap(sdk.WrapSDKContext(s.Ctx), &types.QueryGeometricTwapRequest{
+		PoolId:     poolId,
+		BaseAsset:  denom0,
+		QuoteAsset: denom1,
+		StartTime:  t0,
+	})
+	s.Require().NoError(err)
+	geomTwap, err = s.App.TwapKeeper.GetGeometricTwapToNow(s.Ctx, poolId, denom0, denom1, t0)
+	s.Require().NoError(err)
+	s.Require().Equal(geomTwap, res.GeometricTwap)
+}
+
+// TestTwapResistsIntraBlockManipulation checks that a spot price change only
+// affects the twap in proportion to the time the new price was in effect,
+// so moving the price within a block has no effect on twaps up to that block.
+func (s *TestSuite) TestTwapResistsIntraBlockManipulation() {
+	s.SetupTest()
+	poolId := s.preparePool()
+	t0 := s.Ctx.BlockTime()
+	sp0 := s.spotPrice(poolId, denom0, denom1)
+
+	s.advanceTime(time.Hour)
+	// Move the price by a lot, right at the end of the interval.
+	s.swapAndEndBlock(poolId, sdk.NewCoin(denom0, sdk.NewInt(5_000_000)), denom1)
+	s.Require().True(s.spotPrice(poolId, denom0, denom1).GT(sp0.MulInt64(10)))
+
+	twap, err := s.App.TwapKeeper.GetArithmeticTwapToNow(s.Ctx, poolId, denom0, denom1, t0)
+	s.Require().NoError(err)
+	s.Require().Equal(sp0, twap)
+}
+
+func (s *TestSuite) TestGetTwapErrors() {
+	s.SetupTest()
+	poolId := s.preparePool()
+	t0 := s.Ctx.BlockTime()
+	s.advanceTime(time.Minute)
+
+	tests := map[string]struct {
+		poolId    uint64
+		base      string
+		quote     string
+		startTime time.Time
+		endTime   time.Time
+		expErr    error
+	}{
+		"start time after end time": {
+			poolId: poolId, base: denom0, quote: denom1,
+			startTime: t0.Add(10 * time.Second), endTime: t0,
+			expErr: types.ErrInvalidTimeRange,
+		},
+		"end time in the future": {
+			poolId: poolId, base: denom0, quote: denom1,
+			startTime: t0, endTime: s.Ctx.BlockTime().Add(time.Second),
+			expErr: types.ErrInvalidTimeRange,
+		},
+		"start time before the pool existed": {
+			poolId: poolId, base: denom0, quote: denom1,
+			startTime: t0.Add(-time.Second), endTime: t0.Add(time.Second),
+			expErr: types.ErrRecordNotFound,
+		},
+		"same base and quote": {
+			poolId: poolId, base: denom0, quote: denom0,
+			startTime: t0, endTime: t0.Add(time.Second),
+			expErr: types.ErrInvalidAssetPair,
+		},
+		"denom not in pool": {
+			poolId: poolId, base: denom0, quote: "baz",
+			startTime: t0, endTime: t0.Add(time.Second),
+			expErr: types.ErrRecordNotFound,
+		},
+		"pool does not exist": {
+			poolId: poolId + 1, base: denom0, quote: denom1,
+			startTime: t0, endTime: t0.Add(time.Second),
+			expErr: types.ErrRecordNotFound,
+		},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			_, err := s.App.TwapKeeper.GetArithmeticTwap(s.Ctx, tc.poolId, tc.base, tc.quote, tc.startTime, tc.endTime)
+			s.Require().ErrorIs(err, tc.expErr)
+
+			_, err = s.App.TwapKeeper.GetGeometricTwap(s.Ctx, tc.poolId, tc.base, tc.quote, tc.startTime, tc.endTime)
+			s.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/twap/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	// Group queries under a subcommand
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdArithmeticTwap(),
+		GetCmdGeometricTwap(),
+		GetCmdParams(),
+	)
+
+	return cmd
+}
+
+// GetCmdArithmeticTwap returns the arithmetic twap of a pool's spot price over a time range.
+func GetCmdArithmeticTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "arithmetic [pool-id] [base-denom] [quote-denom] [start-time] [end-time]",
+		Short: "Query the arithmetic twap of a pool's spot price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the arithmetic time weighted average spot price of a pool, for the given asset pair.
+Times can be given as unix seconds or in RFC3339 format. If end-time is omitted, the current block time is used.
+
+Example:
+$ %s query twap arithmetic 1 uosmo uatom 1662076800
+$ %s query twap arithmetic 1 uosmo uatom 2022-09-02T00:00:00Z 2022-09-03T00:00:00Z
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(4, 5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := parseTime(args[3])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryArithmeticTwapRequest{
+				PoolId:     poolId,
+				BaseAsset:  args[1],
+				QuoteAsset: args[2],
+				StartTime:  startTime,
+			}
+			if len(args) == 5 {
+				endTime, err := parseTime(args[4])
+				if err != nil {
+					return err
+				}
+				req.EndTime = &endTime
+			}
+
+			res, err := queryClient.ArithmeticTwap(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdGeometricTwap returns the geometric twap of a pool's spot price over a time range.
+func GetCmdGeometricTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "geometric [pool-id] [base-denom] [quote-denom] [start-time] [end-time]",
+		Short: "Query the geometric twap of a pool's spot price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the geometric time weighted average spot price of a pool, for the given asset pair.
+Times can be given as unix seconds or in RFC3339 format. If end-time is omitted, the current block time is used.
+
+Example:
+$ %s query twap geometric 1 uosmo uatom 1662076800
+$ %s query twap geometric 1 uosmo uatom 2022-09-02T00:00:00Z 2022-09-03T00:00:00Z
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(4, 5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := parseTime(args[3])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryGeometricTwapRequest{
+				PoolId:     poolId,
+				BaseAsset:  args[1],
+				QuoteAsset: args[2],
+				StartTime:  startTime,
+			}
+			if len(args) == 5 {
+				endTime, err := parseTime(args[4])
+				if err != nil {
+					return err
+				}
+				req.EndTime = &endTime
+			}
+
+			res, err := queryClient.GeometricTwap(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdParams returns the parameters of the twap module.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the parameters of the twap module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseTime(timeStr string) (time.Time, error) {
+	if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil { // unix time
+		return time.Unix(timeUnix, 0).UTC(), nil
+	} else if timeRFC, err := time.Parse(time.RFC3339, timeStr); err == nil { // RFC time
+		return timeRFC, nil
+	}
+	return time.Time{}, errors.New("invalid time format")
+}
@@ -0,0 +1,34 @@
+package twap
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochtypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
+)
+
+var _ epochtypes.EpochHooks = &epochhook{}
+
+type epochhook struct {
+	k Keeper
+}
+
+func (k Keeper) EpochHooks() epochtypes.EpochHooks {
+	return &epochhook{k}
+}
+
+// AfterEpochEnd prunes the historical records that are older than the record history keep period,
+// at the end of every prune epoch. Pruning walks every (pool, asset pair) ever recorded,
+// so it is done once per epoch rather than in every end blocker.
+func (hook *epochhook) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	params := hook.k.GetParams(ctx)
+	if epochIdentifier != params.PruneEpochIdentifier {
+		return
+	}
+
+	lastKeptTime := ctx.BlockTime().Add(-params.RecordHistoryKeepPeriod)
+	if err := hook.k.pruneRecordsBeforeTime(ctx, lastKeptTime); err != nil {
+		ctx.Logger().Error("Error pruning old twap records", "Error", err)
+	}
+}
+
+func (hook *epochhook) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {}
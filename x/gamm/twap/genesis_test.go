@@ -0,0 +1,103 @@
+package twap_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/twap/types"
+)
+
+// historicalRecordTimes returns the times of all historical records of the pool's (denom0, denom1) pair.
+func (s *TestSuite) historicalRecordTimes(poolId uint64) []time.Time {
+	times := []time.Time{}
+	for _, record := range s.App.TwapKeeper.ExportGenesis(s.Ctx).Twaps {
+		if record.PoolId == poolId {
+			times = append(times, record.Time)
+		}
+	}
+	return times
+}
+
+func (s *TestSuite) TestPruneRecords() {
+	s.SetupTest()
+	s.App.TwapKeeper.SetParams(s.Ctx, types.NewParams(types.DefaultPruneEpochIdentifier, time.Hour))
+	poolId := s.preparePool()
+	t0 := s.Ctx.BlockTime()
+
+	s.advanceTime(10 * time.Minute)
+	s.swapAndEndBlock(poolId, sdk.NewCoin(denom0, sdk.NewInt(10_000)), denom1)
+	s.advanceTime(20 * time.Minute)
+	s.swapAndEndBlock(poolId, sdk.NewCoin(denom0, sdk.NewInt(10_000)), denom1)
+	s.Require().Equal([]time.Time{t0, t0.Add(10 * time.Minute), t0.Add(30 * time.Minute)}, s.historicalRecordTimes(poolId))
+
+	// the end blocker does not prune.
+	s.advanceTime(100 * time.Minute)
+	s.swapAndEndBlock(poolId, sdk.NewCoin(denom1, sdk.NewInt(10_000)), denom0)
+	s.Require().Equal([]time.Time{t0, t0.Add(10 * time.Minute), t0.Add(30 * time.Minute), t0.Add(130 * time.Minute)}, s.historicalRecordTimes(poolId))
+
+	// neither does the end of an epoch other than the prune epoch.
+	s.App.TwapKeeper.EpochHooks().AfterEpochEnd(s.Ctx, "week", 1)
+	s.Require().Len(s.historicalRecordTimes(poolId), 4)
+
+	// the cutoff is now t0 + 70m, the newest record before the cutoff is kept.
+	s.App.TwapKeeper.EpochHooks().AfterEpochEnd(s.Ctx, types.DefaultPruneEpochIdentifier, 1)
+	s.Require().Equal([]time.Time{t0.Add(30 * time.Minute), t0.Add(130 * time.Minute)}, s.historicalRecordTimes(poolId))
+
+	// twaps starting at or after the cutoff can still be computed, older ones can not.
+	_, err := s.App.TwapKeeper.GetArithmeticTwapToNow(s.Ctx, poolId, denom0, denom1, t0.Add(70*time.Minute))
+	s.Require().NoError(err)
+	_, err = s.App.TwapKeeper.GetArithmeticTwapToNow(s.Ctx, poolId, denom0, denom1, t0.Add(20*time.Minute))
+	s.Require().ErrorIs(err, types.ErrRecordNotFound)
+
+	// pruning never removes the most recent record.
+	s.advanceTime(5 * time.Hour)
+	s.App.TwapKeeper.EpochHooks().AfterEpochEnd(s.Ctx, types.DefaultPruneEpochIdentifier, 2)
+	s.Require().Equal([]time.Time{t0.Add(130 * time.Minute)}, s.historicalRecordTimes(poolId))
+	_, err = s.App.TwapKeeper.GetArithmeticTwapToNow(s.Ctx, poolId, denom0, denom1, s.Ctx.BlockTime().Add(-time.Hour))
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestGenesisRoundTrip() {
+	s.SetupTest()
+	params := types.NewParams(types.DefaultPruneEpochIdentifier, 3*time.Hour)
+	s.App.TwapKeeper.SetParams(s.Ctx, params)
+	poolId := s.preparePool()
+	t0 := s.Ctx.BlockTime()
+
+	s.advanceTime(10 * time.Second)
+	s.swapAndEndBlock(poolId, sdk.NewCoin(denom0, sdk.NewInt(100_000)), denom1)
+	s.advanceTime(20 * time.Second)
+	s.swapAndEndBlock(poolId, sdk.NewCoin(denom1, sdk.NewInt(50_000)), denom0)
+	s.advanceTime(30 * time.Second)
+
+	expTwap, err := s.App.TwapKeeper.GetArithmeticTwapToNow(s.Ctx, poolId, denom0, denom1, t0.Add(5*time.Second))
+	s.Require().NoError(err)
+	genState := s.App.TwapKeeper.ExportGenesis(s.Ctx)
+	s.Require().Equal(params, genState.Params)
+	s.Require().Len(genState.Twaps, 3)
+	s.Require().NoError(genState.Validate())
+
+	// import the records into a fresh state, in reverse order,
+	// to check the most recent record does not depend on the import order.
+	reversed := make([]types.TwapRecord, len(genState.Twaps))
+	for i, record := range genState.Twaps {
+		reversed[len(reversed)-1-i] = record
+	}
+	blockTime := s.Ctx.BlockTime()
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(blockTime)
+	s.App.TwapKeeper.InitGenesis(s.Ctx, types.NewGenesisState(params, reversed))
+
+	s.Require().Equal(genState, s.App.TwapKeeper.ExportGenesis(s.Ctx))
+	twap, err := s.App.TwapKeeper.GetArithmeticTwapToNow(s.Ctx, poolId, denom0, denom1, t0.Add(5*time.Second))
+	s.Require().NoError(err)
+	s.Require().Equal(expTwap, twap)
+}
+
+func (s *TestSuite) TestInitGenesisInvalid() {
+	s.SetupTest()
+	s.Require().Panics(func() {
+		s.App.TwapKeeper.InitGenesis(s.Ctx, types.NewGenesisState(types.NewParams(types.DefaultPruneEpochIdentifier, 0), nil))
+	})
+}
@@ -0,0 +1,76 @@
+package twap
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/twap/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier defines a wrapper around the x/twap keeper providing gRPC method
+// handlers.
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+func (q Querier) ArithmeticTwap(ctx context.Context, req *types.QueryArithmeticTwapRequest) (*types.QueryArithmeticTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var (
+		twap sdk.Dec
+		err  error
+	)
+	if req.EndTime == nil {
+		twap, err = q.Keeper.GetArithmeticTwapToNow(sdkCtx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+	} else {
+		twap, err = q.Keeper.GetArithmeticTwap(sdkCtx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryArithmeticTwapResponse{ArithmeticTwap: twap}, nil
+}
+
+func (q Querier) GeometricTwap(ctx context.Context, req *types.QueryGeometricTwapRequest) (*types.QueryGeometricTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var (
+		twap sdk.Dec
+		err  error
+	)
+	if req.EndTime == nil {
+		twap, err = q.Keeper.GetGeometricTwapToNow(sdkCtx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+	} else {
+		twap, err = q.Keeper.GetGeometricTwap(sdkCtx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGeometricTwapResponse{GeometricTwap: twap}, nil
+}
+
+func (q Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryParamsResponse{Params: q.Keeper.GetParams(sdkCtx)}, nil
+}
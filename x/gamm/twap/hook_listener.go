@@ -9,12 +9,20 @@ import (
 var _ types.GammHooks = &gammhook{}
 
 type gammhook struct {
-	k twapkeeper
+	k Keeper
+}
+
+func (k Keeper) GammHooks() types.GammHooks {
+	return &gammhook{k}
 }
 
 // AfterPoolCreated is called after CreatePool
 func (hook *gammhook) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
-	// TODO: Log pool creation to begin creating TWAPs for it
+	err := hook.k.createRecordsForPool(ctx, poolId)
+	// Will halt pool creation
+	if err != nil {
+		panic(err)
+	}
 }
 
 func (hook *gammhook) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
@@ -27,5 +35,7 @@ func (hook *gammhook) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, pool
 	hook.k.trackChangedPool(ctx, poolId)
 }
 
-func (hook *gammhook) AfterExitPool(_ sdk.Context, _ sdk.AccAddress, _ uint64, _ sdk.Int, _ sdk.Coins) {
+func (hook *gammhook) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+	// Log that this pool had a potential spot price change
+	hook.k.trackChangedPool(ctx, poolId)
 }
@@ -1,8 +1,58 @@
 package twap
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
-type twapkeeper struct {
-	// storeKey     sdk.StoreKey
-	transientKey sdk.TransientStoreKey
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/twap/types"
+)
+
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	transientKey *sdk.TransientStoreKey
+	cdc          codec.BinaryCodec
+	paramSpace   paramtypes.Subspace
+
+	ammkeeper types.AmmInterface
+}
+
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, transientKey *sdk.TransientStoreKey, paramSpace paramtypes.Subspace, ammKeeper types.AmmInterface) *Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return &Keeper{storeKey: storeKey, transientKey: transientKey, cdc: cdc, paramSpace: paramSpace, ammkeeper: ammKeeper}
+}
+
+// GetParams returns the total set of twap parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of twap parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// InitGenesis initializes the twap module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+	k.SetParams(ctx, genState.Params)
+	for _, record := range genState.Twaps {
+		k.storeGenesisRecord(ctx, record)
+	}
+}
+
+// ExportGenesis returns the twap module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	records, err := k.getAllHistoricalTimeIndexedTWAPs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return types.NewGenesisState(k.GetParams(ctx), records)
 }
@@ -0,0 +1,61 @@
+package twap_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/twap/types"
+)
+
+var (
+	denom0 = "bar"
+	denom1 = "foo"
+)
+
+type TestSuite struct {
+	apptesting.KeeperTestHelper
+
+	queryClient types.QueryClient
+}
+
+func TestSuiteRun(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) SetupTest() {
+	s.Setup()
+	s.queryClient = types.NewQueryClient(s.QueryHelper)
+}
+
+// preparePool creates a 1:1 uni-v2 style pool of denom0 and denom1.
+func (s *TestSuite) preparePool() uint64 {
+	return s.PrepareUni2PoolWithAssets(
+		sdk.NewCoin(denom0, sdk.NewInt(1_000_000)),
+		sdk.NewCoin(denom1, sdk.NewInt(1_000_000)),
+	)
+}
+
+// swapAndEndBlock swaps tokenIn into the pool, and then runs the twap end blocker.
+func (s *TestSuite) swapAndEndBlock(poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) {
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(tokenIn))
+	_, err := s.App.GAMMKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[1], poolId, tokenIn, tokenOutDenom, sdk.OneInt())
+	s.Require().NoError(err)
+	s.App.TwapKeeper.EndBlock(s.Ctx)
+}
+
+// advanceTime moves the block time forward, and increments the block height.
+func (s *TestSuite) advanceTime(d time.Duration) {
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(d)).WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	s.QueryHelper.Ctx = s.Ctx
+}
+
+func (s *TestSuite) spotPrice(poolId uint64, base, quote string) sdk.Dec {
+	sp, err := s.App.GAMMKeeper.CalculateSpotPrice(s.Ctx, poolId, base, quote)
+	s.Require().NoError(err)
+	return sp
+}
@@ -0,0 +1,227 @@
+package twap
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/osmomath"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/twap/types"
+)
+
+func newTwapRecord(k types.AmmInterface, ctx sdk.Context, poolId uint64, denom0, denom1 string) types.TwapRecord {
+	record := types.TwapRecord{
+		PoolId:                      poolId,
+		Asset0Denom:                 denom0,
+		Asset1Denom:                 denom1,
+		Height:                      ctx.BlockHeight(),
+		Time:                        ctx.BlockTime(),
+		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
+	}
+	record.P0LastSpotPrice, record.P1LastSpotPrice, record.LastErrorTime = getSpotPrices(ctx, k, poolId, denom0, denom1, record.LastErrorTime)
+	return record
+}
+
+// getSpotPrices gets the spot prices for the pool,
+// input: ctx, amm interface, pool id, asset denoms, previous error time
+// returns spot prices for both pairs of assets, and the 'new error time'.
+// The new error time is the current block time, if any spot price query errored,
+// and otherwise the previous error time.
+func getSpotPrices(ctx sdk.Context, k types.AmmInterface, poolId uint64, denom0, denom1 string, previousErrorTime time.Time) (
+	sp0 sdk.Dec, sp1 sdk.Dec, latestErrTime time.Time,
+) {
+	latestErrTime = previousErrorTime
+	sp0, err0 := k.CalculateSpotPrice(ctx, poolId, denom0, denom1)
+	sp1, err1 := k.CalculateSpotPrice(ctx, poolId, denom1, denom0)
+	if err0 != nil || err1 != nil {
+		latestErrTime = ctx.BlockTime()
+		// In the event of an error, we just sanity replace empty values with zero values
+		// so that the numbers can be still be calculated within TWAPs over error values
+		if sp0.IsNil() {
+			sp0 = sdk.ZeroDec()
+		}
+		if sp1.IsNil() {
+			sp1 = sdk.ZeroDec()
+		}
+	}
+	return sp0, sp1, latestErrTime
+}
+
+// createRecordsForPool creates new twap records of all the unique pairs of denoms within a pool.
+func (k Keeper) createRecordsForPool(ctx sdk.Context, poolId uint64) error {
+	pool, err := k.ammkeeper.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	liquidity := pool.GetTotalPoolLiquidity(ctx)
+	denoms := make([]string, 0, len(liquidity))
+	for _, coin := range liquidity {
+		denoms = append(denoms, coin.Denom)
+	}
+	denomPairs0, denomPairs1 := types.GetAllUniqueDenomPairs(denoms)
+	for i := 0; i < len(denomPairs0); i++ {
+		record := newTwapRecord(k.ammkeeper, ctx, poolId, denomPairs0[i], denomPairs1[i])
+		k.storeNewRecord(ctx, record)
+	}
+	return nil
+}
+
+// updateRecords updates the records of every pool whose spot price may have changed within the block.
+func (k Keeper) updateRecords(ctx sdk.Context) error {
+	changedPoolIds := k.getChangedPools(ctx)
+	for _, poolId := range changedPoolIds {
+		err := k.updateRecordsForPool(ctx, poolId)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) updateRecordsForPool(ctx sdk.Context, poolId uint64) error {
+	records, err := k.getAllMostRecentRecordsForPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	// Pools that existed before this module started tracking them have no records yet.
+	if len(records) == 0 {
+		return k.createRecordsForPool(ctx, poolId)
+	}
+	for _, record := range records {
+		newRecord := k.updateRecord(ctx, record)
+		k.storeNewRecord(ctx, newRecord)
+	}
+	return nil
+}
+
+// updateRecord moves the accumulators of the record forward to the current block time,
+// using the record's last spot prices, and then sets the new spot prices.
+func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) types.TwapRecord {
+	newRecord := recordWithUpdatedAccumulators(record, ctx.BlockTime())
+	newRecord.Height = ctx.BlockHeight()
+
+	newSp0, newSp1, lastErrorTime := getSpotPrices(ctx, k.ammkeeper, record.PoolId, record.Asset0Denom, record.Asset1Denom, record.LastErrorTime)
+
+	newRecord.P0LastSpotPrice = newSp0
+	newRecord.P1LastSpotPrice = newSp1
+	newRecord.LastErrorTime = lastErrorTime
+
+	return newRecord
+}
+
+// recordWithUpdatedAccumulators returns a record, with updated accumulator values and time for provided newTime,
+// otherwise referred to as "interpolating the record" to the target time.
+//
+// pre-condition: newTime >= record.Time
+func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) types.TwapRecord {
+	newRecord := record
+	timeDelta := newTime.Sub(record.Time)
+	newRecord.Time = newTime
+
+	// record.LastSpotPrice is the last spot price from the block the record was created in,
+	// thus it is treated as the effective spot price until the new time.
+	// (As there was no change until at or after this time)
+	p0NewAccum := types.SpotPriceMulDuration(record.P0LastSpotPrice, timeDelta)
+	newRecord.P0ArithmeticTwapAccumulator = p0NewAccum.AddMut(newRecord.P0ArithmeticTwapAccumulator)
+
+	p1NewAccum := types.SpotPriceMulDuration(record.P1LastSpotPrice, timeDelta)
+	newRecord.P1ArithmeticTwapAccumulator = p1NewAccum.AddMut(newRecord.P1ArithmeticTwapAccumulator)
+
+	// The log of a zero spot price is undefined. A zero spot price only occurs
+	// when the spot price errored, and any twap over this period errors anyway,
+	// so the geometric accumulator is left unchanged.
+	if record.P0LastSpotPrice.IsPositive() {
+		logP0SpotPrice := osmomath.BigDecFromSDKDec(record.P0LastSpotPrice).LogBase2().SDKDec()
+		geomNewAccum := types.SpotPriceMulDuration(logP0SpotPrice, timeDelta)
+		newRecord.GeometricTwapAccumulator = geomNewAccum.AddMut(newRecord.GeometricTwapAccumulator)
+	}
+
+	return newRecord
+}
+
+// getInterpolatedRecord returns a record for this pool, interpolated to the given time.
+// It errors if there is no record at or before the given time.
+func (k Keeper) getInterpolatedRecord(ctx sdk.Context, poolId uint64, t time.Time, assetA, assetB string) (types.TwapRecord, error) {
+	assetA, assetB, err := types.LexicographicalOrderDenoms(assetA, assetB)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+	record, err := k.getRecordAtOrBeforeTime(ctx, poolId, t, assetA, assetB)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+	record = recordWithUpdatedAccumulators(record, t)
+	return record, nil
+}
+
+// getMostRecentRecord returns the most recent record for the pool and asset pair,
+// interpolated to the current block time.
+func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, assetA, assetB string) (types.TwapRecord, error) {
+	assetA, assetB, err := types.LexicographicalOrderDenoms(assetA, assetB)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+	record, err := k.getMostRecentRecordStoreRepresentation(ctx, poolId, assetA, assetB)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+	newRecord := recordWithUpdatedAccumulators(record, ctx.BlockTime())
+	return newRecord, nil
+}
+
+// computeArithmeticTwap computes and returns an arithmetic TWAP between
+// two records given the quote asset.
+func computeArithmeticTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
+	// if start time = end time, just return the spot price.
+	timeDelta := endRecord.Time.Sub(startRecord.Time)
+	if timeDelta == time.Duration(0) {
+		if quoteAsset == startRecord.Asset1Denom {
+			return endRecord.P0LastSpotPrice
+		}
+		return endRecord.P1LastSpotPrice
+	}
+
+	var accumDiff sdk.Dec
+	if quoteAsset == startRecord.Asset1Denom {
+		accumDiff = endRecord.P0ArithmeticTwapAccumulator.Sub(startRecord.P0ArithmeticTwapAccumulator)
+	} else {
+		accumDiff = endRecord.P1ArithmeticTwapAccumulator.Sub(startRecord.P1ArithmeticTwapAccumulator)
+	}
+	return types.AccumDiffDivDuration(accumDiff, timeDelta)
+}
+
+// computeGeometricTwap computes and returns a geometric TWAP between
+// two records given the quote asset.
+// The geometric accumulator tracks log2 of the p0 spot price, so the twap
+// of the p1 spot price is the reciprocal of the p0 twap.
+func computeGeometricTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
+	// if start time = end time, just return the spot price.
+	timeDelta := endRecord.Time.Sub(startRecord.Time)
+	if timeDelta == time.Duration(0) {
+		if quoteAsset == startRecord.Asset1Denom {
+			return endRecord.P0LastSpotPrice
+		}
+		return endRecord.P1LastSpotPrice
+	}
+
+	accumDiff := endRecord.GeometricTwapAccumulator.Sub(startRecord.GeometricTwapAccumulator)
+	exponent := types.AccumDiffDivDuration(accumDiff, timeDelta)
+	twap := osmomath.Exp2(osmomath.BigDecFromSDKDec(exponent))
+	if quoteAsset == startRecord.Asset1Denom {
+		return twap.SDKDec()
+	}
+	return osmomath.OneDec().Quo(twap).SDKDec()
+}
+
+// MigrateExistingPools creates twap records for all pools with ids below nextPoolId.
+// It is meant to be called once, when the module is added to a chain with existing pools.
+func (k Keeper) MigrateExistingPools(ctx sdk.Context, nextPoolId uint64) error {
+	for poolId := uint64(1); poolId < nextPoolId; poolId++ {
+		if err := k.createRecordsForPool(ctx, poolId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
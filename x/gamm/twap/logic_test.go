@@ -0,0 +1,119 @@
+package twap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/twap/types"
+)
+
+const (
+	testDenom0 = "bar"
+	testDenom1 = "foo"
+)
+
+var baseTime = time.Unix(1257894000, 0).UTC()
+
+// pricePathRecords returns the records at the start and end of a synthetic price path,
+// where the p0 spot price is prices[i] for durations[i].
+func pricePathRecords(prices []sdk.Dec, durations []time.Duration) (types.TwapRecord, types.TwapRecord) {
+	start := types.TwapRecord{
+		PoolId:                      1,
+		Asset0Denom:                 testDenom0,
+		Asset1Denom:                 testDenom1,
+		Time:                        baseTime,
+		P0LastSpotPrice:             prices[0],
+		P1LastSpotPrice:             sdk.OneDec().Quo(prices[0]),
+		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		GeometricTwapAccumulator:    sdk.ZeroDec(),
+		LastErrorTime:               time.Time{},
+	}
+	record := start
+	for i := range prices {
+		record.P0LastSpotPrice = prices[i]
+		record.P1LastSpotPrice = sdk.OneDec().Quo(prices[i])
+		record = recordWithUpdatedAccumulators(record, record.Time.Add(durations[i]))
+	}
+	return start, record
+}
+
+func TestComputeGeometricTwap(t *testing.T) {
+	tolerance := sdk.NewDecWithPrec(1, 12)
+	tests := map[string]struct {
+		prices       []sdk.Dec
+		durations    []time.Duration
+		expArithTwap sdk.Dec
+		expGeomTwap  sdk.Dec
+	}{
+		"constant price": {
+			prices:       []sdk.Dec{sdk.NewDecWithPrec(15, 1)},
+			durations:    []time.Duration{time.Hour},
+			expArithTwap: sdk.NewDecWithPrec(15, 1),
+			expGeomTwap:  sdk.NewDecWithPrec(15, 1),
+		},
+		"1 then 4, equal durations": {
+			prices:       []sdk.Dec{sdk.OneDec(), sdk.NewDec(4)},
+			durations:    []time.Duration{time.Minute, time.Minute},
+			expArithTwap: sdk.NewDecWithPrec(25, 1),
+			expGeomTwap:  sdk.NewDec(2),
+		},
+		"1 then 16, weighted 3:1": {
+			prices:       []sdk.Dec{sdk.OneDec(), sdk.NewDec(16)},
+			durations:    []time.Duration{3 * time.Second, time.Second},
+			expArithTwap: sdk.MustNewDecFromStr("4.75"),
+			expGeomTwap:  sdk.NewDec(2),
+		},
+		"short spike": {
+			prices:       []sdk.Dec{sdk.OneDec(), sdk.NewDec(1024), sdk.OneDec()},
+			durations:    []time.Duration{45 * time.Second, 10 * time.Second, 45 * time.Second},
+			expArithTwap: sdk.MustNewDecFromStr("103.3"),
+			expGeomTwap:  sdk.NewDec(2),
+		},
+		"prices below one": {
+			prices:       []sdk.Dec{sdk.NewDecWithPrec(25, 2), sdk.NewDecWithPrec(1, 2)},
+			durations:    []time.Duration{time.Second, time.Second},
+			expArithTwap: sdk.NewDecWithPrec(13, 2),
+			expGeomTwap:  sdk.NewDecWithPrec(5, 2),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			start, end := pricePathRecords(tc.prices, tc.durations)
+
+			arithTwap := computeArithmeticTwap(start, end, testDenom1)
+			geomTwap := computeGeometricTwap(start, end, testDenom1)
+			require.Equal(t, tc.expArithTwap, arithTwap)
+			require.True(t, tc.expGeomTwap.Sub(geomTwap).Abs().LTE(tolerance),
+				"expected geometric twap %s, got %s", tc.expGeomTwap, geomTwap)
+
+			// AM-GM: the geometric mean is never larger than the arithmetic mean.
+			require.True(t, geomTwap.LTE(arithTwap.Add(tolerance)))
+
+			// the twap with the assets swapped is the reciprocal.
+			invGeomTwap := computeGeometricTwap(start, end, testDenom0)
+			require.True(t, sdk.OneDec().Quo(tc.expGeomTwap).Sub(invGeomTwap).Abs().LTE(tolerance),
+				"expected inverse geometric twap %s, got %s", sdk.OneDec().Quo(tc.expGeomTwap), invGeomTwap)
+		})
+	}
+}
+
+func TestComputeGeometricTwapSameTime(t *testing.T) {
+	start, _ := pricePathRecords([]sdk.Dec{sdk.NewDec(3)}, []time.Duration{time.Second})
+	require.Equal(t, start.P0LastSpotPrice, computeGeometricTwap(start, start, testDenom1))
+	require.Equal(t, start.P1LastSpotPrice, computeGeometricTwap(start, start, testDenom0))
+}
+
+// TestGeometricAccumulatorSkipsZeroPrice checks that a zero spot price, which is only
+// stored when the spot price errored, does not panic and leaves the accumulator unchanged.
+func TestGeometricAccumulatorSkipsZeroPrice(t *testing.T) {
+	start, _ := pricePathRecords([]sdk.Dec{sdk.OneDec()}, []time.Duration{time.Second})
+	start.P0LastSpotPrice = sdk.ZeroDec()
+	start.GeometricTwapAccumulator = sdk.NewDec(5)
+	record := recordWithUpdatedAccumulators(start, start.Time.Add(time.Minute))
+	require.Equal(t, sdk.NewDec(5), record.GeometricTwapAccumulator)
+}
@@ -16,9 +16,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
-	"github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
-	twaptypes "github.com/osmosis-labs/osmosis/v7/x/gamm/twap/types"
-	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/twap/client/cli"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/twap/types"
 )
 
 var (
@@ -30,24 +29,23 @@ type AppModuleBasic struct {
 	cdc codec.Codec
 }
 
-func (AppModuleBasic) Name() string { return twaptypes.ModuleName }
+func (AppModuleBasic) Name() string { return types.ModuleName }
 
 func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 }
 
+// DefaultGenesis returns default genesis state as raw bytes for the twap module.
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	return json.RawMessage{}
-	// return cdc.MustMarshalJSON(types.DefaultGenesis())
+	return cdc.MustMarshalJSON(types.DefaultGenesis())
 }
 
-// ValidateGenesis performs genesis state validation for the gamm module.
+// ValidateGenesis performs genesis state validation for the twap module.
 func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
-	// var genState types.GenesisState
-	// if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
-	// 	return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
-	// }
-	// return genState.Validate()
-	return nil
+	var genState types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+	return genState.Validate()
 }
 
 //---------------------------------------
@@ -60,37 +58,29 @@ func (b AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux
 
 func (b AppModuleBasic) GetTxCmd() *cobra.Command {
 	return nil
-	// return cli.NewTxCmd()
 }
 func (b AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return nil
-	// return cli.GetQueryCmd()
+	return cli.GetQueryCmd()
 }
 
-// RegisterInterfaces registers interfaces and implementations of the gamm module.
+// RegisterInterfaces registers interfaces and implementations of the twap module.
 func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 }
 
 type AppModule struct {
 	AppModuleBasic
 
-	ak types.AccountKeeper
-	bk types.BankKeeper
-	gk keeper.Keeper
-	tk twapkeeper
+	k Keeper
 }
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterQueryServer(cfg.QueryServer(), NewQuerier(am.k))
 }
 
-func NewAppModule(cdc codec.Codec, keeper keeper.Keeper,
-	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
-) AppModule {
+func NewAppModule(cdc codec.Codec, twapKeeper Keeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{cdc: cdc},
-		gk:             keeper,
-		ak:             accountKeeper,
-		bk:             bankKeeper,
+		k:              twapKeeper,
 	}
 }
 
@@ -101,35 +91,39 @@ func (am AppModule) Route() sdk.Route {
 	return sdk.Route{}
 }
 
-// QuerierRoute returns the gamm module's querier route name.
+// QuerierRoute returns the twap module's querier route name.
 func (AppModule) QuerierRoute() string { return types.RouterKey }
 
-// LegacyQuerierHandler returns the x/gamm module's sdk.Querier.
+// LegacyQuerierHandler returns the x/twap module's sdk.Querier.
 func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
 	return func(sdk.Context, []string, abci.RequestQuery) ([]byte, error) {
 		return nil, fmt.Errorf("legacy querier not supported for the x/%s module", types.ModuleName)
 	}
 }
 
-// InitGenesis performs genesis initialization for the gamm module. It returns
+// InitGenesis performs genesis initialization for the twap module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
+	var genState types.GenesisState
+	cdc.MustUnmarshalJSON(gs, &genState)
+	am.k.InitGenesis(ctx, &genState)
 	return []abci.ValidatorUpdate{}
 }
 
-// ExportGenesis returns the exported genesis state as raw bytes for the gamm
+// ExportGenesis returns the exported genesis state as raw bytes for the twap
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	return json.RawMessage{}
+	genState := am.k.ExportGenesis(ctx)
+	return cdc.MustMarshalJSON(genState)
 }
 
 // BeginBlock performs a no-op.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock returns the end blocker for the gamm module. It returns no validator
+// EndBlock returns the end blocker for the twap module. It returns no validator
 // updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
-	am.tk.endBlockLogic(ctx)
+	am.k.EndBlock(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
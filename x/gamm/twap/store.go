@@ -2,12 +2,16 @@ package twap
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/twap/types"
 )
 
-func (k twapkeeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
-	store := ctx.TransientStore(&k.transientKey)
+func (k Keeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
+	store := ctx.TransientStore(k.transientKey)
 	poolIdBz := make([]byte, 8)
 	binary.LittleEndian.PutUint64(poolIdBz, poolId)
 	// just has to not be empty, for store to work / not register as a delete.
@@ -15,16 +19,155 @@ func (k twapkeeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
 	store.Set(poolIdBz, sentinelExistsValue)
 }
 
-func (k twapkeeper) getChangedPools(ctx sdk.Context) []uint64 {
-	store := ctx.TransientStore(&k.transientKey)
+func (k Keeper) getChangedPools(ctx sdk.Context) []uint64 {
+	store := ctx.TransientStore(k.transientKey)
 	iter := store.Iterator(nil, nil)
 	defer iter.Close()
 
 	alteredPoolIds := []uint64{}
-	for ; iter.Key() != nil; iter.Next() {
+	for ; iter.Valid(); iter.Next() {
 		k := iter.Key()
 		poolId := binary.LittleEndian.Uint64(k)
 		alteredPoolIds = append(alteredPoolIds, poolId)
 	}
 	return alteredPoolIds
 }
+
+// storeNewRecord stores a record, in both the most recent record store and historical stores.
+func (k Keeper) storeNewRecord(ctx sdk.Context, twap types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FormatMostRecentTWAPKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom)
+	bz := k.cdc.MustMarshal(&twap)
+	store.Set(key, bz)
+	k.storeHistoricalTWAP(ctx, twap)
+}
+
+// storeHistoricalTWAP writes a twap to the store, in all needed indexing.
+func (k Keeper) storeHistoricalTWAP(ctx sdk.Context, twap types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key1 := types.FormatHistoricalTimeIndexTWAPKey(twap.Time, twap.PoolId, twap.Asset0Denom, twap.Asset1Denom)
+	key2 := types.FormatHistoricalPoolIndexTWAPKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom, twap.Time)
+	bz := k.cdc.MustMarshal(&twap)
+	store.Set(key1, bz)
+	store.Set(key2, bz)
+}
+
+// storeGenesisRecord stores a record imported from genesis in the historical stores,
+// and as the most recent record of its (pool, asset pair), unless a newer record is already stored.
+// This makes the import independent of the order of the genesis records.
+func (k Keeper) storeGenesisRecord(ctx sdk.Context, twap types.TwapRecord) {
+	mostRecent, err := k.getMostRecentRecordStoreRepresentation(ctx, twap.PoolId, twap.Asset0Denom, twap.Asset1Denom)
+	if err == nil && mostRecent.Time.After(twap.Time) {
+		k.storeHistoricalTWAP(ctx, twap)
+		return
+	}
+	k.storeNewRecord(ctx, twap)
+}
+
+// deleteHistoricalRecord deletes a record from all historical indexes.
+// The most recent record store is left unchanged.
+func (k Keeper) deleteHistoricalRecord(ctx sdk.Context, twap types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FormatHistoricalTimeIndexTWAPKey(twap.Time, twap.PoolId, twap.Asset0Denom, twap.Asset1Denom))
+	store.Delete(types.FormatHistoricalPoolIndexTWAPKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom, twap.Time))
+}
+
+// pruneRecordsBeforeTime deletes all historical records older than lastKeptTime,
+// except for the newest record of every (pool, asset pair) before lastKeptTime.
+// That record is kept, so that the accumulators can still be interpolated to lastKeptTime.
+func (k Keeper) pruneRecordsBeforeTime(ctx sdk.Context, lastKeptTime time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+	// the end key is exclusive, so only records strictly before lastKeptTime are iterated.
+	endKey := append(append([]byte{}, types.HistoricalTWAPsTimeIndexPrefix...), sdk.FormatTimeBytes(lastKeptTime)...)
+	iter := store.ReverseIterator(types.HistoricalTWAPsTimeIndexPrefix, endKey)
+	defer iter.Close()
+
+	// iterate from newest to oldest, so the first record seen for each pair is the one to keep.
+	seenPairs := make(map[string]struct{})
+	toDelete := []types.TwapRecord{}
+	for ; iter.Valid(); iter.Next() {
+		var twap types.TwapRecord
+		if err := k.cdc.Unmarshal(iter.Value(), &twap); err != nil {
+			return err
+		}
+		pairKey := string(types.FormatMostRecentTWAPKey(twap.PoolId, twap.Asset0Denom, twap.Asset1Denom))
+		if _, seen := seenPairs[pairKey]; !seen {
+			seenPairs[pairKey] = struct{}{}
+			continue
+		}
+		toDelete = append(toDelete, twap)
+	}
+
+	// deleting while iterating is not safe, so records are deleted after iterating.
+	for _, twap := range toDelete {
+		k.deleteHistoricalRecord(ctx, twap)
+	}
+	return nil
+}
+
+// getMostRecentRecordStoreRepresentation returns the most recent twap record in the store
+// for the provided (pool, asset0, asset1) triplet.
+// This is not the same as the most recent twap record for the pool, as the latter
+// is interpolated to the current block time.
+func (k Keeper) getMostRecentRecordStoreRepresentation(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FormatMostRecentTWAPKey(poolId, asset0Denom, asset1Denom)
+	bz := store.Get(key)
+	if bz == nil {
+		return types.TwapRecord{}, sdkerrors.Wrapf(types.ErrRecordNotFound,
+			"pool %d, asset pair (%s, %s)", poolId, asset0Denom, asset1Denom)
+	}
+	var twap types.TwapRecord
+	if err := k.cdc.Unmarshal(bz, &twap); err != nil {
+		return types.TwapRecord{}, err
+	}
+	return twap, nil
+}
+
+// getAllMostRecentRecordsForPool returns the most recent record of every asset pair in the given pool.
+func (k Keeper) getAllMostRecentRecordsForPool(ctx sdk.Context, poolId uint64) ([]types.TwapRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := append(append([]byte{}, types.MostRecentTWAPsPrefix...), sdk.Uint64ToBigEndian(poolId)...)
+	return k.getAllRecords(store, prefix)
+}
+
+// getRecordAtOrBeforeTime returns the last record stored at or before time t,
+// for the given pool and asset pair.
+func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, t time.Time, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	startKey := types.FormatHistoricalPoolIndexTWAPKeyPrefix(poolId, asset0Denom, asset1Denom)
+	// The end key is exclusive, so we iterate until the first possible key after time t.
+	endKey := sdk.PrefixEndBytes(types.FormatHistoricalPoolIndexTWAPKey(poolId, asset0Denom, asset1Denom, t))
+	iter := store.ReverseIterator(startKey, endKey)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.TwapRecord{}, sdkerrors.Wrapf(types.ErrRecordNotFound,
+			"pool %d, asset pair (%s, %s), at or before time %s", poolId, asset0Denom, asset1Denom, t)
+	}
+	var twap types.TwapRecord
+	if err := k.cdc.Unmarshal(iter.Value(), &twap); err != nil {
+		return types.TwapRecord{}, err
+	}
+	return twap, nil
+}
+
+// getAllHistoricalTimeIndexedTWAPs returns all historical records, ordered by time.
+func (k Keeper) getAllHistoricalTimeIndexedTWAPs(ctx sdk.Context) ([]types.TwapRecord, error) {
+	return k.getAllRecords(ctx.KVStore(k.storeKey), types.HistoricalTWAPsTimeIndexPrefix)
+}
+
+func (k Keeper) getAllRecords(store sdk.KVStore, prefix []byte) ([]types.TwapRecord, error) {
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	records := []types.TwapRecord{}
+	for ; iter.Valid(); iter.Next() {
+		var twap types.TwapRecord
+		if err := k.cdc.Unmarshal(iter.Value(), &twap); err != nil {
+			return nil, err
+		}
+		records = append(records, twap)
+	}
+	return records, nil
+}
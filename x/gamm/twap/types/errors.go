@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/twap module sentinel errors.
+var (
+	ErrRecordNotFound    = sdkerrors.Register(ModuleName, 2, "twap record not found")
+	ErrInvalidTimeRange  = sdkerrors.Register(ModuleName, 3, "invalid twap time range")
+	ErrInvalidAssetPair  = sdkerrors.Register(ModuleName, 4, "invalid twap asset pair")
+	ErrSpotPriceAffected = sdkerrors.Register(ModuleName, 5, "twap may be affected by a spot price error")
+)
+
+// StartTimeAfterEndTimeError returns an error for a time range that ends before it starts.
+func StartTimeAfterEndTimeError(startTime, endTime time.Time) error {
+	return sdkerrors.Wrap(ErrInvalidTimeRange, fmt.Sprintf("start time %s is after end time %s", startTime, endTime))
+}
+
+// EndTimeInFutureError returns an error for a time range that ends after the current block time.
+func EndTimeInFutureError(endTime, blockTime time.Time) error {
+	return sdkerrors.Wrap(ErrInvalidTimeRange, fmt.Sprintf("end time %s is after the current block time %s", endTime, blockTime))
+}
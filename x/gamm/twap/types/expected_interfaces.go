@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// AmmInterface is the functionality needed from a given pool ID, in order to maintain records and serve TWAPs.
+type AmmInterface interface {
+	GetPoolAndPoke(ctx sdk.Context, poolId uint64) (gammtypes.PoolI, error)
+	// CalculateSpotPrice returns the spot price of the base asset in terms of the quote asset.
+	CalculateSpotPrice(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string) (price sdk.Dec, err error)
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState returns a new genesis state for the twap module.
+func NewGenesisState(params Params, twapRecords []TwapRecord) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		Twaps:  twapRecords,
+	}
+}
+
+// DefaultGenesis returns the default twap genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), []TwapRecord{})
+}
+
+// Validate runs stateless validation checks on the genesis state.
+func (g *GenesisState) Validate() error {
+	if err := g.Params.Validate(); err != nil {
+		return err
+	}
+	for _, record := range g.Twaps {
+		if err := record.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate runs stateless validation checks on a twap record.
+func (t TwapRecord) validate() error {
+	if t.PoolId == 0 {
+		return errors.New("twap record pool id cannot be zero")
+	}
+	if err := sdk.ValidateDenom(t.Asset0Denom); err != nil {
+		return fmt.Errorf("invalid twap record asset0 denom: %w", err)
+	}
+	if err := sdk.ValidateDenom(t.Asset1Denom); err != nil {
+		return fmt.Errorf("invalid twap record asset1 denom: %w", err)
+	}
+	if t.Asset0Denom >= t.Asset1Denom {
+		return fmt.Errorf("twap record denoms must be lexicographically ordered and distinct, got (%s, %s)", t.Asset0Denom, t.Asset1Denom)
+	}
+	if t.Height < 0 {
+		return fmt.Errorf("twap record height cannot be negative, was (%d)", t.Height)
+	}
+	if t.Time.IsZero() {
+		return errors.New("twap record time cannot be zero")
+	}
+	if t.LastErrorTime.After(t.Time) {
+		return fmt.Errorf("twap record last error time (%s) cannot be after the record time (%s)", t.LastErrorTime, t.Time)
+	}
+	nonNegativeDecs := []struct {
+		name string
+		dec  sdk.Dec
+	}{
+		{"p0 last spot price", t.P0LastSpotPrice},
+		{"p1 last spot price", t.P1LastSpotPrice},
+		{"p0 arithmetic twap accumulator", t.P0ArithmeticTwapAccumulator},
+		{"p1 arithmetic twap accumulator", t.P1ArithmeticTwapAccumulator},
+	}
+	for _, d := range nonNegativeDecs {
+		if d.dec.IsNil() || d.dec.IsNegative() {
+			return fmt.Errorf("twap record %s must be non-negative, was (%s)", d.name, d.dec)
+		}
+	}
+	// the geometric accumulator is a sum of logarithms, so it may be negative.
+	if t.GeometricTwapAccumulator.IsNil() {
+		return errors.New("twap record geometric twap accumulator cannot be nil")
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var baseTime = time.Unix(1257894000, 0).UTC()
+
+func newValidRecord() TwapRecord {
+	return TwapRecord{
+		PoolId:                      1,
+		Asset0Denom:                 "bar",
+		Asset1Denom:                 "foo",
+		Height:                      5,
+		Time:                        baseTime,
+		P0LastSpotPrice:             sdk.NewDec(2),
+		P1LastSpotPrice:             sdk.NewDecWithPrec(5, 1),
+		P0ArithmeticTwapAccumulator: sdk.NewDec(100),
+		P1ArithmeticTwapAccumulator: sdk.NewDec(25),
+		GeometricTwapAccumulator:    sdk.NewDec(-10),
+		LastErrorTime:               baseTime.Add(-time.Hour),
+	}
+}
+
+func TestGenesisValidate(t *testing.T) {
+	withRecord := func(f func(*TwapRecord)) []TwapRecord {
+		record := newValidRecord()
+		f(&record)
+		return []TwapRecord{record}
+	}
+	tests := map[string]struct {
+		genState *GenesisState
+		expErr   bool
+	}{
+		"default genesis": {
+			genState: DefaultGenesis(),
+		},
+		"valid records": {
+			genState: NewGenesisState(DefaultParams(), []TwapRecord{newValidRecord(), newValidRecord()}),
+		},
+		"zero keep period": {
+			genState: NewGenesisState(NewParams(DefaultPruneEpochIdentifier, 0), nil),
+			expErr:   true,
+		},
+		"negative keep period": {
+			genState: NewGenesisState(NewParams(DefaultPruneEpochIdentifier, -time.Hour), nil),
+			expErr:   true,
+		},
+		"empty prune epoch identifier": {
+			genState: NewGenesisState(NewParams("", time.Hour), nil),
+			expErr:   true,
+		},
+		"zero pool id": {
+			genState: NewGenesisState(DefaultParams(), withRecord(func(r *TwapRecord) { r.PoolId = 0 })),
+			expErr:   true,
+		},
+		"invalid denom": {
+			genState: NewGenesisState(DefaultParams(), withRecord(func(r *TwapRecord) { r.Asset0Denom = "1" })),
+			expErr:   true,
+		},
+		"denoms not ordered": {
+			genState: NewGenesisState(DefaultParams(), withRecord(func(r *TwapRecord) { r.Asset0Denom, r.Asset1Denom = "foo", "bar" })),
+			expErr:   true,
+		},
+		"same denoms": {
+			genState: NewGenesisState(DefaultParams(), withRecord(func(r *TwapRecord) { r.Asset1Denom = "bar" })),
+			expErr:   true,
+		},
+		"negative height": {
+			genState: NewGenesisState(DefaultParams(), withRecord(func(r *TwapRecord) { r.Height = -1 })),
+			expErr:   true,
+		},
+		"zero time": {
+			genState: NewGenesisState(DefaultParams(), withRecord(func(r *TwapRecord) { r.Time = time.Time{} })),
+			expErr:   true,
+		},
+		"last error time after record time": {
+			genState: NewGenesisState(DefaultParams(), withRecord(func(r *TwapRecord) { r.LastErrorTime = baseTime.Add(time.Second) })),
+			expErr:   true,
+		},
+		"last error time equal to record time": {
+			genState: NewGenesisState(DefaultParams(), withRecord(func(r *TwapRecord) { r.LastErrorTime = baseTime })),
+		},
+		"negative spot price": {
+			genState: NewGenesisState(DefaultParams(), withRecord(func(r *TwapRecord) { r.P1LastSpotPrice = sdk.NewDec(-1) })),
+			expErr:   true,
+		},
+		"negative arithmetic accumulator": {
+			genState: NewGenesisState(DefaultParams(), withRecord(func(r *TwapRecord) { r.P0ArithmeticTwapAccumulator = sdk.NewDec(-1) })),
+			expErr:   true,
+		},
+		"nil arithmetic accumulator": {
+			genState: NewGenesisState(DefaultParams(), withRecord(func(r *TwapRecord) { r.P1ArithmeticTwapAccumulator = sdk.Dec{} })),
+			expErr:   true,
+		},
+		"nil geometric accumulator": {
+			genState: NewGenesisState(DefaultParams(), withRecord(func(r *TwapRecord) { r.GeometricTwapAccumulator = sdk.Dec{} })),
+			expErr:   true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	ModuleName = "twap"
 
@@ -12,4 +18,52 @@ const (
 
 var (
 	AlteredPoolIdsPrefix = []byte{0}
+
+	// MostRecentTWAPsPrefix defines the prefix under which the latest record
+	// of every (pool, asset pair) is stored.
+	MostRecentTWAPsPrefix = []byte{0x01}
+	// HistoricalTWAPsTimeIndexPrefix defines the prefix under which all
+	// historical records are stored, ordered by record time.
+	HistoricalTWAPsTimeIndexPrefix = []byte{0x02}
+	// HistoricalTWAPsPoolIndexPrefix defines the prefix under which all
+	// historical records are stored, ordered by (pool, asset pair, record time).
+	HistoricalTWAPsPoolIndexPrefix = []byte{0x03}
+
+	// KeySeparator separates the denoms and the time in record keys.
+	// It can not appear in a valid sdk denom.
+	KeySeparator = []byte("|")
 )
+
+// poolPairKey returns poolId | denom0 | denom1 |
+func poolPairKey(poolId uint64, denom0, denom1 string) []byte {
+	key := sdk.Uint64ToBigEndian(poolId)
+	key = append(key, []byte(denom0)...)
+	key = append(key, KeySeparator...)
+	key = append(key, []byte(denom1)...)
+	return append(key, KeySeparator...)
+}
+
+// FormatMostRecentTWAPKey returns the key of the most recent record for the given pool and asset pair.
+func FormatMostRecentTWAPKey(poolId uint64, denom0, denom1 string) []byte {
+	return append(append([]byte{}, MostRecentTWAPsPrefix...), poolPairKey(poolId, denom0, denom1)...)
+}
+
+// FormatHistoricalTimeIndexTWAPKey returns the time-ordered key of a historical record.
+func FormatHistoricalTimeIndexTWAPKey(accumulatorWriteTime time.Time, poolId uint64, denom0, denom1 string) []byte {
+	key := append([]byte{}, HistoricalTWAPsTimeIndexPrefix...)
+	key = append(key, sdk.FormatTimeBytes(accumulatorWriteTime)...)
+	key = append(key, KeySeparator...)
+	return append(key, poolPairKey(poolId, denom0, denom1)...)
+}
+
+// FormatHistoricalPoolIndexTWAPKey returns the pool-ordered key of a historical record.
+func FormatHistoricalPoolIndexTWAPKey(poolId uint64, denom0, denom1 string, accumulatorWriteTime time.Time) []byte {
+	key := FormatHistoricalPoolIndexTWAPKeyPrefix(poolId, denom0, denom1)
+	return append(key, sdk.FormatTimeBytes(accumulatorWriteTime)...)
+}
+
+// FormatHistoricalPoolIndexTWAPKeyPrefix returns the prefix of all historical records
+// of the given pool and asset pair in the pool index.
+func FormatHistoricalPoolIndexTWAPKeyPrefix(poolId uint64, denom0, denom1 string) []byte {
+	return append(append([]byte{}, HistoricalTWAPsPoolIndexPrefix...), poolPairKey(poolId, denom0, denom1)...)
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	epochtypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
+)
+
+// Parameter store keys.
+var (
+	KeyRecordHistoryKeepPeriod = []byte("RecordHistoryKeepPeriod")
+	KeyPruneEpochIdentifier    = []byte("PruneEpochIdentifier")
+)
+
+// DefaultRecordHistoryKeepPeriod is the default time for which historical
+// twap records are kept.
+const DefaultRecordHistoryKeepPeriod = 48 * time.Hour
+
+// DefaultPruneEpochIdentifier is the default identifier of the epoch
+// at whose end historical twap records are pruned.
+const DefaultPruneEpochIdentifier = "day"
+
+// ParamKeyTable returns the twap module's parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(pruneEpochIdentifier string, recordHistoryKeepPeriod time.Duration) Params {
+	return Params{
+		RecordHistoryKeepPeriod: recordHistoryKeepPeriod,
+		PruneEpochIdentifier:    pruneEpochIdentifier,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the twap module.
+func DefaultParams() Params {
+	return NewParams(DefaultPruneEpochIdentifier, DefaultRecordHistoryKeepPeriod)
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	if err := validateRecordHistoryKeepPeriod(p.RecordHistoryKeepPeriod); err != nil {
+		return err
+	}
+	return epochtypes.ValidateEpochIdentifierString(p.PruneEpochIdentifier)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRecordHistoryKeepPeriod, &p.RecordHistoryKeepPeriod, validateRecordHistoryKeepPeriod),
+		paramtypes.NewParamSetPair(KeyPruneEpochIdentifier, &p.PruneEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+	}
+}
+
+func validateRecordHistoryKeepPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("record history keep period must be positive: %s", v)
+	}
+
+	return nil
+}
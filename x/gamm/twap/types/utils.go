@@ -0,0 +1,61 @@
+package types
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetAllUniqueDenomPairs returns all unique pairs of denoms, where for every pair
+// (X, Y), X < Y lexicographically.
+// The first returned slice holds the smaller denom of each pair,
+// the second returned slice holds the larger one.
+// Panics if the input contains duplicate denoms.
+func GetAllUniqueDenomPairs(denoms []string) ([]string, []string) {
+	sortedDenoms := make([]string, len(denoms))
+	copy(sortedDenoms, denoms)
+	sort.Strings(sortedDenoms)
+
+	numPairs := len(denoms) * (len(denoms) - 1) / 2
+	pairGT := make([]string, 0, numPairs)
+	pairLT := make([]string, 0, numPairs)
+	for i := 0; i < len(sortedDenoms); i++ {
+		for j := i + 1; j < len(sortedDenoms); j++ {
+			if sortedDenoms[i] == sortedDenoms[j] {
+				panic("input had duplicated denom")
+			}
+			pairLT = append(pairLT, sortedDenoms[i])
+			pairGT = append(pairGT, sortedDenoms[j])
+		}
+	}
+	return pairLT, pairGT
+}
+
+// LexicographicalOrderDenoms returns the two denoms in lexicographical order,
+// erroring if they are the same denom.
+func LexicographicalOrderDenoms(denom0, denom1 string) (string, string, error) {
+	if denom0 == denom1 {
+		return "", "", sdkerrors.Wrapf(ErrInvalidAssetPair, "both assets cannot be of the same denom %s", denom0)
+	}
+	if denom0 > denom1 {
+		denom0, denom1 = denom1, denom0
+	}
+	return denom0, denom1, nil
+}
+
+// SpotPriceMulDuration returns the spot price multiplied by the time delta,
+// that is the spot price multiplied by the number of milliseconds that passed.
+// We use milliseconds rather than nanoseconds to reduce the accumulators' growth.
+func SpotPriceMulDuration(sp sdk.Dec, timeDelta time.Duration) sdk.Dec {
+	deltaMS := timeDelta.Milliseconds()
+	return sp.MulInt64(deltaMS)
+}
+
+// AccumDiffDivDuration returns the accumulator difference divided by the
+// number of milliseconds in the time delta.
+func AccumDiffDivDuration(accumDiff sdk.Dec, timeDelta time.Duration) sdk.Dec {
+	deltaMS := timeDelta.Milliseconds()
+	return accumDiff.QuoInt64(deltaMS)
+}
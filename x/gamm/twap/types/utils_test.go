@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAllUniqueDenomPairs(t *testing.T) {
+	tests := map[string]struct {
+		denoms      []string
+		wantedPairs [][2]string
+		panics      bool
+	}{
+		"basic":       {[]string{"A", "B"}, [][2]string{{"A", "B"}}, false},
+		"basic_rev":   {[]string{"B", "A"}, [][2]string{{"A", "B"}}, false},
+		"three_denom": {[]string{"A", "B", "C"}, [][2]string{{"A", "B"}, {"A", "C"}, {"B", "C"}}, false},
+		"unsorted":    {[]string{"C", "A", "B"}, [][2]string{{"A", "B"}, {"A", "C"}, {"B", "C"}}, false},
+		"duplicate":   {[]string{"A", "A"}, nil, true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tt.panics {
+				require.Panics(t, func() { GetAllUniqueDenomPairs(tt.denoms) })
+				return
+			}
+			pairsLT, pairsGT := GetAllUniqueDenomPairs(tt.denoms)
+			require.Len(t, pairsLT, len(tt.wantedPairs))
+			for i, pair := range tt.wantedPairs {
+				require.Equal(t, pair[0], pairsLT[i])
+				require.Equal(t, pair[1], pairsGT[i])
+			}
+		})
+	}
+}
+
+func TestAccumulatorMath(t *testing.T) {
+	sp := sdk.MustNewDecFromStr("1.5")
+	accum := SpotPriceMulDuration(sp, 10*time.Second)
+	require.Equal(t, sdk.NewDec(15000), accum)
+	require.Equal(t, sp, AccumDiffDivDuration(accum, 10*time.Second))
+}
+
+func TestHistoricalPoolIndexKeyOrdering(t *testing.T) {
+	baseTime := time.Unix(1257894000, 0).UTC()
+	earlier := FormatHistoricalPoolIndexTWAPKey(1, "A", "B", baseTime)
+	later := FormatHistoricalPoolIndexTWAPKey(1, "A", "B", baseTime.Add(time.Second))
+	require.Less(t, string(earlier), string(later))
+
+	// A denom that extends another denom must not fall within its prefix.
+	prefix := FormatHistoricalPoolIndexTWAPKeyPrefix(1, "A", "B")
+	otherPair := FormatHistoricalPoolIndexTWAPKey(1, "A", "BB", baseTime)
+	require.NotEqual(t, prefix, otherPair[:len(prefix)])
+}
@@ -36,4 +36,11 @@ var (
 	ErrNotStableSwapPool               = sdkerrors.Register(ModuleName, 61, "not stableswap pool")
 	ErrInvalidStableswapScalingFactors = sdkerrors.Register(ModuleName, 62, "length between liquidity and scaling factors mismatch")
 	ErrNotScalingFactorGovernor        = sdkerrors.Register(ModuleName, 63, "not scaling factor governor")
+	ErrInvalidStableswapAmplification  = sdkerrors.Register(ModuleName, 67, "invalid stableswap amplification parameter")
+	ErrNotPendingScalingFactorGovernor = sdkerrors.Register(ModuleName, 68, "not pending scaling factor governor")
+
+	ErrNotBalancerPool = sdkerrors.Register(ModuleName, 64, "not balancer pool")
+	ErrNotPoolGovernor = sdkerrors.Register(ModuleName, 65, "not pool governor")
+	ErrNoVotingPower   = sdkerrors.Register(ModuleName, 66, "no locked lptoken to vote with")
+	ErrPoolPaused      = sdkerrors.Register(ModuleName, 69, "pool is paused")
 )
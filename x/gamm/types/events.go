@@ -12,11 +12,34 @@ const (
 	TypeEvtPoolCreated  = "pool_created"
 	TypeEvtTokenSwapped = "token_swapped"
 
+	TypeEvtPoolParamsUpdated = "pool_params_updated"
+	TypeEvtPoolParamsVoted   = "pool_params_voted"
+
+	TypeEvtWeightChangeScheduled = "weight_change_scheduled"
+	TypeEvtWeightChangeVoted     = "weight_change_voted"
+
+	TypeEvtTakerFeeCharged      = "taker_fee_charged"
+	TypeEvtTakerFeeRecipientSet = "taker_fee_recipient_set"
+
+	TypeEvtPoolPauseSet          = "pool_pause_set"
+	TypeEvtCircuitBreakerTripped = "circuit_breaker_tripped"
+
 	AttributeValueCategory = ModuleName
 	AttributeKeyPoolId     = "pool_id"
 	AttributeKeySwapFee    = "swap_fee"
 	AttributeKeyTokensIn   = "tokens_in"
 	AttributeKeyTokensOut  = "tokens_out"
+	AttributeKeyTakerFee   = "taker_fee"
+	AttributeKeyRecipient  = "recipient"
+
+	AttributeKeySwapsPaused        = "swaps_paused"
+	AttributeKeyJoinsPaused        = "joins_paused"
+	AttributeKeyExitsPaused        = "exits_paused"
+	AttributeKeyPauseSource        = "pause_source"
+	AttributeKeyBaseAsset          = "base_asset"
+	AttributeKeyQuoteAsset         = "quote_asset"
+	AttributeKeyReferenceSpotPrice = "reference_spot_price"
+	AttributeKeySpotPrice          = "spot_price"
 )
 
 func CreateSwapEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) sdk.Event {
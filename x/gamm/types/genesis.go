@@ -1,7 +1,10 @@
 package types
 
 import (
+	"fmt"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultGenesis creates a default GenesisState object.
@@ -10,6 +13,10 @@ func DefaultGenesis() *GenesisState {
 		Pools:          []*codectypes.Any{},
 		NextPoolNumber: 1,
 		Params:         DefaultParams(),
+
+		TakerFeeRecipients: []TakerFeeRecipient{},
+		TakerFeesCollected: []TakerFeesCollected{},
+		PoolPauses:         []PoolPause{},
 	}
 }
 
@@ -19,5 +26,22 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	for _, recipient := range gs.TakerFeeRecipients {
+		if _, err := sdk.AccAddressFromBech32(recipient.Recipient); err != nil {
+			return fmt.Errorf("invalid taker fee recipient of pool %d: %w", recipient.PoolId, err)
+		}
+	}
+	for _, collected := range gs.TakerFeesCollected {
+		if err := collected.Fees.Validate(); err != nil {
+			return fmt.Errorf("invalid taker fees collected of pool %d: %w", collected.PoolId, err)
+		}
+	}
+	pausedPoolIds := make(map[uint64]bool, len(gs.PoolPauses))
+	for _, pause := range gs.PoolPauses {
+		if pausedPoolIds[pause.PoolId] {
+			return fmt.Errorf("duplicate pause of pool %d", pause.PoolId)
+		}
+		pausedPoolIds[pause.PoolId] = true
+	}
 	return nil
 }
@@ -27,9 +27,51 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PauseSource is who paused a pool.
+type PauseSource int32
+
+const (
+	PauseSourceGovernance     PauseSource = 0
+	PauseSourcePoolGovernor   PauseSource = 1
+	PauseSourceCircuitBreaker PauseSource = 2
+)
+
+var PauseSource_name = map[int32]string{
+	0: "PauseSourceGovernance",
+	1: "PauseSourcePoolGovernor",
+	2: "PauseSourceCircuitBreaker",
+}
+
+var PauseSource_value = map[string]int32{
+	"PauseSourceGovernance":     0,
+	"PauseSourcePoolGovernor":   1,
+	"PauseSourceCircuitBreaker": 2,
+}
+
+func (x PauseSource) String() string {
+	return proto.EnumName(PauseSource_name, int32(x))
+}
+
+func (PauseSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{0}
+}
+
 // Params holds parameters for the incentives module
 type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	// taker_fee is the fraction of the swap fee of every swap that is taken from
+	// the pool's LPs, and sent to the pool's taker fee recipient, or to the
+	// community pool if it has none.
+	TakerFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=taker_fee,json=takerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee" yaml:"taker_fee"`
+	// pool_taker_fees override taker_fee for individual pools.
+	PoolTakerFees []PoolTakerFee `protobuf:"bytes,3,rep,name=pool_taker_fees,json=poolTakerFees,proto3" json:"pool_taker_fees" yaml:"pool_taker_fees"`
+	// circuit_breaker_max_price_change is the largest relative change of a
+	// pool's spot price within circuit_breaker_window blocks before its swaps
+	// and joins are paused. Zero disables the circuit breaker.
+	CircuitBreakerMaxPriceChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=circuit_breaker_max_price_change,json=circuitBreakerMaxPriceChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"circuit_breaker_max_price_change" yaml:"circuit_breaker_max_price_change"`
+	// circuit_breaker_window is the number of blocks that the circuit breaker
+	// compares spot prices over.
+	CircuitBreakerWindow uint64 `protobuf:"varint,5,opt,name=circuit_breaker_window,json=circuitBreakerWindow,proto3" json:"circuit_breaker_window,omitempty" yaml:"circuit_breaker_window"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -72,18 +114,359 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Params) GetPoolTakerFees() []PoolTakerFee {
+	if m != nil {
+		return m.PoolTakerFees
+	}
+	return nil
+}
+
+func (m *Params) GetCircuitBreakerWindow() uint64 {
+	if m != nil {
+		return m.CircuitBreakerWindow
+	}
+	return 0
+}
+
+// PoolTakerFee overrides the taker fee of the pool with pool_id.
+type PoolTakerFee struct {
+	PoolId   uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TakerFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=taker_fee,json=takerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee" yaml:"taker_fee"`
+}
+
+func (m *PoolTakerFee) Reset()         { *m = PoolTakerFee{} }
+func (m *PoolTakerFee) String() string { return proto.CompactTextString(m) }
+func (*PoolTakerFee) ProtoMessage()    {}
+func (*PoolTakerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{1}
+}
+func (m *PoolTakerFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolTakerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolTakerFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolTakerFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolTakerFee.Merge(m, src)
+}
+func (m *PoolTakerFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolTakerFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolTakerFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolTakerFee proto.InternalMessageInfo
+
+func (m *PoolTakerFee) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// TakerFeeRecipient is the address that the governor of the pool with pool_id
+// has the pool's taker fees sent to.
+type TakerFeeRecipient struct {
+	PoolId    uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
+}
+
+func (m *TakerFeeRecipient) Reset()         { *m = TakerFeeRecipient{} }
+func (m *TakerFeeRecipient) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeRecipient) ProtoMessage()    {}
+func (*TakerFeeRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{2}
+}
+func (m *TakerFeeRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeRecipient.Merge(m, src)
+}
+func (m *TakerFeeRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeRecipient proto.InternalMessageInfo
+
+func (m *TakerFeeRecipient) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TakerFeeRecipient) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// TakerFeesCollected are all taker fees taken from the swaps of the pool with
+// pool_id.
+type TakerFeesCollected struct {
+	PoolId uint64                                   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Fees   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees" yaml:"fees"`
+}
+
+func (m *TakerFeesCollected) Reset()         { *m = TakerFeesCollected{} }
+func (m *TakerFeesCollected) String() string { return proto.CompactTextString(m) }
+func (*TakerFeesCollected) ProtoMessage()    {}
+func (*TakerFeesCollected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{3}
+}
+func (m *TakerFeesCollected) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeesCollected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeesCollected.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeesCollected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeesCollected.Merge(m, src)
+}
+func (m *TakerFeesCollected) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeesCollected) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeesCollected.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeesCollected proto.InternalMessageInfo
+
+func (m *TakerFeesCollected) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TakerFeesCollected) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// PoolPause is the set of actions that are paused on the pool with pool_id.
+type PoolPause struct {
+	PoolId      uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	SwapsPaused bool   `protobuf:"varint,2,opt,name=swaps_paused,json=swapsPaused,proto3" json:"swaps_paused,omitempty" yaml:"swaps_paused"`
+	JoinsPaused bool   `protobuf:"varint,3,opt,name=joins_paused,json=joinsPaused,proto3" json:"joins_paused,omitempty" yaml:"joins_paused"`
+	ExitsPaused bool   `protobuf:"varint,4,opt,name=exits_paused,json=exitsPaused,proto3" json:"exits_paused,omitempty" yaml:"exits_paused"`
+	// source is who last paused the pool.
+	Source PauseSource `protobuf:"varint,5,opt,name=source,proto3,enum=osmosis.gamm.v1beta1.PauseSource" json:"source,omitempty" yaml:"source"`
+	// height is the block height at which the pool was last paused.
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// paused_until_height is the block height from which a pause by the circuit
+	// breaker no longer applies. It is zero for pauses that last until they are
+	// lifted.
+	PausedUntilHeight int64 `protobuf:"varint,7,opt,name=paused_until_height,json=pausedUntilHeight,proto3" json:"paused_until_height,omitempty" yaml:"paused_until_height"`
+}
+
+func (m *PoolPause) Reset()         { *m = PoolPause{} }
+func (m *PoolPause) String() string { return proto.CompactTextString(m) }
+func (*PoolPause) ProtoMessage()    {}
+func (*PoolPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{4}
+}
+func (m *PoolPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolPause.Merge(m, src)
+}
+func (m *PoolPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolPause proto.InternalMessageInfo
+
+func (m *PoolPause) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolPause) GetSwapsPaused() bool {
+	if m != nil {
+		return m.SwapsPaused
+	}
+	return false
+}
+
+func (m *PoolPause) GetJoinsPaused() bool {
+	if m != nil {
+		return m.JoinsPaused
+	}
+	return false
+}
+
+func (m *PoolPause) GetExitsPaused() bool {
+	if m != nil {
+		return m.ExitsPaused
+	}
+	return false
+}
+
+func (m *PoolPause) GetSource() PauseSource {
+	if m != nil {
+		return m.Source
+	}
+	return PauseSourceGovernance
+}
+
+func (m *PoolPause) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PoolPause) GetPausedUntilHeight() int64 {
+	if m != nil {
+		return m.PausedUntilHeight
+	}
+	return 0
+}
+
+// CircuitBreakerReference is the spot price of quote_asset in base_asset in
+// the pool with pool_id at height, that the circuit breaker compares the spot
+// prices of the following circuit_breaker_window blocks with.
+type CircuitBreakerReference struct {
+	PoolId     uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	BaseAsset  string                                 `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string                                 `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	Height     int64                                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	SpotPrice  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=spot_price,json=spotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spot_price"`
+}
+
+func (m *CircuitBreakerReference) Reset()         { *m = CircuitBreakerReference{} }
+func (m *CircuitBreakerReference) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerReference) ProtoMessage()    {}
+func (*CircuitBreakerReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{5}
+}
+func (m *CircuitBreakerReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerReference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerReference.Merge(m, src)
+}
+func (m *CircuitBreakerReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerReference proto.InternalMessageInfo
+
+func (m *CircuitBreakerReference) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *CircuitBreakerReference) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
+	}
+	return ""
+}
+
+func (m *CircuitBreakerReference) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
+	}
+	return ""
+}
+
+func (m *CircuitBreakerReference) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // GenesisState defines the gamm module's genesis state.
 type GenesisState struct {
-	Pools          []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
-	NextPoolNumber uint64        `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
-	Params         Params        `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	Pools              []*types1.Any        `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	NextPoolNumber     uint64               `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
+	Params             Params               `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	TakerFeeRecipients []TakerFeeRecipient  `protobuf:"bytes,4,rep,name=taker_fee_recipients,json=takerFeeRecipients,proto3" json:"taker_fee_recipients"`
+	TakerFeesCollected []TakerFeesCollected `protobuf:"bytes,5,rep,name=taker_fees_collected,json=takerFeesCollected,proto3" json:"taker_fees_collected"`
+	PoolPauses         []PoolPause          `protobuf:"bytes,6,rep,name=pool_pauses,json=poolPauses,proto3" json:"pool_pauses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5a324eb7f1dd793e, []int{1}
+	return fileDescriptor_5a324eb7f1dd793e, []int{6}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -133,8 +516,35 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTakerFeeRecipients() []TakerFeeRecipient {
+	if m != nil {
+		return m.TakerFeeRecipients
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTakerFeesCollected() []TakerFeesCollected {
+	if m != nil {
+		return m.TakerFeesCollected
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolPauses() []PoolPause {
+	if m != nil {
+		return m.PoolPauses
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.gamm.v1beta1.PauseSource", PauseSource_name, PauseSource_value)
 	proto.RegisterType((*Params)(nil), "osmosis.gamm.v1beta1.Params")
+	proto.RegisterType((*PoolTakerFee)(nil), "osmosis.gamm.v1beta1.PoolTakerFee")
+	proto.RegisterType((*TakerFeeRecipient)(nil), "osmosis.gamm.v1beta1.TakerFeeRecipient")
+	proto.RegisterType((*TakerFeesCollected)(nil), "osmosis.gamm.v1beta1.TakerFeesCollected")
+	proto.RegisterType((*PoolPause)(nil), "osmosis.gamm.v1beta1.PoolPause")
+	proto.RegisterType((*CircuitBreakerReference)(nil), "osmosis.gamm.v1beta1.CircuitBreakerReference")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.gamm.v1beta1.GenesisState")
 }
 
@@ -143,32 +553,72 @@ func init() {
 }
 
 var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x18, 0xc5, 0x33, 0xde, 0x7b, 0x0b, 0xe6, 0x8a, 0x7f, 0x42, 0x17, 0xb9, 0x17, 0x49, 0x4b, 0x56,
-	0xd9, 0x74, 0x86, 0x5b, 0x11, 0xa1, 0x3b, 0x53, 0x50, 0x0a, 0x22, 0x25, 0xee, 0xdc, 0x84, 0x49,
-	0x9c, 0xc6, 0x60, 0x32, 0x5f, 0xc8, 0x4c, 0x4b, 0xf3, 0x16, 0x82, 0x7b, 0x1f, 0x40, 0xb7, 0x3e,
-	0x44, 0x71, 0xd5, 0xa5, 0xab, 0x2a, 0xed, 0x1b, 0xf8, 0x04, 0x32, 0x7f, 0x22, 0x82, 0x77, 0x95,
-	0x7c, 0xf3, 0xfd, 0xce, 0xe1, 0xcc, 0x49, 0xdc, 0x10, 0x44, 0x0d, 0xa2, 0x14, 0xa4, 0xa0, 0x75,
-	0x4d, 0x36, 0x37, 0x19, 0x93, 0xf4, 0x86, 0x14, 0x8c, 0x33, 0x51, 0x0a, 0xdc, 0xb4, 0x20, 0xc1,
-	0x1b, 0x5a, 0x06, 0x2b, 0x06, 0x5b, 0xe6, 0x7a, 0x58, 0x40, 0x01, 0x1a, 0x20, 0xea, 0xcd, 0xb0,
-	0xd7, 0x57, 0x05, 0x40, 0x51, 0x31, 0xa2, 0xa7, 0x6c, 0xbd, 0x22, 0x94, 0x77, 0xfd, 0x2a, 0xd7,
-	0x3e, 0xa9, 0xd1, 0x98, 0xc1, 0xae, 0x02, 0x33, 0x91, 0x8c, 0x0a, 0xf6, 0x37, 0x44, 0x0e, 0x25,
-	0x37, 0xfb, 0xf0, 0x33, 0x72, 0x07, 0x4b, 0xda, 0xd2, 0x5a, 0x78, 0x9f, 0x90, 0xfb, 0xa8, 0x01,
-	0xa8, 0xd2, 0xbc, 0x65, 0x54, 0x96, 0xc0, 0xd3, 0x15, 0x63, 0x3e, 0x1a, 0x9f, 0x45, 0x97, 0xd3,
-	0x2b, 0x6c, 0x5d, 0x95, 0x4f, 0x1f, 0x14, 0xcf, 0xa1, 0xe4, 0xf1, 0xab, 0xdd, 0x61, 0xe4, 0xfc,
-	0x3e, 0x8c, 0xfc, 0x8e, 0xd6, 0xd5, 0x2c, 0xfc, 0xcf, 0x21, 0xfc, 0xf2, 0x73, 0x14, 0x15, 0xa5,
-	0x7c, 0xbf, 0xce, 0x70, 0x0e, 0xb5, 0x8d, 0x67, 0x1f, 0x13, 0xf1, 0xee, 0x03, 0x91, 0x5d, 0xc3,
-	0x84, 0x36, 0x13, 0xc9, 0x03, 0xa5, 0x9f, 0x5b, 0xf9, 0x0b, 0xc6, 0xc2, 0xaf, 0xc8, 0xbd, 0xf7,
-	0xd2, 0x94, 0xf6, 0x46, 0x52, 0xc9, 0xbc, 0xa7, 0xee, 0x85, 0x62, 0x84, 0x4d, 0x36, 0xc4, 0xa6,
-	0x17, 0xdc, 0xf7, 0x82, 0x9f, 0xf3, 0x2e, 0xbe, 0xfb, 0xfd, 0xdb, 0xe4, 0x62, 0x09, 0x50, 0x2d,
-	0x12, 0x43, 0x7b, 0x91, 0xfb, 0x90, 0xb3, 0xad, 0x4c, 0x75, 0x3e, 0xbe, 0xae, 0x33, 0xd6, 0xfa,
-	0x77, 0xc6, 0x28, 0x3a, 0x4f, 0xee, 0xab, 0x73, 0xc5, 0xbe, 0xd6, 0xa7, 0xde, 0xcc, 0x1d, 0x34,
-	0xba, 0x11, 0xff, 0x6c, 0x8c, 0xa2, 0xcb, 0xe9, 0x63, 0x7c, 0xdb, 0x57, 0xc2, 0xa6, 0xb5, 0xf8,
-	0x5c, 0x5d, 0x3f, 0xb1, 0x8a, 0x78, 0xb1, 0x3b, 0x06, 0x68, 0x7f, 0x0c, 0xd0, 0xaf, 0x63, 0x80,
-	0x3e, 0x9e, 0x02, 0x67, 0x7f, 0x0a, 0x9c, 0x1f, 0xa7, 0xc0, 0x79, 0x4b, 0xfe, 0xa9, 0xc0, 0xfa,
-	0x4d, 0x2a, 0x9a, 0x89, 0x7e, 0x20, 0x9b, 0x67, 0x64, 0x6b, 0xfe, 0x15, 0xdd, 0x47, 0x36, 0xd0,
-	0x17, 0x7a, 0xf2, 0x27, 0x00, 0x00, 0xff, 0xff, 0xda, 0xf2, 0x18, 0x37, 0x48, 0x02, 0x00, 0x00,
+	// 1030 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xf6, 0xc6, 0x8e, 0x5b, 0x8f, 0xd3, 0xfc, 0x92, 0xa9, 0x7f, 0x89, 0x13, 0x9a, 0xdd, 0x74,
+	0x0e, 0xd4, 0x80, 0xb2, 0xab, 0x06, 0x21, 0xa4, 0x5c, 0x50, 0x37, 0xa8, 0x25, 0x52, 0x5b, 0x45,
+	0x53, 0x50, 0x05, 0x97, 0x65, 0xbd, 0x9e, 0x38, 0x9b, 0xda, 0x3b, 0xcb, 0xce, 0x3a, 0x71, 0xbe,
+	0x01, 0x47, 0x24, 0xae, 0x5c, 0x10, 0x37, 0x6e, 0x48, 0xc0, 0x67, 0xa8, 0x38, 0xf5, 0x08, 0x1c,
+	0x0c, 0x4a, 0x2e, 0x9c, 0xfd, 0x09, 0xd0, 0xbc, 0x33, 0xbb, 0xde, 0x38, 0x09, 0xc5, 0x07, 0x4e,
+	0xde, 0x79, 0xdf, 0xe7, 0x79, 0xde, 0x3f, 0xf3, 0xce, 0x8c, 0x11, 0xe1, 0xa2, 0xcf, 0x45, 0x28,
+	0x9c, 0xae, 0xdf, 0xef, 0x3b, 0xc7, 0xf7, 0xdb, 0x2c, 0xf5, 0xef, 0x3b, 0x5d, 0x16, 0x31, 0x11,
+	0x0a, 0x3b, 0x4e, 0x78, 0xca, 0x71, 0x43, 0x63, 0x6c, 0x89, 0xb1, 0x35, 0x66, 0xbd, 0xd1, 0xe5,
+	0x5d, 0x0e, 0x00, 0x47, 0x7e, 0x29, 0xec, 0xfa, 0x5a, 0x97, 0xf3, 0x6e, 0x8f, 0x39, 0xb0, 0x6a,
+	0x0f, 0x0e, 0x1c, 0x3f, 0x3a, 0xcd, 0x5c, 0x01, 0xe8, 0x78, 0x8a, 0xa3, 0x16, 0xda, 0x65, 0xaa,
+	0x95, 0xd3, 0xf6, 0x05, 0xcb, 0x93, 0x08, 0x78, 0x18, 0x29, 0x3f, 0xf9, 0xab, 0x82, 0xaa, 0xfb,
+	0x7e, 0xe2, 0xf7, 0x05, 0xfe, 0xda, 0x40, 0xcb, 0x31, 0xe7, 0x3d, 0x2f, 0x48, 0x98, 0x9f, 0x86,
+	0x3c, 0xf2, 0x0e, 0x18, 0x6b, 0x1a, 0x9b, 0xe5, 0x56, 0x7d, 0x7b, 0xcd, 0xd6, 0xaa, 0x52, 0x27,
+	0x4b, 0xd4, 0xde, 0xe5, 0x61, 0xe4, 0x3e, 0x7e, 0x39, 0xb2, 0x4a, 0xe3, 0x91, 0xd5, 0x3c, 0xf5,
+	0xfb, 0xbd, 0x1d, 0x72, 0x49, 0x81, 0x7c, 0xff, 0x87, 0xd5, 0xea, 0x86, 0xe9, 0xe1, 0xa0, 0x6d,
+	0x07, 0xbc, 0xaf, 0xd3, 0xd3, 0x3f, 0x5b, 0xa2, 0xf3, 0xc2, 0x49, 0x4f, 0x63, 0x26, 0x40, 0x4c,
+	0xd0, 0xff, 0x49, 0xfe, 0xae, 0xa6, 0x3f, 0x64, 0x0c, 0x7b, 0xa8, 0x96, 0xfa, 0x2f, 0x58, 0x02,
+	0xc9, 0xcc, 0x6d, 0x1a, 0xad, 0x9a, 0xeb, 0xca, 0x88, 0xbf, 0x8f, 0xac, 0x37, 0xff, 0x85, 0xea,
+	0x87, 0x2c, 0x18, 0x8f, 0xac, 0x25, 0x95, 0x5b, 0x2e, 0x44, 0xe8, 0x4d, 0xf8, 0x96, 0x01, 0x8e,
+	0x10, 0xc4, 0xf4, 0x72, 0xa7, 0x68, 0x96, 0xa1, 0x66, 0x62, 0x5f, 0xb5, 0x3b, 0xf6, 0x3e, 0xe7,
+	0xbd, 0x8f, 0x35, 0xd9, 0x35, 0x75, 0xf1, 0x2b, 0x85, 0xe2, 0x27, 0x42, 0x84, 0xde, 0x8a, 0x0b,
+	0x68, 0x81, 0xbf, 0x35, 0xd0, 0x66, 0x10, 0x26, 0xc1, 0x20, 0x4c, 0xbd, 0x76, 0xc2, 0x00, 0xd8,
+	0xf7, 0x87, 0x5e, 0x9c, 0x84, 0x01, 0xf3, 0x82, 0x43, 0x3f, 0xea, 0xb2, 0x66, 0x05, 0x8a, 0xfc,
+	0x74, 0xe6, 0x22, 0xef, 0xa9, 0x1c, 0x5e, 0xa7, 0x4f, 0xe8, 0x1d, 0x0d, 0x71, 0x15, 0xe2, 0x89,
+	0x3f, 0xdc, 0x97, 0xfe, 0x5d, 0x70, 0xe3, 0xe7, 0x68, 0x65, 0x5a, 0xe2, 0x24, 0x8c, 0x3a, 0xfc,
+	0xa4, 0x39, 0xbf, 0x69, 0xb4, 0x2a, 0xee, 0xdd, 0xf1, 0xc8, 0xda, 0xb8, 0x3a, 0x94, 0xc2, 0x11,
+	0xda, 0xb8, 0x18, 0xe0, 0xb9, 0x32, 0x7f, 0x63, 0xa0, 0x85, 0x62, 0xf3, 0xf0, 0x3b, 0xe8, 0x06,
+	0x34, 0x2c, 0xec, 0x34, 0x0d, 0x90, 0xc6, 0xe3, 0x91, 0xb5, 0x58, 0xe8, 0x64, 0xd8, 0x21, 0xb4,
+	0x2a, 0xbf, 0xf6, 0x3a, 0xff, 0xf9, 0x1c, 0x90, 0x14, 0x2d, 0x67, 0x99, 0x51, 0x16, 0x84, 0x71,
+	0xc8, 0xa2, 0x74, 0xb6, 0x14, 0xb7, 0x51, 0x2d, 0xc9, 0x98, 0x3a, 0xc5, 0xc6, 0x24, 0x68, 0xee,
+	0x22, 0x74, 0x02, 0x23, 0x3f, 0x18, 0x08, 0xe7, 0xf3, 0xb1, 0xcb, 0x7b, 0x3d, 0x16, 0xa4, 0xac,
+	0x33, 0x5b, 0xdc, 0x08, 0x55, 0x60, 0x6c, 0xe7, 0x5e, 0x77, 0x54, 0x3f, 0xd0, 0xd3, 0x5a, 0x57,
+	0x42, 0x30, 0xa2, 0x33, 0x9d, 0x4e, 0x88, 0x43, 0x7e, 0x2a, 0xa3, 0x9a, 0xdc, 0xc8, 0x7d, 0x7f,
+	0x20, 0x66, 0xdc, 0xc5, 0x1d, 0xb4, 0x20, 0x4e, 0xfc, 0x58, 0x78, 0xb1, 0xe4, 0x76, 0xa0, 0x4b,
+	0x37, 0xdd, 0xd5, 0xf1, 0xc8, 0xba, 0xad, 0x18, 0x45, 0x2f, 0xa1, 0x75, 0x58, 0x42, 0x1c, 0xe0,
+	0x1e, 0xc9, 0x2c, 0x32, 0x6e, 0x79, 0x9a, 0x5b, 0xf4, 0x12, 0x5a, 0x87, 0xe5, 0x84, 0xcb, 0x86,
+	0x61, 0x9a, 0x73, 0x2b, 0xd3, 0xdc, 0xa2, 0x97, 0xd0, 0x3a, 0x2c, 0x35, 0xf7, 0x31, 0xaa, 0x0a,
+	0x3e, 0x48, 0x02, 0x06, 0x07, 0x60, 0x71, 0xfb, 0xee, 0x35, 0xf7, 0x82, 0x44, 0x3f, 0x03, 0xa0,
+	0xbb, 0x3c, 0x1e, 0x59, 0xb7, 0x74, 0x41, 0x60, 0x21, 0x54, 0x6b, 0xe0, 0xb7, 0x50, 0xf5, 0x90,
+	0x85, 0xdd, 0xc3, 0xb4, 0x59, 0xdd, 0x34, 0x5a, 0xe5, 0x22, 0x54, 0xd9, 0x09, 0xd5, 0x00, 0xfc,
+	0x14, 0xdd, 0x56, 0x09, 0x79, 0x83, 0x28, 0x0d, 0x7b, 0x9e, 0xe6, 0xdd, 0x00, 0x9e, 0x39, 0x1e,
+	0x59, 0xeb, 0xba, 0xcb, 0x97, 0x41, 0x84, 0x2e, 0x2b, 0xeb, 0x27, 0xd2, 0xf8, 0x91, 0xb2, 0xfd,
+	0x66, 0xa0, 0xd5, 0xdd, 0x0b, 0x27, 0x93, 0xb2, 0x03, 0x96, 0xb0, 0x28, 0x60, 0x78, 0x75, 0x6a,
+	0x17, 0xf3, 0x1d, 0xdb, 0x40, 0x48, 0x0e, 0x92, 0xe7, 0x0b, 0xc1, 0xf4, 0x54, 0xd3, 0x9a, 0xb4,
+	0x3c, 0x90, 0x06, 0x6c, 0xa1, 0xfa, 0x17, 0x03, 0x9e, 0x66, 0xfe, 0x32, 0xf8, 0x11, 0x98, 0x14,
+	0x60, 0x25, 0xaf, 0x57, 0xf6, 0xbc, 0x9c, 0x17, 0xf7, 0x04, 0x21, 0x11, 0xf3, 0x54, 0x5d, 0x4d,
+	0xd0, 0xd9, 0x9a, 0x6b, 0xcf, 0x76, 0xa0, 0x69, 0x4d, 0x2a, 0xc0, 0xdd, 0x45, 0x7e, 0x2e, 0xa3,
+	0x85, 0x47, 0xea, 0x6d, 0x7d, 0x96, 0xfa, 0x29, 0xc3, 0xef, 0xa1, 0x79, 0x59, 0x81, 0xd0, 0x0f,
+	0x58, 0xc3, 0x56, 0xcf, 0xa7, 0x9d, 0x3d, 0x9f, 0xf6, 0x83, 0xe8, 0xd4, 0xad, 0xfd, 0xf2, 0xe3,
+	0xd6, 0xbc, 0x1c, 0xe5, 0x3d, 0xaa, 0xd0, 0xb8, 0x85, 0x96, 0x22, 0x36, 0x4c, 0x3d, 0x68, 0x46,
+	0x34, 0xe8, 0xb7, 0x59, 0x02, 0x45, 0x57, 0xe8, 0xa2, 0xb4, 0x4b, 0xec, 0x53, 0xb0, 0xe2, 0x1d,
+	0x54, 0x8d, 0xe1, 0xe1, 0x84, 0xa2, 0xeb, 0xdb, 0x77, 0xae, 0x1b, 0x0b, 0x89, 0x71, 0x2b, 0xb2,
+	0x34, 0xaa, 0x19, 0xd8, 0x43, 0x8d, 0xfc, 0x0e, 0xf2, 0xf2, 0xcb, 0x40, 0x34, 0x2b, 0x90, 0xeb,
+	0xbd, 0xab, 0x95, 0x2e, 0xdd, 0x4e, 0x5a, 0x14, 0xa7, 0xd3, 0x0e, 0x81, 0x3f, 0x2f, 0x04, 0x10,
+	0x5e, 0x90, 0xdd, 0x2b, 0xcd, 0x79, 0x08, 0xd0, 0xfa, 0xe7, 0x00, 0x93, 0x7b, 0x68, 0x3a, 0xc2,
+	0xc4, 0x83, 0x1f, 0xa2, 0x3a, 0xf4, 0x08, 0xc6, 0x4c, 0x34, 0xab, 0x20, 0x6c, 0x5d, 0xff, 0x64,
+	0xc2, 0xf1, 0xd0, 0x7a, 0x28, 0xce, 0x0c, 0xe2, 0xed, 0x23, 0x54, 0x2f, 0x9c, 0x1c, 0xbc, 0x86,
+	0xfe, 0x5f, 0x58, 0x3e, 0xe2, 0xc7, 0x2c, 0x89, 0xfc, 0x28, 0x60, 0x4b, 0x25, 0xfc, 0x06, 0x5a,
+	0x2d, 0xb8, 0xa4, 0xa6, 0x72, 0xf3, 0x64, 0xc9, 0xc0, 0x1b, 0x68, 0xad, 0xe0, 0xbc, 0x38, 0xe5,
+	0x4b, 0x73, 0xeb, 0x95, 0x2f, 0xbf, 0x33, 0x4b, 0xee, 0xde, 0xcb, 0x33, 0xd3, 0x78, 0x75, 0x66,
+	0x1a, 0x7f, 0x9e, 0x99, 0xc6, 0x57, 0xe7, 0x66, 0xe9, 0xd5, 0xb9, 0x59, 0xfa, 0xf5, 0xdc, 0x2c,
+	0x7d, 0xe6, 0x14, 0x26, 0x4e, 0x97, 0xb0, 0xd5, 0xf3, 0xdb, 0x22, 0x5b, 0x38, 0xc7, 0xef, 0x3b,
+	0x43, 0xf5, 0x4f, 0x0e, 0xc6, 0xaf, 0x5d, 0x85, 0x39, 0x7a, 0xf7, 0xef, 0x01, 0x00, 0x10, 0x75,
+	0x9d, 0xe3, 0xe6, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -191,6 +641,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CircuitBreakerWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CircuitBreakerWindow))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.CircuitBreakerMaxPriceChange.Size()
+		i -= size
+		if _, err := m.CircuitBreakerMaxPriceChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PoolTakerFees) > 0 {
+		for iNdEx := len(m.PoolTakerFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolTakerFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.PoolCreationFee) > 0 {
 		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -208,7 +697,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *PoolTakerFee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -218,101 +707,1220 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolTakerFee) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.NextPoolNumber != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPoolNumber))
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x8
 	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+	return len(dAtA) - i, nil
+}
+
+func (m *TakerFeeRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeeRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TakerFeesCollected) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakerFeesCollected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeesCollected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PausedUntilHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PausedUntilHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Source != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExitsPaused {
+		i--
+		if m.ExitsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.JoinsPaused {
+		i--
+		if m.JoinsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SwapsPaused {
+		i--
+		if m.SwapsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolPauses) > 0 {
+		for iNdEx := len(m.PoolPauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolPauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TakerFeesCollected) > 0 {
+		for iNdEx := len(m.TakerFeesCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeesCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TakerFeeRecipients) > 0 {
+		for iNdEx := len(m.TakerFeeRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakerFeeRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.NextPoolNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPoolNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
 			{
 				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolCreationFee) > 0 {
+		for _, e := range m.PoolCreationFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PoolTakerFees) > 0 {
+		for _, e := range m.PoolTakerFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.CircuitBreakerMaxPriceChange.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.CircuitBreakerWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.CircuitBreakerWindow))
+	}
+	return n
+}
+
+func (m *PoolTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TakerFeeRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TakerFeesCollected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	if m.SwapsPaused {
+		n += 2
+	}
+	if m.JoinsPaused {
+		n += 2
+	}
+	if m.ExitsPaused {
+		n += 2
+	}
+	if m.Source != 0 {
+		n += 1 + sovGenesis(uint64(m.Source))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.PausedUntilHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.PausedUntilHeight))
+	}
+	return n
+}
+
+func (m *CircuitBreakerReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPoolNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPoolNumber))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TakerFeeRecipients) > 0 {
+		for _, e := range m.TakerFeeRecipients {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TakerFeesCollected) > 0 {
+		for _, e := range m.TakerFeesCollected {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolPauses) > 0 {
+		for _, e := range m.PoolPauses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
+			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTakerFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolTakerFees = append(m.PoolTakerFees, PoolTakerFee{})
+			if err := m.PoolTakerFees[len(m.PoolTakerFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerMaxPriceChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreakerMaxPriceChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerWindow", wireType)
+			}
+			m.CircuitBreakerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolTakerFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolTakerFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolTakerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeeRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeesCollected) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeesCollected: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeesCollected: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapsPaused = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JoinsPaused = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExitsPaused = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= PauseSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedUntilHeight", wireType)
+			}
+			m.PausedUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausedUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PoolCreationFee) > 0 {
-		for _, e := range m.PoolCreationFee {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.NextPoolNumber != 0 {
-		n += 1 + sovGenesis(uint64(m.NextPoolNumber))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *CircuitBreakerReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -335,17 +1943,36 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: CircuitBreakerReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CircuitBreakerReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -355,23 +1982,106 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
-			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -511,6 +2221,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeeRecipients = append(m.TakerFeeRecipients, TakerFeeRecipient{})
+			if err := m.TakerFeeRecipients[len(m.TakerFeeRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesCollected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeesCollected = append(m.TakerFeesCollected, TakerFeesCollected{})
+			if err := m.TakerFeesCollected[len(m.TakerFeesCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPauses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolPauses = append(m.PoolPauses, PoolPause{})
+			if err := m.PoolPauses[len(m.PoolPauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeSetPoolPause = "SetPoolPause"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetPoolPause)
+	govtypes.RegisterProposalTypeCodec(&SetPoolPauseProposal{}, "osmosis/SetPoolPauseProposal")
+}
+
+var _ govtypes.Content = &SetPoolPauseProposal{}
+
+func NewSetPoolPauseProposal(title, description string, poolId uint64, swapsPaused, joinsPaused, exitsPaused bool) SetPoolPauseProposal {
+	return SetPoolPauseProposal{
+		Title:       title,
+		Description: description,
+		PoolId:      poolId,
+		SwapsPaused: swapsPaused,
+		JoinsPaused: joinsPaused,
+		ExitsPaused: exitsPaused,
+	}
+}
+
+func (p *SetPoolPauseProposal) GetTitle() string { return p.Title }
+
+func (p *SetPoolPauseProposal) GetDescription() string { return p.Description }
+
+func (p *SetPoolPauseProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetPoolPauseProposal) ProposalType() string {
+	return ProposalTypeSetPoolPause
+}
+
+func (p *SetPoolPauseProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.PoolId == 0 {
+		return errors.New("pool id must be positive")
+	}
+	return nil
+}
+
+func (p SetPoolPauseProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Pool Pause Proposal:
+  Title:        %s
+  Description:  %s
+  Pool Id:      %d
+  Swaps Paused: %t
+  Joins Paused: %t
+  Exits Paused: %t
+`, p.Title, p.Description, p.PoolId, p.SwapsPaused, p.JoinsPaused, p.ExitsPaused))
+	return b.String()
+}
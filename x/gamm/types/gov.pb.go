@@ -0,0 +1,535 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/v1beta1/gov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SetPoolPauseProposal is a gov Content type for pausing or unpausing swaps,
+// joins and exits of the pool with pool_id. Once governance pauses any of
+// them, only governance can change the pool's pause.
+type SetPoolPauseProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	PoolId      uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	SwapsPaused bool   `protobuf:"varint,4,opt,name=swaps_paused,json=swapsPaused,proto3" json:"swaps_paused,omitempty" yaml:"swaps_paused"`
+	JoinsPaused bool   `protobuf:"varint,5,opt,name=joins_paused,json=joinsPaused,proto3" json:"joins_paused,omitempty" yaml:"joins_paused"`
+	ExitsPaused bool   `protobuf:"varint,6,opt,name=exits_paused,json=exitsPaused,proto3" json:"exits_paused,omitempty" yaml:"exits_paused"`
+}
+
+func (m *SetPoolPauseProposal) Reset()      { *m = SetPoolPauseProposal{} }
+func (*SetPoolPauseProposal) ProtoMessage() {}
+func (*SetPoolPauseProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31b9a6c0dbbdfa3, []int{0}
+}
+func (m *SetPoolPauseProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPoolPauseProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPoolPauseProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPoolPauseProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPoolPauseProposal.Merge(m, src)
+}
+func (m *SetPoolPauseProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPoolPauseProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPoolPauseProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPoolPauseProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SetPoolPauseProposal)(nil), "osmosis.gamm.v1beta1.SetPoolPauseProposal")
+}
+
+func init() { proto.RegisterFile("osmosis/gamm/v1beta1/gov.proto", fileDescriptor_f31b9a6c0dbbdfa3) }
+
+var fileDescriptor_f31b9a6c0dbbdfa3 = []byte{
+	// 346 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x31, 0x4f, 0xc2, 0x40,
+	0x18, 0x86, 0x7b, 0x08, 0xa8, 0x2d, 0x31, 0xa6, 0x12, 0x6d, 0x1c, 0x5a, 0x72, 0x83, 0x21, 0x31,
+	0xf6, 0x42, 0x1c, 0x34, 0x8c, 0xdd, 0xd8, 0x48, 0xdd, 0x5c, 0x48, 0x4b, 0x9b, 0x7a, 0xa6, 0xe5,
+	0x6b, 0xb8, 0x03, 0xe1, 0x1f, 0x38, 0x3a, 0x3a, 0xf2, 0x73, 0x1c, 0x19, 0x9d, 0x1a, 0x03, 0x83,
+	0xce, 0xfd, 0x05, 0xa6, 0x77, 0x15, 0x1b, 0xb6, 0xef, 0xed, 0xf3, 0x3e, 0x49, 0x2f, 0xaf, 0x6a,
+	0x02, 0x4b, 0x80, 0x51, 0x46, 0x22, 0x2f, 0x49, 0xc8, 0xbc, 0xe7, 0x87, 0xdc, 0xeb, 0x91, 0x08,
+	0xe6, 0x76, 0x3a, 0x05, 0x0e, 0x7a, 0xbb, 0xe4, 0x76, 0xc1, 0xed, 0x92, 0x5f, 0xb6, 0x23, 0x88,
+	0x40, 0x14, 0x48, 0x71, 0xc9, 0x2e, 0xfe, 0xae, 0xa9, 0xed, 0x87, 0x90, 0x0f, 0x01, 0xe2, 0xa1,
+	0x37, 0x63, 0xe1, 0x70, 0x0a, 0x29, 0x30, 0x2f, 0xd6, 0xaf, 0xd4, 0x06, 0xa7, 0x3c, 0x0e, 0x0d,
+	0xd4, 0x41, 0xdd, 0x63, 0xe7, 0x34, 0xcf, 0xac, 0xd6, 0xd2, 0x4b, 0xe2, 0x3e, 0x16, 0x9f, 0xb1,
+	0x2b, 0xb1, 0x7e, 0xaf, 0x6a, 0x41, 0xc8, 0xc6, 0x53, 0x9a, 0x72, 0x0a, 0x13, 0xa3, 0x26, 0xda,
+	0xe7, 0x79, 0x66, 0xe9, 0xb2, 0x5d, 0x81, 0xd8, 0xad, 0x56, 0xf5, 0x6b, 0xf5, 0x30, 0x05, 0x88,
+	0x47, 0x34, 0x30, 0x0e, 0x3a, 0xa8, 0x5b, 0x77, 0xf4, 0x3c, 0xb3, 0x4e, 0xa4, 0x55, 0x02, 0xec,
+	0x36, 0x8b, 0x6b, 0x10, 0xe8, 0x7d, 0xb5, 0xc5, 0x5e, 0xbc, 0x94, 0x8d, 0xd2, 0xe2, 0x2f, 0x03,
+	0xa3, 0xde, 0x41, 0xdd, 0x23, 0xe7, 0x22, 0xcf, 0xac, 0x33, 0x69, 0x54, 0x29, 0x76, 0x35, 0x11,
+	0xc5, 0x8b, 0x84, 0xfb, 0x0c, 0x74, 0xb2, 0x73, 0x1b, 0xfb, 0x6e, 0x95, 0x62, 0x57, 0x13, 0xf1,
+	0xdf, 0x0d, 0x17, 0x94, 0xef, 0xdc, 0xe6, 0xbe, 0x5b, 0xa5, 0xd8, 0xd5, 0x44, 0x94, 0x6e, 0xbf,
+	0xf5, 0xba, 0xb2, 0x94, 0xf7, 0x95, 0xa5, 0xfc, 0xac, 0x2c, 0xe4, 0x0c, 0x3e, 0x36, 0x26, 0x5a,
+	0x6f, 0x4c, 0xf4, 0xb5, 0x31, 0xd1, 0xdb, 0xd6, 0x54, 0xd6, 0x5b, 0x53, 0xf9, 0xdc, 0x9a, 0xca,
+	0x23, 0x89, 0x28, 0x7f, 0x9a, 0xf9, 0xf6, 0x18, 0x12, 0x52, 0x4e, 0x77, 0x13, 0x7b, 0x3e, 0xfb,
+	0x0b, 0x64, 0x7e, 0x47, 0x16, 0x72, 0x6c, 0xbe, 0x4c, 0x43, 0xe6, 0x37, 0xc5, 0x76, 0xb7, 0xbf,
+	0x03, 0x00, 0xf9, 0x7a, 0xd4, 0xf8, 0x09, 0x02, 0x00, 0x00,
+}
+
+func (this *SetPoolPauseProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetPoolPauseProposal)
+	if !ok {
+		that2, ok := that.(SetPoolPauseProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.SwapsPaused != that1.SwapsPaused {
+		return false
+	}
+	if this.JoinsPaused != that1.JoinsPaused {
+		return false
+	}
+	if this.ExitsPaused != that1.ExitsPaused {
+		return false
+	}
+	return true
+}
+func (m *SetPoolPauseProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPoolPauseProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPoolPauseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExitsPaused {
+		i--
+		if m.ExitsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.JoinsPaused {
+		i--
+		if m.JoinsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SwapsPaused {
+		i--
+		if m.SwapsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SetPoolPauseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGov(uint64(m.PoolId))
+	}
+	if m.SwapsPaused {
+		n += 2
+	}
+	if m.JoinsPaused {
+		n += 2
+	}
+	if m.ExitsPaused {
+		n += 2
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SetPoolPauseProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPoolPauseProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPoolPauseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapsPaused = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JoinsPaused = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExitsPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
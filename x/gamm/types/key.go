@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -25,6 +26,16 @@ var (
 	KeyPrefixPools = []byte{0x02}
 	// KeyTotalLiquidity defines key to store total liquidity.
 	KeyTotalLiquidity = []byte{0x03}
+	// KeyPrefixPoolParamsVotes defines prefix to store the votes of locked LP holders for new pool params.
+	KeyPrefixPoolParamsVotes = []byte{0x04}
+	// KeyPrefixTakerFeeRecipients defines prefix to store the addresses that pool governors have taker fees sent to.
+	KeyPrefixTakerFeeRecipients = []byte{0x05}
+	// KeyPrefixTakerFeesCollected defines prefix to store the taker fees taken from each pool's swaps.
+	KeyPrefixTakerFeesCollected = []byte{0x06}
+	// KeyPrefixPoolPauses defines prefix to store the actions that are paused on each pool.
+	KeyPrefixPoolPauses = []byte{0x07}
+	// KeyPrefixCircuitBreakerReferences defines prefix to store the spot prices that the circuit breaker compares with.
+	KeyPrefixCircuitBreakerReferences = []byte{0x08}
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -56,3 +67,29 @@ func GetPoolShareDenom(poolId uint64) string {
 func GetKeyPrefixPools(poolId uint64) []byte {
 	return append(KeyPrefixPools, sdk.Uint64ToBigEndian(poolId)...)
 }
+
+func GetKeyPrefixPoolParamsVotes(poolId uint64) []byte {
+	return append(KeyPrefixPoolParamsVotes, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyPoolParamsVote(poolId uint64, voter sdk.AccAddress) []byte {
+	return append(GetKeyPrefixPoolParamsVotes(poolId), address.MustLengthPrefix(voter)...)
+}
+
+func GetKeyTakerFeeRecipient(poolId uint64) []byte {
+	return append(KeyPrefixTakerFeeRecipients, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyTakerFeesCollected(poolId uint64) []byte {
+	return append(KeyPrefixTakerFeesCollected, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyPoolPause(poolId uint64) []byte {
+	return append(KeyPrefixPoolPauses, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyCircuitBreakerReference(poolId uint64, baseAsset, quoteAsset string) []byte {
+	key := append(KeyPrefixCircuitBreakerReferences, sdk.Uint64ToBigEndian(poolId)...)
+	key = append(key, address.MustLengthPrefix([]byte(baseAsset))...)
+	return append(key, address.MustLengthPrefix([]byte(quoteAsset))...)
+}
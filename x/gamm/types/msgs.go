@@ -27,24 +27,45 @@ func ValidateFutureGovernor(governor string) error {
 		return nil
 	}
 
+	_, err := ParseFutureGovernor(governor, 0)
+	return err
+}
+
+// FutureGovernor is a parsed future pool governor.
+// Either Address is set, or the pool is governed by the lockers of LockDenom for at least LockDuration.
+type FutureGovernor struct {
+	Address      sdk.AccAddress
+	LockDenom    string
+	LockDuration time.Duration
+}
+
+// ParseFutureGovernor parses the future governor of the pool with poolId, which is either
+// an address, a "lptoken,duration" spec, or a duration, which is governed by the lockers
+// of the pool's own share denom.
+func ParseFutureGovernor(governor string, poolId uint64) (FutureGovernor, error) {
+	if governor == "" {
+		return FutureGovernor{}, sdkerrors.Wrapf(ErrNotPoolGovernor, "pool %d has no governor", poolId)
+	}
+
 	// validation for future owner
 	// "osmo1fqlr98d45v5ysqgp6h56kpujcj4cvsjnjq9nck"
-	_, err := sdk.AccAddressFromBech32(governor)
+	addr, err := sdk.AccAddressFromBech32(governor)
 	if err == nil {
-		return nil
+		return FutureGovernor{Address: addr}, nil
 	}
 
+	lpTokenStr := GetPoolShareDenom(poolId)
 	lockTimeStr := ""
 	splits := strings.Split(governor, ",")
 	if len(splits) > 2 {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
+		return FutureGovernor{}, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
 	}
 
 	// token,100h
 	if len(splits) == 2 {
-		lpTokenStr := splits[0]
+		lpTokenStr = splits[0]
 		if sdk.ValidateDenom(lpTokenStr) != nil {
-			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
+			return FutureGovernor{}, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
 		}
 		lockTimeStr = splits[1]
 	}
@@ -55,11 +76,11 @@ func ValidateFutureGovernor(governor string) error {
 	}
 
 	// Note that a duration of 0 is allowed
-	_, err = time.ParseDuration(lockTimeStr)
+	lockDuration, err := time.ParseDuration(lockTimeStr)
 	if err != nil {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
+		return FutureGovernor{}, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
 	}
-	return nil
+	return FutureGovernor{LockDenom: lpTokenStr, LockDuration: lockDuration}, nil
 }
 
 var _ sdk.Msg = &MsgSwapExactAmountIn{}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -867,3 +868,56 @@ func TestMsgExitSwapShareAmountIn(t *testing.T) {
 		}
 	}
 }
+
+func TestParseFutureGovernor(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	tests := []struct {
+		name             string
+		governor         string
+		expectedGovernor FutureGovernor
+		expectErr        bool
+	}{
+		{
+			name:             "address",
+			governor:         addr.String(),
+			expectedGovernor: FutureGovernor{Address: addr},
+		},
+		{
+			name:             "lptoken and lock duration",
+			governor:         "lptoken,1000h",
+			expectedGovernor: FutureGovernor{LockDenom: "lptoken", LockDuration: 1000 * time.Hour},
+		},
+		{
+			name:             "lock duration for pool token",
+			governor:         "1000h",
+			expectedGovernor: FutureGovernor{LockDenom: "gamm/pool/7", LockDuration: 1000 * time.Hour},
+		},
+		{
+			name:      "no governor",
+			governor:  "",
+			expectErr: true,
+		},
+		{
+			name:      "invalid governor",
+			governor:  "invalid_cosmos_address",
+			expectErr: true,
+		},
+		{
+			name:      "too many splits",
+			governor:  "lptoken,1000h,1000h",
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		governor, err := ParseFutureGovernor(test.governor, 7)
+		if test.expectErr {
+			require.Error(t, err, "test: %v", test.name)
+			continue
+		}
+		require.NoError(t, err, "test: %v", test.name)
+		require.Equal(t, test.expectedGovernor, governor, "test: %v", test.name)
+	}
+}
@@ -12,16 +12,31 @@ import (
 // Parameter store keys.
 var (
 	KeyPoolCreationFee = []byte("PoolCreationFee")
+	KeyTakerFee        = []byte("TakerFee")
+	KeyPoolTakerFees   = []byte("PoolTakerFees")
+
+	KeyCircuitBreakerMaxPriceChange = []byte("CircuitBreakerMaxPriceChange")
+	KeyCircuitBreakerWindow         = []byte("CircuitBreakerWindow")
 )
 
+// DefaultCircuitBreakerWindow is the default number of blocks that the circuit breaker compares spot prices over.
+const DefaultCircuitBreakerWindow = 100
+
 // ParamTable for gamm module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// NewParams returns params with the given pool creation fee, that take no taker fee
+// and disable the circuit breaker.
 func NewParams(poolCreationFee sdk.Coins) Params {
 	return Params{
 		PoolCreationFee: poolCreationFee,
+		TakerFee:        sdk.ZeroDec(),
+		PoolTakerFees:   []PoolTakerFee{},
+
+		CircuitBreakerMaxPriceChange: sdk.ZeroDec(),
+		CircuitBreakerWindow:         DefaultCircuitBreakerWindow,
 	}
 }
 
@@ -29,6 +44,11 @@ func NewParams(poolCreationFee sdk.Coins) Params {
 func DefaultParams() Params {
 	return Params{
 		PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		TakerFee:        sdk.ZeroDec(),
+		PoolTakerFees:   []PoolTakerFee{},
+
+		CircuitBreakerMaxPriceChange: sdk.ZeroDec(),
+		CircuitBreakerWindow:         DefaultCircuitBreakerWindow,
 	}
 }
 
@@ -37,6 +57,18 @@ func (p Params) Validate() error {
 	if err := validatePoolCreationFee(p.PoolCreationFee); err != nil {
 		return err
 	}
+	if err := validateTakerFee(p.TakerFee); err != nil {
+		return err
+	}
+	if err := validatePoolTakerFees(p.PoolTakerFees); err != nil {
+		return err
+	}
+	if err := validateCircuitBreakerMaxPriceChange(p.CircuitBreakerMaxPriceChange); err != nil {
+		return err
+	}
+	if err := validateCircuitBreakerWindow(p.CircuitBreakerWindow); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -45,6 +77,10 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyTakerFee, &p.TakerFee, validateTakerFee),
+		paramtypes.NewParamSetPair(KeyPoolTakerFees, &p.PoolTakerFees, validatePoolTakerFees),
+		paramtypes.NewParamSetPair(KeyCircuitBreakerMaxPriceChange, &p.CircuitBreakerMaxPriceChange, validateCircuitBreakerMaxPriceChange),
+		paramtypes.NewParamSetPair(KeyCircuitBreakerWindow, &p.CircuitBreakerWindow, validateCircuitBreakerWindow),
 	}
 }
 
@@ -60,3 +96,73 @@ func validatePoolCreationFee(i interface{}) error {
 
 	return nil
 }
+
+// GetTakerFee returns the taker fee of the pool with poolId, which is its override if it has one.
+func (p Params) GetTakerFee(poolId uint64) sdk.Dec {
+	for _, poolTakerFee := range p.PoolTakerFees {
+		if poolTakerFee.PoolId == poolId {
+			return poolTakerFee.TakerFee
+		}
+	}
+	return p.TakerFee
+}
+
+func validateTakerFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("taker fee must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validatePoolTakerFees(i interface{}) error {
+	v, ok := i.([]PoolTakerFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	poolIds := make(map[uint64]bool, len(v))
+	for _, poolTakerFee := range v {
+		if poolIds[poolTakerFee.PoolId] {
+			return fmt.Errorf("duplicate taker fee of pool %d", poolTakerFee.PoolId)
+		}
+		poolIds[poolTakerFee.PoolId] = true
+
+		if err := validateTakerFee(poolTakerFee.TakerFee); err != nil {
+			return fmt.Errorf("invalid taker fee of pool %d: %w", poolTakerFee.PoolId, err)
+		}
+	}
+
+	return nil
+}
+
+func validateCircuitBreakerMaxPriceChange(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("circuit breaker max price change must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateCircuitBreakerWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("circuit breaker window must be positive")
+	}
+
+	return nil
+}
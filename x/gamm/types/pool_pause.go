@@ -0,0 +1,12 @@
+package types
+
+// IsPaused returns whether any action is paused on the pool.
+func (p PoolPause) IsPaused() bool {
+	return p.SwapsPaused || p.JoinsPaused || p.ExitsPaused
+}
+
+// IsExpired returns whether the pause no longer applies at height,
+// which is only the case for pauses by the circuit breaker.
+func (p PoolPause) IsExpired(height int64) bool {
+	return p.PausedUntilHeight != 0 && height >= p.PausedUntilHeight
+}
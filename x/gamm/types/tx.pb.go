@@ -969,77 +969,106 @@ func init() {
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/tx.proto", fileDescriptor_cfc8fd3ac7df3247) }
 
 var fileDescriptor_cfc8fd3ac7df3247 = []byte{
-	// 1119 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4b, 0x6f, 0x1b, 0x45,
-	0x1c, 0xcf, 0xd8, 0x6e, 0x1e, 0x93, 0xe6, 0xb5, 0x4d, 0x1a, 0x67, 0xdb, 0xda, 0xe9, 0x80, 0x20,
-	0xa5, 0xea, 0x2e, 0x4d, 0x25, 0x8a, 0xb8, 0x00, 0x86, 0x20, 0x8c, 0xb0, 0x5c, 0x6d, 0x2f, 0x15,
-	0x17, 0x6b, 0x1d, 0xaf, 0xdc, 0x55, 0xb3, 0x33, 0x96, 0x67, 0x36, 0xb8, 0x42, 0x02, 0x89, 0xc7,
-	0x1d, 0x84, 0x78, 0x7c, 0x02, 0xc4, 0x57, 0xe0, 0x00, 0x07, 0xb8, 0xf4, 0xd8, 0x1b, 0x94, 0x83,
-	0x85, 0x92, 0x6f, 0xe0, 0x4f, 0x80, 0x76, 0x67, 0xf6, 0xe9, 0xdd, 0xd8, 0x9b, 0xd8, 0xc9, 0x29,
-	0xf1, 0xce, 0xff, 0xfd, 0xff, 0xcd, 0xef, 0xff, 0xdf, 0x85, 0x37, 0x08, 0xb5, 0x08, 0x35, 0xa9,
-	0xda, 0xd6, 0x2d, 0x4b, 0x3d, 0xbc, 0xdb, 0x34, 0x98, 0x7e, 0x57, 0x65, 0x3d, 0xa5, 0xd3, 0x25,
-	0x8c, 0x48, 0xeb, 0xe2, 0x58, 0x71, 0x8e, 0x15, 0x71, 0x2c, 0xaf, 0xb7, 0x49, 0x9b, 0xb8, 0x02,
-	0xaa, 0xf3, 0x1f, 0x97, 0x95, 0x4b, 0xfb, 0xae, 0xb0, 0xda, 0xd4, 0xa9, 0xe1, 0x5b, 0xda, 0x27,
-	0x26, 0xe6, 0xe7, 0xe8, 0xf7, 0x1c, 0x5c, 0xac, 0xd1, 0xf6, 0x47, 0xc4, 0xc4, 0x0f, 0x08, 0x39,
-	0x90, 0x6e, 0xc1, 0x59, 0x6a, 0xe0, 0x96, 0xd1, 0x2d, 0x82, 0x6d, 0xb0, 0xb3, 0x50, 0x59, 0x1b,
-	0xf4, 0xcb, 0x4b, 0x4f, 0x75, 0xeb, 0xe0, 0x2d, 0xc4, 0x9f, 0x23, 0x4d, 0x08, 0x48, 0xb7, 0xe1,
-	0x5c, 0x87, 0x90, 0x83, 0x86, 0xd9, 0x2a, 0xe6, 0xb6, 0xc1, 0x4e, 0xa1, 0x22, 0x0d, 0xfa, 0xe5,
-	0x65, 0x2e, 0x2b, 0x0e, 0x90, 0x36, 0xeb, 0xfc, 0x57, 0x6d, 0x49, 0x5d, 0xb8, 0x4a, 0x1f, 0xeb,
-	0x5d, 0xa3, 0x41, 0x6c, 0xd6, 0xd0, 0x2d, 0x62, 0x63, 0x56, 0xcc, 0xbb, 0x1e, 0x3e, 0x7c, 0xd6,
-	0x2f, 0xcf, 0xfc, 0xdb, 0x2f, 0xbf, 0xd2, 0x36, 0xd9, 0x63, 0xbb, 0xa9, 0xec, 0x13, 0x4b, 0x15,
-	0x41, 0xf3, 0x3f, 0x77, 0x68, 0xeb, 0x89, 0xca, 0x9e, 0x76, 0x0c, 0xaa, 0x54, 0x31, 0x1b, 0xf4,
-	0xcb, 0x57, 0x43, 0x3e, 0xb8, 0x29, 0xc7, 0x2a, 0xd2, 0x96, 0x5d, 0x0f, 0x75, 0x9b, 0xbd, 0xeb,
-	0x3e, 0x94, 0x9a, 0x70, 0x89, 0x91, 0x27, 0x06, 0x6e, 0x98, 0xb8, 0x61, 0xe9, 0x3d, 0x5a, 0x2c,
-	0x6c, 0xe7, 0x77, 0x16, 0x77, 0xb7, 0x14, 0x6e, 0x57, 0x71, 0x6a, 0xe2, 0x95, 0x4f, 0x79, 0x8f,
-	0x98, 0xb8, 0xf2, 0x92, 0x13, 0xcb, 0xa0, 0x5f, 0xbe, 0xc6, 0x3d, 0x84, 0xb5, 0x85, 0x27, 0x8a,
-	0xb4, 0x45, 0xf7, 0x71, 0x15, 0xd7, 0xf4, 0x1e, 0x45, 0x2f, 0x00, 0xbc, 0x12, 0xaa, 0x9f, 0x66,
-	0xd0, 0x0e, 0xc1, 0xd4, 0x90, 0x68, 0x42, 0xbe, 0xbc, 0xa2, 0xd5, 0xcc, 0xf9, 0x6e, 0x8a, 0xfa,
-	0xc7, 0xec, 0x0d, 0x27, 0x5c, 0x83, 0xf3, 0x5e, 0xc8, 0xc5, 0xdc, 0xa8, 0x5c, 0x37, 0x45, 0xae,
-	0x2b, 0xd1, 0x5c, 0x91, 0x36, 0x27, 0xf2, 0x43, 0x7f, 0x70, 0x6c, 0xec, 0xf5, 0x4c, 0x36, 0x55,
-	0x6c, 0x74, 0xe0, 0x0a, 0xcf, 0xcd, 0xc4, 0x13, 0x82, 0x46, 0xcc, 0x1c, 0xd2, 0x96, 0xdc, 0x27,
-	0x55, 0x2c, 0x0a, 0x65, 0xc0, 0x65, 0x9e, 0xaf, 0x53, 0x4d, 0xcb, 0xc4, 0x63, 0x40, 0xe3, 0x65,
-	0x51, 0xae, 0xeb, 0xe1, 0x72, 0x09, 0xf5, 0x00, 0x1b, 0x97, 0xdd, 0xe7, 0x75, 0x9b, 0xd5, 0x4c,
-	0x4c, 0x51, 0xdb, 0xc5, 0x86, 0x57, 0x3f, 0x1f, 0x1b, 0x0f, 0xe0, 0x82, 0xaf, 0x5e, 0x04, 0xa3,
-	0x1c, 0x17, 0x85, 0xe3, 0xd5, 0x98, 0x63, 0xa4, 0xcd, 0x7b, 0xce, 0xd0, 0xd7, 0x00, 0xae, 0x3d,
-	0xfc, 0x54, 0xef, 0xf0, 0xf4, 0xaa, 0x58, 0x23, 0x36, 0x33, 0xc2, 0x4d, 0x00, 0x23, 0x9b, 0x50,
-	0x81, 0x2b, 0x41, 0x4e, 0x2d, 0x03, 0x13, 0xcb, 0xed, 0xdc, 0x42, 0x45, 0x0e, 0xca, 0x1a, 0x13,
-	0x40, 0xda, 0x92, 0x17, 0xc1, 0xfb, 0xee, 0xef, 0xbf, 0x73, 0x70, 0xbd, 0x46, 0xdb, 0x4e, 0x24,
-	0x7b, 0x3d, 0x7d, 0x9f, 0x79, 0xe1, 0x64, 0x41, 0xce, 0x1e, 0x9c, 0xed, 0x3a, 0xd1, 0x53, 0x81,
-	0xe0, 0x57, 0x95, 0x24, 0xb6, 0x53, 0x86, 0xb2, 0xad, 0x14, 0x9c, 0x3a, 0x69, 0x42, 0x39, 0x72,
-	0x15, 0x1c, 0x30, 0x9d, 0xed, 0x2a, 0x48, 0x9f, 0xc3, 0xf5, 0xa4, 0x8e, 0x17, 0x0b, 0x6e, 0x3a,
-	0xb5, 0xcc, 0x38, 0xbd, 0x96, 0x8e, 0x22, 0xa4, 0xad, 0x85, 0x40, 0xc4, 0x73, 0x44, 0xdf, 0x03,
-	0x78, 0x3d, 0xa9, 0xb2, 0x61, 0xbe, 0x09, 0x8c, 0x4d, 0x86, 0x6f, 0xe2, 0xf6, 0x90, 0xb6, 0xec,
-	0x05, 0x26, 0xa2, 0xfa, 0x0a, 0x40, 0x29, 0x68, 0x44, 0xdd, 0x66, 0xa7, 0xc0, 0xdd, 0x3b, 0xde,
-	0x55, 0x34, 0xf1, 0xd8, 0xb0, 0xbb, 0x2c, 0xda, 0xc2, 0x51, 0xf7, 0x22, 0x07, 0x37, 0x86, 0x6b,
-	0x53, 0xb7, 0x59, 0x16, 0xd8, 0x7d, 0x10, 0x83, 0xdd, 0xce, 0x28, 0xd8, 0x79, 0xd9, 0xc6, 0x70,
-	0xf7, 0x19, 0xbc, 0x92, 0x30, 0x35, 0x04, 0x9f, 0x7d, 0x9c, 0xb9, 0x15, 0x72, 0xea, 0x20, 0x42,
-	0xda, 0x6a, 0x30, 0x87, 0x04, 0xad, 0x45, 0x88, 0xa5, 0x30, 0x0a, 0xf5, 0xe3, 0x10, 0xcb, 0x77,
-	0x00, 0xde, 0x48, 0xac, 0xad, 0x0f, 0xbc, 0x8e, 0xc7, 0x1b, 0xc1, 0xa5, 0x00, 0x67, 0x23, 0xef,
-	0x98, 0x39, 0x8f, 0x65, 0x3c, 0xf2, 0x46, 0x7f, 0xe6, 0xe0, 0x96, 0x18, 0xb9, 0x3c, 0x2e, 0x66,
-	0x74, 0xf1, 0x69, 0xa8, 0x26, 0xd3, 0x90, 0x9a, 0x3c, 0xa1, 0x04, 0xf3, 0x7c, 0x72, 0x84, 0x92,
-	0x64, 0x13, 0x69, 0x6b, 0xde, 0x9e, 0x10, 0x10, 0xca, 0xcf, 0x00, 0xde, 0x4c, 0x2d, 0xe2, 0x85,
-	0x6e, 0x31, 0xe8, 0x97, 0x7c, 0xa4, 0xbf, 0x0f, 0x9d, 0xd3, 0x53, 0xdd, 0xe9, 0x4c, 0xfd, 0x7d,
-	0x7b, 0x88, 0x87, 0xf8, 0x9d, 0xdd, 0x1a, 0xf4, 0xcb, 0x1b, 0x31, 0x60, 0x26, 0xd1, 0x50, 0x62,
-	0xad, 0x0a, 0xd3, 0xde, 0xf8, 0x52, 0xe8, 0xe6, 0xd2, 0x79, 0xd0, 0x0d, 0xfa, 0x21, 0x8a, 0xa1,
-	0x68, 0xa3, 0x2e, 0x90, 0x20, 0x7e, 0xcd, 0xc3, 0xa2, 0xd8, 0xbb, 0x62, 0x71, 0x4d, 0x91, 0x1f,
-	0x12, 0xf6, 0xa7, 0x7c, 0xc6, 0xfd, 0x29, 0x69, 0x11, 0x2e, 0x4c, 0x77, 0x11, 0x4e, 0xdb, 0x6b,
-	0x2e, 0x9d, 0xd3, 0x5e, 0xf3, 0x13, 0x80, 0xdb, 0x69, 0xad, 0xba, 0xd8, 0xdd, 0xe6, 0xaf, 0x1c,
-	0x94, 0x43, 0x91, 0x85, 0x09, 0x72, 0x9a, 0x34, 0x14, 0x19, 0xe1, 0xf9, 0x09, 0x8c, 0x70, 0x87,
-	0x22, 0x7c, 0x14, 0x84, 0x28, 0xa2, 0x70, 0x36, 0x8a, 0x48, 0x30, 0x89, 0xb4, 0x55, 0x01, 0xae,
-	0x80, 0x22, 0x7e, 0x04, 0x10, 0xa5, 0x57, 0x31, 0xcc, 0x11, 0x71, 0xe0, 0x83, 0xa9, 0x02, 0x7f,
-	0xf7, 0xb7, 0x39, 0x98, 0xaf, 0xd1, 0xb6, 0xf4, 0x08, 0xce, 0xfb, 0xdf, 0x3e, 0x6e, 0x26, 0xef,
-	0x7c, 0xa1, 0xd7, 0x7b, 0xf9, 0xd6, 0x48, 0x11, 0x3f, 0xa7, 0x47, 0x70, 0xde, 0x7f, 0x73, 0x4e,
-	0xb7, 0xec, 0x89, 0x9c, 0x60, 0x79, 0xe8, 0xfd, 0x91, 0xf2, 0x97, 0xbd, 0xe8, 0x2b, 0xd6, 0x6b,
-	0xa9, 0xfa, 0x43, 0xb2, 0xf2, 0xee, 0xf8, 0xb2, 0xbe, 0xd3, 0x43, 0xbe, 0xea, 0xc7, 0x36, 0xec,
-	0xdb, 0xe3, 0x5a, 0xaa, 0xdb, 0x4c, 0xbe, 0x97, 0x41, 0xd8, 0xf7, 0xfb, 0x25, 0x80, 0x57, 0x53,
-	0x56, 0x3d, 0xf5, 0xc4, 0x66, 0x0c, 0x2b, 0xc8, 0xf7, 0x33, 0x2a, 0x24, 0x06, 0x11, 0xdb, 0x47,
-	0x46, 0x07, 0x11, 0x55, 0x18, 0x23, 0x88, 0x94, 0x41, 0xfa, 0x0d, 0x80, 0x9b, 0x69, 0x74, 0xf4,
-	0xfa, 0x89, 0xe8, 0x49, 0xd0, 0x90, 0xdf, 0xcc, 0xaa, 0xe1, 0xc7, 0xf1, 0x05, 0xdc, 0x48, 0x1e,
-	0xad, 0xca, 0x48, 0x93, 0x11, 0x79, 0xf9, 0x8d, 0x6c, 0xf2, 0x5e, 0x00, 0x95, 0xea, 0xb3, 0xa3,
-	0x12, 0x78, 0x7e, 0x54, 0x02, 0xff, 0x1d, 0x95, 0xc0, 0xb7, 0xc7, 0xa5, 0x99, 0xe7, 0xc7, 0xa5,
-	0x99, 0x7f, 0x8e, 0x4b, 0x33, 0x9f, 0xa8, 0x21, 0x9a, 0x10, 0xb6, 0xef, 0x1c, 0xe8, 0x4d, 0xea,
-	0xfd, 0x50, 0x0f, 0xef, 0xab, 0x3d, 0xfe, 0x55, 0xd5, 0xe5, 0x8c, 0xe6, 0xac, 0xfb, 0x15, 0xf4,
-	0xde, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x67, 0xe6, 0x5e, 0x56, 0x72, 0x15, 0x00, 0x00,
+	// 1126 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcb, 0x6f, 0x1b, 0xc5,
+	0x1f, 0xcf, 0xd8, 0x6e, 0x1e, 0x93, 0xe6, 0xe1, 0x4d, 0xd2, 0x6c, 0xb6, 0xad, 0xed, 0xce, 0xef,
+	0x27, 0x48, 0xa8, 0xba, 0x4b, 0x53, 0x89, 0xa0, 0x5e, 0x00, 0x43, 0x10, 0x46, 0x58, 0xae, 0xb6,
+	0x97, 0x8a, 0x8b, 0xb5, 0x8e, 0x57, 0xee, 0xaa, 0xd9, 0x19, 0xcb, 0x33, 0x1b, 0x5c, 0x21, 0x81,
+	0x04, 0xe2, 0xc2, 0x09, 0x84, 0x78, 0x1c, 0x39, 0x21, 0xfe, 0x05, 0x0e, 0x70, 0x80, 0x4b, 0x8f,
+	0xbd, 0x41, 0x39, 0x58, 0x28, 0xb9, 0x70, 0xf6, 0x5f, 0x80, 0x76, 0x77, 0xf6, 0xe9, 0xdd, 0x3a,
+	0x9b, 0xd8, 0xcd, 0xc9, 0xde, 0x99, 0xef, 0xfb, 0xfb, 0x99, 0xcf, 0x7c, 0x77, 0xe1, 0x75, 0x42,
+	0x4d, 0x42, 0x0d, 0xaa, 0x74, 0x34, 0xd3, 0x54, 0x8e, 0x6e, 0xb7, 0x74, 0xa6, 0xdd, 0x56, 0x58,
+	0x5f, 0xee, 0xf6, 0x08, 0x23, 0xc2, 0x3a, 0xdf, 0x96, 0xed, 0x6d, 0x99, 0x6f, 0x4b, 0xeb, 0x1d,
+	0xd2, 0x21, 0x8e, 0x80, 0x62, 0xff, 0x73, 0x65, 0xa5, 0xd2, 0x81, 0x23, 0xac, 0xb4, 0x34, 0xaa,
+	0xfb, 0x96, 0x0e, 0x88, 0x81, 0xdd, 0x7d, 0xf4, 0x6b, 0x0e, 0x2e, 0xd6, 0x69, 0xe7, 0x7d, 0x62,
+	0xe0, 0x7b, 0x84, 0x1c, 0x0a, 0x3b, 0x70, 0x96, 0xea, 0xb8, 0xad, 0xf7, 0x44, 0x50, 0x01, 0xdb,
+	0x0b, 0xd5, 0xe2, 0x70, 0x50, 0x5e, 0x7a, 0xac, 0x99, 0x87, 0x77, 0x91, 0xbb, 0x8e, 0x54, 0x2e,
+	0x20, 0xdc, 0x84, 0x73, 0x5d, 0x42, 0x0e, 0x9b, 0x46, 0x5b, 0xcc, 0x55, 0xc0, 0x76, 0xa1, 0x2a,
+	0x0c, 0x07, 0xe5, 0x65, 0x57, 0x96, 0x6f, 0x20, 0x75, 0xd6, 0xfe, 0x57, 0x6b, 0x0b, 0x3d, 0xb8,
+	0x4a, 0x1f, 0x6a, 0x3d, 0xbd, 0x49, 0x2c, 0xd6, 0xd4, 0x4c, 0x62, 0x61, 0x26, 0xe6, 0x1d, 0x0f,
+	0xef, 0x3d, 0x19, 0x94, 0x67, 0xfe, 0x1e, 0x94, 0x5f, 0xea, 0x18, 0xec, 0xa1, 0xd5, 0x92, 0x0f,
+	0x88, 0xa9, 0xf0, 0xa0, 0xdd, 0x9f, 0x5b, 0xb4, 0xfd, 0x48, 0x61, 0x8f, 0xbb, 0x3a, 0x95, 0x6b,
+	0x98, 0x0d, 0x07, 0xe5, 0x2b, 0x21, 0x1f, 0xae, 0x29, 0xdb, 0x2a, 0x52, 0x97, 0x1d, 0x0f, 0x0d,
+	0x8b, 0xbd, 0xe5, 0x2c, 0x0a, 0x2d, 0xb8, 0xc4, 0xc8, 0x23, 0x1d, 0x37, 0x0d, 0xdc, 0x34, 0xb5,
+	0x3e, 0x15, 0x0b, 0x95, 0xfc, 0xf6, 0xe2, 0xee, 0x96, 0xec, 0xda, 0x95, 0xed, 0x9a, 0x78, 0xe5,
+	0x93, 0xdf, 0x26, 0x06, 0xae, 0xfe, 0xcf, 0x8e, 0x65, 0x38, 0x28, 0x5f, 0x75, 0x3d, 0x84, 0xb5,
+	0xb9, 0x27, 0x8a, 0xd4, 0x45, 0x67, 0xb9, 0x86, 0xeb, 0x5a, 0x9f, 0xa2, 0x67, 0x00, 0xae, 0x85,
+	0xea, 0xa7, 0xea, 0xb4, 0x4b, 0x30, 0xd5, 0x05, 0x9a, 0x90, 0xaf, 0x5b, 0xd1, 0x5a, 0xe6, 0x7c,
+	0x37, 0x79, 0xfd, 0x63, 0xf6, 0x46, 0x13, 0xae, 0xc3, 0x79, 0x2f, 0x64, 0x31, 0x37, 0x2e, 0xd7,
+	0x4d, 0x9e, 0xeb, 0x4a, 0x34, 0x57, 0xa4, 0xce, 0xf1, 0xfc, 0xd0, 0x6f, 0x2e, 0x36, 0xf6, 0xfb,
+	0x06, 0x9b, 0x2a, 0x36, 0xba, 0x70, 0xc5, 0xcd, 0xcd, 0xc0, 0x13, 0x82, 0x46, 0xcc, 0x1c, 0x52,
+	0x97, 0x9c, 0x95, 0x1a, 0xe6, 0x85, 0xd2, 0xe1, 0xb2, 0x9b, 0xaf, 0x5d, 0x4d, 0xd3, 0xc0, 0xa7,
+	0x80, 0xc6, 0xff, 0x79, 0xb9, 0xae, 0x85, 0xcb, 0xc5, 0xd5, 0x03, 0x6c, 0x5c, 0x76, 0xd6, 0x1b,
+	0x16, 0xab, 0x1b, 0x98, 0xa2, 0x0e, 0x5c, 0x0b, 0xd5, 0xcf, 0xc7, 0xc6, 0x3d, 0xb8, 0xe0, 0xab,
+	0x8b, 0x60, 0x9c, 0x63, 0x91, 0x3b, 0x5e, 0x8d, 0x39, 0x46, 0xea, 0xbc, 0xe7, 0x0c, 0x7d, 0x09,
+	0x60, 0xf1, 0xfe, 0x47, 0x5a, 0xd7, 0x4d, 0xaf, 0x86, 0x55, 0x62, 0x31, 0x3d, 0xdc, 0x04, 0x30,
+	0xb6, 0x09, 0x55, 0xb8, 0x12, 0xe4, 0xd4, 0xd6, 0x31, 0x31, 0x9d, 0xce, 0x2d, 0x54, 0xa5, 0xa0,
+	0xac, 0x31, 0x01, 0xa4, 0x2e, 0x79, 0x11, 0xbc, 0x63, 0x3f, 0xdf, 0x2d, 0xfc, 0xfb, 0x63, 0x19,
+	0xa0, 0x3f, 0x73, 0x70, 0xbd, 0x4e, 0x3b, 0x76, 0x3c, 0xfb, 0x7d, 0xed, 0x80, 0x79, 0x41, 0x65,
+	0xc1, 0xcf, 0x3e, 0x9c, 0xed, 0xd9, 0x39, 0x50, 0x8e, 0xe3, 0x97, 0xe5, 0x24, 0xce, 0x93, 0x47,
+	0x72, 0xae, 0x16, 0xec, 0x6a, 0xa9, 0x5c, 0x39, 0x72, 0x20, 0x6c, 0x48, 0x9d, 0xef, 0x40, 0x08,
+	0x9f, 0xc0, 0xf5, 0xa4, 0xbe, 0x8b, 0x05, 0x27, 0x9d, 0x7a, 0x66, 0xb4, 0x5e, 0x4d, 0xc7, 0x12,
+	0x52, 0x8b, 0x21, 0x28, 0xb9, 0x39, 0xa2, 0x6f, 0x00, 0xbc, 0x96, 0x54, 0xd9, 0x30, 0xeb, 0x04,
+	0xc6, 0x26, 0xc3, 0x3a, 0x71, 0x7b, 0x48, 0x5d, 0xf6, 0x02, 0xe3, 0x51, 0x7d, 0x0e, 0xa0, 0x10,
+	0x34, 0xa2, 0x61, 0xb1, 0x33, 0xa0, 0xef, 0x4d, 0xef, 0x40, 0x1a, 0xf8, 0xd4, 0xe0, 0xbb, 0xcc,
+	0xdb, 0xe2, 0x60, 0x0f, 0x3d, 0xcb, 0xc1, 0x8d, 0xd1, 0xda, 0x34, 0x2c, 0x96, 0x05, 0x76, 0xef,
+	0xc6, 0x60, 0xb7, 0x3d, 0x0e, 0x76, 0x5e, 0xb6, 0x31, 0xdc, 0x7d, 0x0c, 0xd7, 0x12, 0xee, 0x0e,
+	0xce, 0x6a, 0x1f, 0x64, 0x6e, 0x85, 0x94, 0x7a, 0x1d, 0x21, 0x75, 0x35, 0xb8, 0x8d, 0x38, 0xb9,
+	0x45, 0xe8, 0xa5, 0x50, 0x01, 0xe7, 0xa7, 0x97, 0xaf, 0x01, 0xbc, 0x9e, 0x58, 0x5b, 0x1f, 0x78,
+	0x5d, 0x8f, 0x3d, 0x82, 0x43, 0x01, 0xce, 0x47, 0xe1, 0x31, 0x73, 0x1e, 0xd7, 0x78, 0x14, 0x8e,
+	0x7e, 0xcf, 0xc1, 0x2d, 0x7e, 0xf1, 0xba, 0x71, 0x31, 0xbd, 0x87, 0xcf, 0x42, 0x35, 0x99, 0xae,
+	0xaa, 0xc9, 0x13, 0x4a, 0x70, 0xab, 0x4f, 0x8e, 0x50, 0x92, 0x6c, 0x22, 0xb5, 0xe8, 0x4d, 0x0b,
+	0x01, 0xa1, 0xfc, 0x00, 0xe0, 0x8d, 0xd4, 0x22, 0x5e, 0xe8, 0x2c, 0x83, 0x7e, 0xca, 0x47, 0xfa,
+	0x7b, 0xdf, 0xde, 0x3d, 0xd3, 0x99, 0xce, 0xd4, 0xdf, 0x37, 0x46, 0x78, 0xc8, 0x3d, 0xb3, 0x5b,
+	0xc3, 0x41, 0x79, 0x23, 0x06, 0xcc, 0x24, 0x1a, 0x4a, 0xac, 0x55, 0x61, 0xda, 0x73, 0x5f, 0x0a,
+	0xdd, 0x5c, 0x7a, 0x11, 0x74, 0x83, 0xbe, 0x8d, 0x62, 0x28, 0xda, 0xa8, 0x0b, 0x24, 0x88, 0x9f,
+	0xf3, 0x50, 0xe4, 0xd3, 0x57, 0x2c, 0xae, 0x29, 0xf2, 0x43, 0xc2, 0x14, 0x95, 0xcf, 0x38, 0x45,
+	0x25, 0x8d, 0xc3, 0x85, 0xe9, 0x8e, 0xc3, 0x69, 0x73, 0xcd, 0xa5, 0x17, 0x34, 0xd7, 0x7c, 0x0f,
+	0x60, 0x25, 0xad, 0x55, 0x17, 0x3b, 0xdb, 0xfc, 0x91, 0x83, 0x52, 0x28, 0xb2, 0x30, 0x41, 0x4e,
+	0x93, 0x86, 0x22, 0x57, 0x78, 0x7e, 0x02, 0x57, 0xb8, 0x4d, 0x11, 0x3e, 0x0a, 0x42, 0x14, 0x51,
+	0x38, 0x1f, 0x45, 0x24, 0x98, 0x44, 0xea, 0x2a, 0x07, 0x57, 0x40, 0x11, 0xdf, 0x01, 0x88, 0xd2,
+	0xab, 0x18, 0xe6, 0x88, 0x38, 0xf0, 0xc1, 0x54, 0x81, 0xbf, 0xfb, 0xcb, 0x1c, 0xcc, 0xd7, 0x69,
+	0x47, 0x78, 0x00, 0xe7, 0xfd, 0x2f, 0x20, 0x37, 0x92, 0x67, 0xbe, 0xd0, 0x4b, 0xbe, 0xb4, 0x33,
+	0x56, 0xc4, 0xcf, 0xe9, 0x01, 0x9c, 0xf7, 0xdf, 0x9f, 0xd3, 0x2d, 0x7b, 0x22, 0xd2, 0xce, 0x58,
+	0x91, 0xd0, 0x79, 0x28, 0x8e, 0xbe, 0x62, 0xbd, 0x92, 0xaa, 0x3f, 0x22, 0x2b, 0xed, 0x9e, 0x5e,
+	0xd6, 0x77, 0x7a, 0x04, 0x85, 0xd8, 0xa6, 0x0d, 0xae, 0x9b, 0xa7, 0xb5, 0xd4, 0xb0, 0x98, 0x74,
+	0x27, 0x83, 0xb0, 0xef, 0xf7, 0x33, 0x00, 0xaf, 0xa4, 0x8c, 0x7a, 0xca, 0x73, 0x9b, 0x31, 0xaa,
+	0x20, 0xed, 0x65, 0x54, 0x48, 0x0c, 0x22, 0x36, 0x8f, 0x8c, 0x0f, 0x22, 0xaa, 0x20, 0xed, 0x65,
+	0x54, 0xf0, 0x83, 0xf8, 0x02, 0xc0, 0xcd, 0x34, 0x3a, 0x7a, 0xf5, 0xb9, 0xe8, 0x49, 0xd0, 0x90,
+	0x5e, 0xcf, 0xaa, 0xe1, 0xc7, 0xf1, 0x29, 0xdc, 0x48, 0xbe, 0x5a, 0xe5, 0xb1, 0x26, 0x23, 0xf2,
+	0xd2, 0x6b, 0xd9, 0xe4, 0xbd, 0x00, 0xaa, 0xb5, 0x27, 0xc7, 0x25, 0xf0, 0xf4, 0xb8, 0x04, 0xfe,
+	0x39, 0x2e, 0x81, 0xaf, 0x4e, 0x4a, 0x33, 0x4f, 0x4f, 0x4a, 0x33, 0x7f, 0x9d, 0x94, 0x66, 0x3e,
+	0x54, 0x42, 0x34, 0xc1, 0x6d, 0xdf, 0x3a, 0xd4, 0x5a, 0xd4, 0x7b, 0x50, 0x8e, 0xf6, 0x94, 0xbe,
+	0xfb, 0x6d, 0xd5, 0xe1, 0x8c, 0xd6, 0xac, 0xf3, 0x2d, 0xf4, 0xce, 0x7f, 0x03, 0x00, 0x82, 0x90,
+	0xf0, 0x58, 0x78, 0x15, 0x00, 0x00,
+}
+
+func (this *SwapAmountInRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SwapAmountInRoute)
+	if !ok {
+		that2, ok := that.(SwapAmountInRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.TokenOutDenom != that1.TokenOutDenom {
+		return false
+	}
+	return true
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
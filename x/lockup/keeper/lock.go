@@ -173,6 +173,39 @@ func (k Keeper) BeginForceUnlock(ctx sdk.Context, lockID uint64, coins sdk.Coins
 	return k.beginUnlock(ctx, *lock, coins)
 }
 
+// SplitLock splits coins off the lock with lockID into a new lock with the same owner, duration and end time,
+// and returns the new lock. The new lock gets a copy of every synthetic lockup of the lock, so that both
+// keep what the synthetic lockups stand for, such as superfluid staking and its slashing liability.
+// This method should be called by the superfluid module ONLY.
+func (k Keeper) SplitLock(ctx sdk.Context, lockID uint64, coins sdk.Coins) (types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	if coins.Empty() || !coins.IsAllLTE(lock.Coins) || coins.IsEqual(lock.Coins) {
+		return types.PeriodLock{}, fmt.Errorf("requested amount to split must be positive and less than locked tokens %s, got %s", lock.Coins, coins)
+	}
+
+	splitLock, err := k.splitLock(ctx, *lock, coins)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	err = k.addLockRefs(ctx, splitLock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	// The accumulation stores of the synthetic denoms count the tokens of both locks, as they did before the split.
+	for _, synthLock := range k.GetAllSyntheticLockupsByLockup(ctx, lockID) {
+		synthLock.UnderlyingLockId = splitLock.ID
+		err = k.setSyntheticLockAndResetRefs(ctx, splitLock, synthLock)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+	}
+	return splitLock, nil
+}
+
 // beginUnlock unlocks specified tokens from the given lock. Existing lock refs
 // of not unlocking queue are deleted and new lock refs are then added.
 // EndTime of the lock is set within this method.
@@ -699,6 +699,81 @@ func (suite *KeeperTestSuite) TestSlashTokensFromLockByID() {
 	suite.Require().Error(err)
 }
 
+func (suite *KeeperTestSuite) TestSplitLock() {
+	suite.SetupTest()
+
+	// lock coins, and create synthetic lockups of the lock
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr, coins, time.Second)
+	err := suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, 1, "synthstakestakedtovalidator", time.Second, false)
+	suite.Require().NoError(err)
+	err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, 1, "synthstakeunstakingfromvalidator", time.Second, true)
+	suite.Require().NoError(err)
+
+	// invalid amounts to split
+	for _, invalidCoins := range []sdk.Coins{
+		{},
+		{sdk.NewInt64Coin("stake", 10)},
+		{sdk.NewInt64Coin("stake", 11)},
+		{sdk.NewInt64Coin("stake1", 1)},
+	} {
+		_, err = suite.App.LockupKeeper.SplitLock(suite.Ctx, 1, invalidCoins)
+		suite.Require().Error(err, invalidCoins)
+	}
+
+	splitLock, err := suite.App.LockupKeeper.SplitLock(suite.Ctx, 1, sdk.Coins{sdk.NewInt64Coin("stake", 3)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), splitLock.ID)
+	suite.Require().Equal(addr.String(), splitLock.Owner)
+	suite.Require().Equal(time.Second, splitLock.Duration)
+	suite.Require().Equal("3stake", splitLock.Coins.String())
+
+	lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal("7stake", lock.Coins.String())
+
+	// the split lock is found by its refs
+	locks := suite.App.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.Ctx, addr, "stake", time.Second)
+	suite.Require().Len(locks, 2)
+
+	// the split lock has a copy of every synthetic lockup of the lock
+	synthLocks := suite.App.LockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, 2)
+	suite.Require().Len(synthLocks, 2)
+	for _, synthLock := range suite.App.LockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, 1) {
+		splitSynthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, 2, synthLock.SynthDenom)
+		suite.Require().NoError(err)
+		suite.Require().Equal(synthLock.EndTime, splitSynthLock.EndTime)
+		suite.Require().Equal(synthLock.Duration, splitSynthLock.Duration)
+	}
+
+	// accumulations are unchanged
+	for _, denom := range []string{"stake", "synthstakestakedtovalidator", "synthstakeunstakingfromvalidator"} {
+		acc := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+			Denom:    denom,
+			Duration: time.Second,
+		})
+		suite.Require().Equal(int64(10), acc.Int64(), denom)
+	}
+
+	// deleting the synthetic lockups of both locks empties their accumulations
+	for _, lockID := range []uint64{1, 2} {
+		err = suite.App.LockupKeeper.DeleteSyntheticLockup(suite.Ctx, lockID, "synthstakestakedtovalidator")
+		suite.Require().NoError(err)
+	}
+	acc := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+		Denom:    "synthstakestakedtovalidator",
+		Duration: time.Second,
+	})
+	suite.Require().Equal(int64(0), acc.Int64())
+
+	// unlocking locks can't be split
+	err = suite.App.LockupKeeper.BeginForceUnlock(suite.Ctx, 2, sdk.Coins{})
+	suite.Require().NoError(err)
+	_, err = suite.App.LockupKeeper.SplitLock(suite.Ctx, 2, sdk.Coins{sdk.NewInt64Coin("stake", 1)})
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestEditLockup() {
 	suite.SetupTest()
 
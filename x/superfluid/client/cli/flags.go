@@ -1,6 +1,25 @@
 package cli
 
+import (
+	flag "github.com/spf13/pflag"
+)
+
 // Proposal flags.
 const (
-	FlagSuperfluidAssets = "superfluid-assets"
+	FlagSuperfluidAssets  = "superfluid-assets"
+	FlagRiskFactors       = "risk-factors"
+	FlagMaxSuperfluidOsmo = "max-superfluid-osmo"
 )
+
+// Tx flags.
+const (
+	FlagAmount = "amount"
+)
+
+// FlagSetAmount returns flags for msgs that act on part of a lock.
+func FlagSetAmount() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagAmount, "", "The amount of the lock to act on, split off into a new lock, e.g. 1gamm/pool/1. Defaults to the whole lock")
+	return fs
+}
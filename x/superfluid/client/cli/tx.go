@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -30,10 +31,11 @@ func GetTxCmd() *cobra.Command {
 		NewSuperfluidDelegateCmd(),
 		NewSuperfluidUndelegateCmd(),
 		NewSuperfluidUnbondLockCmd(),
-		// NewSuperfluidRedelegateCmd(),
+		NewSuperfluidRedelegateCmd(),
 		NewCmdSubmitSetSuperfluidAssetsProposal(),
 		NewCmdSubmitRemoveSuperfluidAssetsProposal(),
 		NewCmdLockAndSuperfluidDelegate(),
+		NewCmdMergeLocksAndSuperfluidDelegate(),
 		NewCmdUnPoolWhitelistedPool(),
 	)
 
@@ -97,15 +99,30 @@ func NewSuperfluidUndelegateCmd() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgSuperfluidUndelegate(
+			coins := sdk.Coins(nil)
+			amountStr, err := cmd.Flags().GetString(FlagAmount)
+			if err != nil {
+				return err
+			}
+
+			if amountStr != "" {
+				coins, err = sdk.ParseCoinsNormalized(amountStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgPartialSuperfluidUndelegate(
 				clientCtx.GetFromAddress(),
 				uint64(lockId),
+				coins,
 			)
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().AddFlagSet(FlagSetAmount())
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -129,56 +146,71 @@ func NewSuperfluidUnbondLockCmd() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgSuperfluidUnbondLock(
+			coins := sdk.Coins(nil)
+			amountStr, err := cmd.Flags().GetString(FlagAmount)
+			if err != nil {
+				return err
+			}
+
+			if amountStr != "" {
+				coins, err = sdk.ParseCoinsNormalized(amountStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgPartialSuperfluidUnbondLock(
 				clientCtx.GetFromAddress(),
 				uint64(lockId),
+				coins,
 			)
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().AddFlagSet(FlagSetAmount())
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
-// NewSuperfluidRedelegateCmd broadcast MsgSuperfluidRedelegate
-// func NewSuperfluidRedelegateCmd() *cobra.Command {
-// 	cmd := &cobra.Command{
-// 		Use:   "redelegate [lock_id] [val_addr] [flags]",
-// 		Short: "superfluid redelegate a lock to a new validator",
-// 		Args:  cobra.ExactArgs(2),
-// 		RunE: func(cmd *cobra.Command, args []string) error {
-// 			clientCtx, err := client.GetClientTxContext(cmd)
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
-
-// 			lockId, err := strconv.Atoi(args[0])
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			valAddr, err := sdk.ValAddressFromBech32(args[1])
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			msg := types.NewMsgSuperfluidRedelegate(
-// 				clientCtx.GetFromAddress(),
-// 				uint64(lockId),
-// 				valAddr,
-// 			)
-
-// 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
-// 		},
-// 	}
-
-// 	flags.AddTxFlagsToCmd(cmd)
-// 	return cmd
-// }
+// NewSuperfluidRedelegateCmd broadcast MsgSuperfluidRedelegate.
+func NewSuperfluidRedelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegate [lock_id] [val_addr] [flags]",
+		Short: "superfluid redelegate a lock to a new validator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			lockId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSuperfluidRedelegate(
+				clientCtx.GetFromAddress(),
+				uint64(lockId),
+				valAddr,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
 
 // NewCmdSubmitSetSuperfluidAssetsProposal implements a command handler for submitting a superfluid asset set proposal transaction.
 func NewCmdSubmitSetSuperfluidAssetsProposal() *cobra.Command {
@@ -225,6 +257,8 @@ func NewCmdSubmitSetSuperfluidAssetsProposal() *cobra.Command {
 	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(FlagSuperfluidAssets, "", "The superfluid asset array")
+	cmd.Flags().String(FlagRiskFactors, "", "The risk factor array of the superfluid assets, empty for the minimum risk factor param")
+	cmd.Flags().String(FlagMaxSuperfluidOsmo, "", "The max superfluid OSMO array of the superfluid assets, empty for no cap")
 
 	return cmd
 }
@@ -296,12 +330,45 @@ func parseSetSuperfluidAssetsArgsToContent(cmd *cobra.Command) (govtypes.Content
 
 	assets := strings.Split(assetsStr, ",")
 
+	riskFactorsStr, err := cmd.Flags().GetString(FlagRiskFactors)
+	if err != nil {
+		return nil, err
+	}
+	riskFactors, err := splitAssetParams(riskFactorsStr, len(assets))
+	if err != nil {
+		return nil, err
+	}
+
+	maxSuperfluidOsmoStr, err := cmd.Flags().GetString(FlagMaxSuperfluidOsmo)
+	if err != nil {
+		return nil, err
+	}
+	maxSuperfluidOsmos, err := splitAssetParams(maxSuperfluidOsmoStr, len(assets))
+	if err != nil {
+		return nil, err
+	}
+
 	superfluidAssets := []types.SuperfluidAsset{}
-	for _, asset := range assets {
-		superfluidAssets = append(superfluidAssets, types.SuperfluidAsset{
+	for i, asset := range assets {
+		superfluidAsset := types.SuperfluidAsset{
 			Denom:     asset,
 			AssetType: types.SuperfluidAssetTypeLPShare,
-		})
+		}
+		if riskFactors[i] != "" {
+			riskFactor, err := sdk.NewDecFromStr(riskFactors[i])
+			if err != nil {
+				return nil, err
+			}
+			superfluidAsset.RiskFactor = &riskFactor
+		}
+		if maxSuperfluidOsmos[i] != "" {
+			maxSuperfluidOsmo, ok := sdk.NewIntFromString(maxSuperfluidOsmos[i])
+			if !ok {
+				return nil, fmt.Errorf("invalid max superfluid osmo %s", maxSuperfluidOsmos[i])
+			}
+			superfluidAsset.MaxSuperfluidOsmo = &maxSuperfluidOsmo
+		}
+		superfluidAssets = append(superfluidAssets, superfluidAsset)
 	}
 
 	content := &types.SetSuperfluidAssetsProposal{
@@ -312,6 +379,20 @@ func parseSetSuperfluidAssetsArgsToContent(cmd *cobra.Command) (govtypes.Content
 	return content, nil
 }
 
+// splitAssetParams splits a comma separated array of a param of each of numAssets superfluid assets,
+// where an empty array or element leaves the param unset.
+func splitAssetParams(paramsStr string, numAssets int) ([]string, error) {
+	if paramsStr == "" {
+		return make([]string, numAssets), nil
+	}
+
+	params := strings.Split(paramsStr, ",")
+	if len(params) != numAssets {
+		return nil, fmt.Errorf("got %d params for %d superfluid assets", len(params), numAssets)
+	}
+	return params, nil
+}
+
 func parseRemoveSuperfluidAssetsArgsToContent(cmd *cobra.Command) (govtypes.Content, error) {
 	title, err := cmd.Flags().GetString(govcli.FlagTitle)
 	if err != nil {
@@ -374,6 +455,48 @@ func NewCmdLockAndSuperfluidDelegate() *cobra.Command {
 	return cmd
 }
 
+// NewCmdMergeLocksAndSuperfluidDelegate broadcast MsgMergeLocksAndSuperfluidDelegate.
+func NewCmdMergeLocksAndSuperfluidDelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge-locks-and-superfluid-delegate [lock_ids] [val_addr] [flags]",
+		Short: "merge locks into the first of them and superfluid delegate it",
+		Example: fmt.Sprintf(`$ %s tx superfluid merge-locks-and-superfluid-delegate 1,2,3 osmovaloper1... --from=mykey`,
+			version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			sender := clientCtx.GetFromAddress()
+
+			lockIds := []uint64{}
+			for _, lockIdStr := range strings.Split(args[0], ",") {
+				lockId, err := strconv.ParseUint(strings.TrimSpace(lockIdStr), 10, 64)
+				if err != nil {
+					return err
+				}
+				lockIds = append(lockIds, lockId)
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMergeLocksAndSuperfluidDelegate(sender, lockIds, valAddr)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewCmdUnPoolWhitelistedPool implements a command handler for unpooling whitelisted pools.
 func NewCmdUnPoolWhitelistedPool() *cobra.Command {
 	cmd := &cobra.Command{
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -118,6 +119,25 @@ func (k Keeper) UpdateOsmoEquivalentMultipliers(ctx sdk.Context, asset types.Sup
 		}
 
 		multiplier := k.calculateOsmoBackingPerShare(pool, osmoPoolAsset)
+		if k.GetParams(ctx).OsmoEquivalentMultiplierMethod == types.OsmoEquivalentMultiplierMethodTwap {
+			// The twap covers the epoch that just ended.
+			epochDuration := k.ek.GetEpochInfo(ctx, k.GetEpochIdentifier(ctx)).Duration
+			twapMultiplier, err := k.calculateTwapOsmoBackingPerShare(ctx, pool, bondDenom, ctx.BlockTime().Add(-epochDuration))
+			if err != nil {
+				// The twap is not available for pools created within the last epoch,
+				// or whose spot price errored within it. Falling back to the spot osmo backing
+				// would let it be manipulated, so these keep the multiplier of the previous epoch.
+				// Assets that don't have one yet start at the spot osmo backing, as otherwise
+				// they couldn't be superfluid delegated until the twap covers a whole epoch.
+				prevMultiplier := k.GetOsmoEquivalentMultiplier(ctx, asset.Denom)
+				if prevMultiplier.IsPositive() {
+					multiplier = prevMultiplier
+				}
+				k.Logger(ctx).Error(fmt.Sprintf("setting the osmo equivalent multiplier of %s to %s, as its twap is unavailable: %s", asset.Denom, multiplier, err))
+			} else {
+				multiplier = twapMultiplier
+			}
+		}
 		k.SetOsmoEquivalentMultiplier(ctx, newEpochNumber, asset.Denom, multiplier)
 	} else if asset.AssetType == types.SuperfluidAssetTypeNative {
 		// TODO: Consider deleting superfluid asset type native
@@ -10,15 +10,30 @@ import (
 )
 
 func HandleSetSuperfluidAssetsProposal(ctx sdk.Context, k keeper.Keeper, ek types.EpochKeeper, p *types.SetSuperfluidAssetsProposal) error {
+	updatedExistingAsset := false
 	for _, asset := range p.Assets {
+		if k.GetSuperfluidAsset(ctx, asset.Denom).Denom != "" {
+			updatedExistingAsset = true
+		}
 		k.AddNewSuperfluidAsset(ctx, asset)
 		event := sdk.NewEvent(
 			types.TypeEvtSetSuperfluidAsset,
 			sdk.NewAttribute(types.AttributeDenom, asset.Denom),
 			sdk.NewAttribute(types.AttributeSuperfluidAssetType, asset.AssetType.String()),
 		)
+		if asset.RiskFactor != nil {
+			event = event.AppendAttributes(sdk.NewAttribute(types.AttributeRiskFactor, asset.RiskFactor.String()))
+		}
+		if asset.MaxSuperfluidOsmo != nil {
+			event = event.AppendAttributes(sdk.NewAttribute(types.AttributeMaxSuperfluidOsmo, asset.MaxSuperfluidOsmo.String()))
+		}
 		ctx.EventManager().EmitEvent(event)
 	}
+
+	// superfluid delegations of existing assets follow their new risk factors and max superfluid OSMO right away
+	if updatedExistingAsset {
+		k.RefreshIntermediaryDelegationAmounts(ctx)
+	}
 	return nil
 }
 
@@ -150,3 +150,49 @@ func (suite *KeeperTestSuite) TestHandleSetSuperfluidAssetsProposal() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestHandleSetSuperfluidAssetsProposalRiskParams() {
+	suite.SetupTest()
+	suite.createGammPool([]string{"stake", "foo"})
+
+	asset := types.SuperfluidAsset{
+		Denom:     "gamm/pool/1",
+		AssetType: types.SuperfluidAssetTypeLPShare,
+	}
+	err := gov.HandleSetSuperfluidAssetsProposal(suite.ctx, *suite.app.SuperfluidKeeper, *suite.app.EpochsKeeper, &types.SetSuperfluidAssetsProposal{
+		Title:       "title",
+		Description: "description",
+		Assets:      []types.SuperfluidAsset{asset},
+	})
+	suite.Require().NoError(err)
+
+	// set the risk params of the existing asset
+	riskFactor := sdk.NewDecWithPrec(8, 1)
+	maxSuperfluidOsmo := sdk.NewInt(1000000)
+	asset.RiskFactor = &riskFactor
+	asset.MaxSuperfluidOsmo = &maxSuperfluidOsmo
+	proposal := &types.SetSuperfluidAssetsProposal{
+		Title:       "title",
+		Description: "description",
+		Assets:      []types.SuperfluidAsset{asset},
+	}
+	suite.Require().NoError(proposal.ValidateBasic())
+	err = gov.HandleSetSuperfluidAssetsProposal(suite.ctx, *suite.app.SuperfluidKeeper, *suite.app.EpochsKeeper, proposal)
+	suite.Require().NoError(err)
+
+	resp, err := suite.querier.AllAssets(sdk.WrapSDKContext(suite.ctx), &types.AllAssetsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SuperfluidAsset{asset}, resp.Assets)
+	suite.Require().Equal(riskFactor, suite.app.SuperfluidKeeper.GetRiskFactor(suite.ctx, asset))
+
+	// invalid risk params are rejected
+	invalidRiskFactor := sdk.OneDec()
+	negativeMaxSuperfluidOsmo := sdk.NewInt(-1)
+	for _, invalidAsset := range []types.SuperfluidAsset{
+		{Denom: asset.Denom, AssetType: asset.AssetType, RiskFactor: &invalidRiskFactor},
+		{Denom: asset.Denom, AssetType: asset.AssetType, MaxSuperfluidOsmo: &negativeMaxSuperfluidOsmo},
+	} {
+		proposal.Assets = []types.SuperfluidAsset{invalidAsset}
+		suite.Require().Error(proposal.ValidateBasic())
+	}
+}
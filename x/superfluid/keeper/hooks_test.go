@@ -3,6 +3,8 @@ package keeper_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
 )
 
 func (suite *KeeperTestSuite) TestSuperfluidAfterEpochEnd() {
@@ -44,6 +46,11 @@ func (suite *KeeperTestSuite) TestSuperfluidAfterEpochEnd() {
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			suite.SetupTest()
+			// this test sets the multiplier through the spot price at the epoch boundary,
+			// which the twap method is designed to ignore.
+			params := suite.App.SuperfluidKeeper.GetParams(suite.Ctx)
+			params.OsmoEquivalentMultiplierMethod = types.OsmoEquivalentMultiplierMethodSpot
+			suite.App.SuperfluidKeeper.SetParams(suite.Ctx, params)
 			valAddrs := suite.SetupValidators(tc.validatorStats)
 
 			denoms, poolIds := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
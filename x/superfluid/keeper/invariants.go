@@ -48,8 +48,10 @@ func TotalSuperfluidDelegationInvariant(keeper Keeper) sdk.Invariant {
 		}
 
 		// Compute the total delegation amount expected
-		// from every lockID intermediary account connections
-		totalExpectedSuperfluidAmount := sdk.ZeroInt()
+		// from every lockID intermediary account connections.
+		// Like the refreshes, it scales the amount locked to each intermediary account at once,
+		// as scaling each lock under a max superfluid OSMO would truncate differently.
+		lockedAmounts := map[string]sdk.Coin{}
 		connections := keeper.GetAllLockIdIntermediaryAccountConnections(ctx)
 		for _, connection := range connections {
 			lockId := connection.LockId
@@ -62,7 +64,22 @@ func TotalSuperfluidDelegationInvariant(keeper Keeper) sdk.Invariant {
 				return sdk.FormatInvariant(types.ModuleName, totalSuperfluidDelegationInvariantName,
 					"\tonly single coin lockup is eligible for superfluid staking"), true
 			}
-			amount := keeper.GetSuperfluidOSMOTokens(ctx, lock.Coins[0].Denom, lock.Coins[0].Amount)
+			lockedAmount, ok := lockedAmounts[connection.IntermediaryAccount]
+			if !ok {
+				lockedAmount = sdk.NewCoin(lock.Coins[0].Denom, sdk.ZeroInt())
+			}
+			lockedAmounts[connection.IntermediaryAccount] = lockedAmount.Add(lock.Coins[0])
+		}
+
+		totalExpectedSuperfluidAmount := sdk.ZeroInt()
+		totals := keeper.getTotalSuperfluidDelegatedAmounts(ctx)
+		for _, lockedAmount := range lockedAmounts {
+			total, ok := totals[lockedAmount.Denom]
+			if !ok {
+				total = sdk.ZeroInt()
+			}
+			asset := keeper.GetSuperfluidAsset(ctx, lockedAmount.Denom)
+			amount := keeper.getSuperfluidOSMOTokensWithTotal(ctx, asset, lockedAmount.Amount, total)
 			totalExpectedSuperfluidAmount = totalExpectedSuperfluidAmount.Add(amount)
 		}
 
@@ -69,6 +69,12 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.App.MintKeeper.SetParams(suite.Ctx, mintParams)
 	suite.App.MintKeeper.SetMinter(suite.Ctx, minttypes.NewMinter(sdk.NewDec(1_000_000)))
 
+	// most tests superfluid delegate into pools created in the same block,
+	// which have no twap yet, so they set the multiplier through the spot price.
+	superfluidParams := suite.App.SuperfluidKeeper.GetParams(suite.Ctx)
+	superfluidParams.OsmoEquivalentMultiplierMethod = types.OsmoEquivalentMultiplierMethodSpot
+	suite.App.SuperfluidKeeper.SetParams(suite.Ctx, superfluidParams)
+
 	distributionParams := suite.App.DistrKeeper.GetParams(suite.Ctx)
 	distributionParams.BaseProposerReward = sdk.ZeroDec()
 	distributionParams.BonusProposerReward = sdk.ZeroDec()
@@ -46,36 +46,46 @@ func (server msgServer) SuperfluidDelegate(goCtx context.Context, msg *types.Msg
 func (server msgServer) SuperfluidUndelegate(goCtx context.Context, msg *types.MsgSuperfluidUndelegate) (*types.MsgSuperfluidUndelegateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	err := server.keeper.SuperfluidUndelegate(ctx, msg.Sender, msg.LockId)
+	lockId, err := server.keeper.PartialSuperfluidUndelegate(ctx, msg.Sender, msg.LockId, msg.Coins)
 	if err != nil {
 		ctx.EventManager().EmitEvent(sdk.NewEvent(
 			types.TypeEvtSuperfluidUndelegate,
 			sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", msg.LockId)),
 		))
 	}
-	return &types.MsgSuperfluidUndelegateResponse{}, err
+	return &types.MsgSuperfluidUndelegateResponse{LockId: lockId}, err
 }
 
-// func (server msgServer) SuperfluidRedelegate(goCtx context.Context, msg *types.MsgSuperfluidRedelegate) (*types.MsgSuperfluidRedelegateResponse, error) {
-// 	ctx := sdk.UnwrapSDKContext(goCtx)
+func (server msgServer) SuperfluidRedelegate(goCtx context.Context, msg *types.MsgSuperfluidRedelegate) (*types.MsgSuperfluidRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 
-// 	err := server.keeper.SuperfluidRedelegate(ctx, msg.Sender, msg.LockId, msg.NewValAddr)
-// 	return &types.MsgSuperfluidRedelegateResponse{}, err
-// }
+	srcValAddr, err := server.keeper.SuperfluidRedelegate(ctx, msg.Sender, msg.LockId, msg.NewValAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSuperfluidRedelegate,
+		sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", msg.LockId)),
+		sdk.NewAttribute(types.AttributeSrcValidator, srcValAddr),
+		sdk.NewAttribute(types.AttributeValidator, msg.NewValAddr),
+	))
+	return &types.MsgSuperfluidRedelegateResponse{}, nil
+}
 
 func (server msgServer) SuperfluidUnbondLock(goCtx context.Context, msg *types.MsgSuperfluidUnbondLock) (
 	*types.MsgSuperfluidUnbondLockResponse, error,
 ) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	err := server.keeper.SuperfluidUnbondLock(ctx, msg.LockId, msg.Sender)
+	lockId, err := server.keeper.PartialSuperfluidUnbondLock(ctx, msg.LockId, msg.Sender, msg.Coins)
 	if err != nil {
 		ctx.EventManager().EmitEvent(sdk.NewEvent(
 			types.TypeEvtSuperfluidUnbondLock,
 			sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", msg.LockId)),
 		))
 	}
-	return &types.MsgSuperfluidUnbondLockResponse{}, err
+	return &types.MsgSuperfluidUnbondLockResponse{LockId: lockId}, err
 }
 
 func (server msgServer) LockAndSuperfluidDelegate(goCtx context.Context, msg *types.MsgLockAndSuperfluidDelegate) (*types.MsgLockAndSuperfluidDelegateResponse, error) {
@@ -104,6 +114,24 @@ func (server msgServer) LockAndSuperfluidDelegate(goCtx context.Context, msg *ty
 	}, err
 }
 
+func (server msgServer) MergeLocksAndSuperfluidDelegate(goCtx context.Context, msg *types.MsgMergeLocksAndSuperfluidDelegate) (*types.MsgMergeLocksAndSuperfluidDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lockId, err := server.keeper.MergeLocksAndSuperfluidDelegate(ctx, msg.Sender, msg.LockIds, msg.ValAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	mergedLockIDsSerialized, _ := json.Marshal(msg.LockIds[1:])
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSuperfluidDelegate,
+		sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", lockId)),
+		sdk.NewAttribute(types.AttributeMergedLockIds, string(mergedLockIDsSerialized)),
+		sdk.NewAttribute(types.AttributeValidator, msg.ValAddr),
+	))
+	return &types.MsgMergeLocksAndSuperfluidDelegateResponse{LockId: lockId}, nil
+}
+
 func (server msgServer) UnPoolWhitelistedPool(goCtx context.Context, msg *types.MsgUnPoolWhitelistedPool) (*types.MsgUnPoolWhitelistedPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
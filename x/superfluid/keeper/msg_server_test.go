@@ -192,3 +192,42 @@ func (suite *KeeperTestSuite) TestMsgLockAndSuperfluidDelegate() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgMergeLocksAndSuperfluidDelegate() {
+	suite.SetupTest()
+
+	lockOwner := sdk.AccAddress([]byte("addr1---------------"))
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+	unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+
+	lockIds := []uint64{}
+	for i := 0; i < 2; i++ {
+		coins := sdk.Coins{sdk.NewInt64Coin(denoms[0], 1000000)}
+		suite.FundAcc(lockOwner, coins)
+		lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, lockOwner, coins, unbondingDuration)
+		suite.Require().NoError(err)
+		lockIds = append(lockIds, lock.ID)
+	}
+
+	c := sdk.WrapSDKContext(suite.Ctx)
+	msgServer := keeper.NewMsgServerImpl(suite.App.SuperfluidKeeper)
+	resp, err := msgServer.MergeLocksAndSuperfluidDelegate(c, types.NewMsgMergeLocksAndSuperfluidDelegate(lockOwner, lockIds, valAddrs[0]))
+	suite.Require().NoError(err)
+	suite.Require().Equal(lockIds[0], resp.LockId)
+	mergedLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, resp.LockId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(2000000), mergedLock.Coins.AmountOf(denoms[0]))
+
+	// a lock owned by someone else can't be merged
+	coins := sdk.Coins{sdk.NewInt64Coin(denoms[0], 1000000)}
+	otherAddr := sdk.AccAddress([]byte("addr2---------------"))
+	suite.FundAcc(otherAddr, coins)
+	otherLock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, otherAddr, coins, unbondingDuration)
+	suite.Require().NoError(err)
+	suite.FundAcc(lockOwner, coins)
+	ownLock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, lockOwner, coins, unbondingDuration)
+	suite.Require().NoError(err)
+	_, err = msgServer.MergeLocksAndSuperfluidDelegate(c, types.NewMsgMergeLocksAndSuperfluidDelegate(lockOwner, []uint64{ownLock.ID, otherLock.ID}, valAddrs[0]))
+	suite.Require().ErrorIs(err, lockuptypes.ErrNotLockOwner)
+}
@@ -13,6 +13,8 @@ import (
 // This function is responsible for inspecting every intermediate account to valAddr.
 // For each intermediate account IA, it slashes every constituent delegation behind IA.
 // Furthermore, if the infraction height is sufficiently old, slashes unbondings
+// Locks that were redelegated away from valAddr keep an unbonding synthetic lockup to it, so they get slashed too,
+// and the delegation they were redelegated to is reduced by what they were slashed, as staking does for redelegations.
 // Note: Based on sdk.staking.Slash function review, slashed tokens are burnt not sent to community pool
 // we ignore that, and send the underliyng tokens to the community pool anyway.
 func (k Keeper) SlashLockupsForValidatorSlash(ctx sdk.Context, valAddr sdk.ValAddress, infractionHeight int64, slashFactor sdk.Dec) {
@@ -63,7 +65,25 @@ func (k Keeper) slashSynthLock(ctx sdk.Context, synthLock *lockuptypes.Synthetic
 	slashCoins := sdk.NewCoins(sdk.NewCoin(lock.Coins[0].Denom, slashAmt))
 	_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
 		// These tokens get moved to the community pool.
-		_, err := k.lk.SlashTokensFromLockByID(cacheCtx, lock.ID, slashCoins)
-		return err
+		slashedLock, err := k.lk.SlashTokensFromLockByID(cacheCtx, lock.ID, slashCoins)
+		if err != nil {
+			return err
+		}
+		if !synthLock.IsUnlocking() {
+			return nil
+		}
+
+		// An unbonding lock that is still superfluid staked was redelegated, and its delegation
+		// to the new validator, which staking doesn't slash, has to shrink with the lock.
+		acc, found := k.GetIntermediaryAccountFromLockId(cacheCtx, lock.ID)
+		if !found {
+			return nil
+		}
+		preSlashAmount := k.GetSuperfluidOSMOTokens(cacheCtx, acc.Denom, lock.Coins[0].Amount)
+		postSlashAmount := k.GetSuperfluidOSMOTokens(cacheCtx, acc.Denom, slashedLock.Coins.AmountOf(acc.Denom))
+		if !preSlashAmount.GT(postSlashAmount) {
+			return nil
+		}
+		return k.forceUndelegateAndBurnOsmoTokens(cacheCtx, preSlashAmount.Sub(postSlashAmount), acc)
 	})
 }
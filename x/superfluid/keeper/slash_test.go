@@ -188,3 +188,48 @@ func (suite *KeeperTestSuite) TestSlashLockupsForUnbondingDelegationSlash() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSlashLockupsForRedelegationSlash() {
+	suite.SetupTest()
+
+	// Generate delegator addresses
+	delAddrs := CreateRandomAccounts(2)
+
+	// setup validators
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+	// setup superfluid delegations, and redelegate lock1 from val0 to val1
+	intermediaryAccs, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs,
+		[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}}, denoms)
+	suite.checkIntermediaryAccountDelegations(intermediaryAccs)
+	_, err := suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, locks[0].Owner, locks[0].ID, valAddrs[1].String())
+	suite.Require().NoError(err)
+
+	// slash the validator lock1 was redelegated from
+	slashFactor := sdk.NewDecWithPrec(5, 2)
+	validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+	suite.Require().True(found)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	power := sdk.TokensToConsensusPower(validator.Tokens, sdk.DefaultPowerReduction)
+	suite.App.StakingKeeper.Slash(suite.Ctx, consAddr, suite.Ctx.BlockHeight(), power, slashFactor)
+
+	// check invariant is fine, as the delegation lock1 was redelegated to shrinks with it
+	reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+	suite.Require().False(broken, reason)
+
+	// both the redelegated lock and the lock still delegated to the slashed validator are slashed
+	for _, lock := range locks {
+		gotLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+		suite.Require().NoError(err)
+		suite.Require().Equal(sdk.NewInt(950000).String(), gotLock.Coins[0].Amount.String())
+	}
+
+	// slashes of the validator lock1 was redelegated to don't slash lock2
+	suite.App.SuperfluidKeeper.SlashLockupsForValidatorSlash(suite.Ctx, valAddrs[1], suite.Ctx.BlockHeight(), slashFactor)
+	gotLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, locks[1].ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(950000).String(), gotLock.Coins[0].Amount.String())
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -29,59 +30,120 @@ func (k Keeper) GetExpectedDelegationAmount(ctx sdk.Context, acc types.Superflui
 	return refreshedAmount
 }
 
+// getExpectedDelegationAmount returns GetExpectedDelegationAmount of acc, given the total amount of acc's denom
+// superfluid delegated.
+func (k Keeper) getExpectedDelegationAmount(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount, asset types.SuperfluidAsset, totalAmount sdk.Int) sdk.Int {
+	totalSuperfluidDelegation := k.GetTotalSyntheticAssetsLocked(ctx, stakingSyntheticDenom(acc.Denom, acc.ValAddr))
+	return k.getSuperfluidOSMOTokensWithTotal(ctx, asset, totalSuperfluidDelegation, totalAmount)
+}
+
+// getTotalSuperfluidDelegatedAmount returns how much of denom is superfluid delegated to any validator.
+func (k Keeper) getTotalSuperfluidDelegatedAmount(ctx sdk.Context, denom string) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, acc := range k.GetAllIntermediaryAccounts(ctx) {
+		if acc.Denom == denom {
+			total = total.Add(k.GetTotalSyntheticAssetsLocked(ctx, stakingSyntheticDenom(acc.Denom, acc.ValAddr)))
+		}
+	}
+	return total
+}
+
+// getTotalSuperfluidDelegatedAmounts returns how much of each denom is superfluid delegated to any validator,
+// in a single pass over the intermediary accounts.
+func (k Keeper) getTotalSuperfluidDelegatedAmounts(ctx sdk.Context) map[string]sdk.Int {
+	totals := map[string]sdk.Int{}
+	for _, acc := range k.GetAllIntermediaryAccounts(ctx) {
+		total, ok := totals[acc.Denom]
+		if !ok {
+			total = sdk.ZeroInt()
+		}
+		totals[acc.Denom] = total.Add(k.GetTotalSyntheticAssetsLocked(ctx, stakingSyntheticDenom(acc.Denom, acc.ValAddr)))
+	}
+	return totals
+}
+
 func (k Keeper) RefreshIntermediaryDelegationAmounts(ctx sdk.Context) {
 	// iterate over every (denom, validator) pair
 	accs := k.GetAllIntermediaryAccounts(ctx)
+	// refreshing delegations doesn't change the synthetic locks, so the totals hold for the whole refresh
+	totals := k.getTotalSuperfluidDelegatedAmounts(ctx)
+	assets := map[string]types.SuperfluidAsset{}
 	for _, acc := range accs {
-		mAddr := acc.GetAccAddress()
-
-		valAddress, err := sdk.ValAddressFromBech32(acc.ValAddr)
-		if err != nil {
-			panic(err)
+		asset, ok := assets[acc.Denom]
+		if !ok {
+			asset = k.GetSuperfluidAsset(ctx, acc.Denom)
+			assets[acc.Denom] = asset
 		}
+		k.refreshIntermediaryDelegationAmount(ctx, acc, asset, totals[acc.Denom])
+	}
+}
 
-		validator, found := k.sk.GetValidator(ctx, valAddress)
-		if !found {
-			k.Logger(ctx).Error(fmt.Sprintf("validator not found or %s", acc.ValAddr))
-			continue
+// refreshCappedIntermediaryDelegationAmounts refreshes the intermediary accounts of denom if it has a max superfluid OSMO,
+// as how much OSMO each of them delegates under the cap depends on how much of denom is superfluid delegated in total.
+func (k Keeper) refreshCappedIntermediaryDelegationAmounts(ctx sdk.Context, denom string) {
+	asset := k.GetSuperfluidAsset(ctx, denom)
+	if asset.MaxSuperfluidOsmo == nil {
+		return
+	}
+	total := k.getTotalSuperfluidDelegatedAmount(ctx, denom)
+	for _, acc := range k.GetAllIntermediaryAccounts(ctx) {
+		if acc.Denom == denom {
+			k.refreshIntermediaryDelegationAmount(ctx, acc, asset, total)
 		}
+	}
+}
 
-		currentAmount := sdk.NewInt(0)
-		delegation, found := k.sk.GetDelegation(ctx, mAddr, valAddress)
-		if !found {
-			// continue if current delegation is 0, in case its really a dust delegation
-			// that becomes worth something after refresh.
-			k.Logger(ctx).Info(fmt.Sprintf("Existing delegation not found for %s with %s during superfluid refresh."+
-				" It may have been previously bonded, but now unbonded.", mAddr.String(), acc.ValAddr))
-		} else {
-			// TODO: Be consistent withn TokensFromShares vs ValidateFromUnbondAmount
-			currentAmount = validator.TokensFromShares(delegation.Shares).RoundInt()
-		}
+// refreshIntermediaryDelegationAmount refreshes the delegation of acc to its expected delegation amount,
+// given its asset and the total amount of its denom superfluid delegated.
+func (k Keeper) refreshIntermediaryDelegationAmount(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount, asset types.SuperfluidAsset, totalAmount sdk.Int) {
+	mAddr := acc.GetAccAddress()
 
-		refreshedAmount := k.GetExpectedDelegationAmount(ctx, acc)
+	valAddress, err := sdk.ValAddressFromBech32(acc.ValAddr)
+	if err != nil {
+		panic(err)
+	}
 
-		if refreshedAmount.GT(currentAmount) {
-			adjustment := refreshedAmount.Sub(currentAmount)
-			err = k.mintOsmoTokensAndDelegate(ctx, adjustment, acc)
-			if err != nil {
-				ctx.Logger().Error("Error in forceUndelegateAndBurnOsmoTokens, state update reverted", err)
-			}
-		} else if currentAmount.GT(refreshedAmount) {
-			// In this case, we want to change the IA's delegated balance to be refreshed Amount
-			// which is less than what it already has.
-			// This means we need to "InstantUndelegate" some of its delegation (not going through the unbonding queue)
-			// and then burn that excessly delegated bits.
-			adjustment := currentAmount.Sub(refreshedAmount)
-
-			err := k.forceUndelegateAndBurnOsmoTokens(ctx, adjustment, acc)
-			if err != nil {
-				ctx.Logger().Error("Error in forceUndelegateAndBurnOsmoTokens, state update reverted", err)
-			}
-		} else {
-			ctx.Logger().Info("Intermediary account already has correct delegation amount?" +
-				" This with high probability implies the exact same spot price as the last epoch," +
-				"and no delegation changes.")
+	validator, found := k.sk.GetValidator(ctx, valAddress)
+	if !found {
+		k.Logger(ctx).Error(fmt.Sprintf("validator not found or %s", acc.ValAddr))
+		return
+	}
+
+	currentAmount := sdk.NewInt(0)
+	delegation, found := k.sk.GetDelegation(ctx, mAddr, valAddress)
+	if !found {
+		// continue if current delegation is 0, in case its really a dust delegation
+		// that becomes worth something after refresh.
+		k.Logger(ctx).Info(fmt.Sprintf("Existing delegation not found for %s with %s during superfluid refresh."+
+			" It may have been previously bonded, but now unbonded.", mAddr.String(), acc.ValAddr))
+	} else {
+		// TODO: Be consistent withn TokensFromShares vs ValidateFromUnbondAmount
+		currentAmount = validator.TokensFromShares(delegation.Shares).RoundInt()
+	}
+
+	refreshedAmount := k.getExpectedDelegationAmount(ctx, acc, asset, totalAmount)
+
+	if refreshedAmount.GT(currentAmount) {
+		adjustment := refreshedAmount.Sub(currentAmount)
+		err = k.mintOsmoTokensAndDelegate(ctx, adjustment, acc)
+		if err != nil {
+			ctx.Logger().Error("Error in forceUndelegateAndBurnOsmoTokens, state update reverted", err)
 		}
+	} else if currentAmount.GT(refreshedAmount) {
+		// In this case, we want to change the IA's delegated balance to be refreshed Amount
+		// which is less than what it already has.
+		// This means we need to "InstantUndelegate" some of its delegation (not going through the unbonding queue)
+		// and then burn that excessly delegated bits.
+		adjustment := currentAmount.Sub(refreshedAmount)
+
+		err := k.forceUndelegateAndBurnOsmoTokens(ctx, adjustment, acc)
+		if err != nil {
+			ctx.Logger().Error("Error in forceUndelegateAndBurnOsmoTokens, state update reverted", err)
+		}
+	} else {
+		ctx.Logger().Info("Intermediary account already has correct delegation amount?" +
+			" This with high probability implies the exact same spot price as the last epoch," +
+			"and no delegation changes.")
 	}
 }
 
@@ -102,6 +164,7 @@ func (k Keeper) IncreaseSuperfluidDelegation(ctx sdk.Context, lockID uint64, amo
 		return err
 	}
 
+	k.refreshCappedIntermediaryDelegationAmounts(ctx, acc.Denom)
 	return nil
 }
 
@@ -196,7 +259,76 @@ func (k Keeper) SuperfluidDelegate(ctx sdk.Context, sender string, lockID uint64
 		return types.ErrOsmoEquivalentZeroNotAllowed
 	}
 
-	return k.mintOsmoTokensAndDelegate(ctx, amount, acc)
+	err = k.mintOsmoTokensAndDelegate(ctx, amount, acc)
+	if err != nil {
+		return err
+	}
+
+	// Delegating more of a capped asset lowers what each of its delegations is worth under its cap.
+	k.refreshCappedIntermediaryDelegationAmounts(ctx, acc.Denom)
+	return nil
+}
+
+// MergeLocksAndSuperfluidDelegate merges the locks with lockIDs into the first of them, and superfluid delegates it
+// to valAddr, returning its ID. Every lock has to be eligible for superfluid delegation, and have the same denom and
+// duration as the first. The others are force unlocked, and their tokens added to the first as AddTokensToLockByID does.
+// Either all of this happens, or none of it does.
+func (k Keeper) MergeLocksAndSuperfluidDelegate(ctx sdk.Context, sender string, lockIDs []uint64, valAddr string) (uint64, error) {
+	if len(lockIDs) == 0 {
+		return 0, fmt.Errorf("no lock to merge")
+	}
+	seenLockIDs := map[uint64]bool{}
+	for _, lockID := range lockIDs {
+		if seenLockIDs[lockID] {
+			return 0, sdkerrors.Wrapf(types.ErrLocksNotMergeable, "lock id %d is duplicated", lockID)
+		}
+		seenLockIDs[lockID] = true
+	}
+	mergedLockID := lockIDs[0]
+
+	err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		mergedLock, err := k.lk.GetLockByID(cacheCtx, mergedLockID)
+		if err != nil {
+			return err
+		}
+		err = k.validateLockForSFDelegate(cacheCtx, mergedLock, sender)
+		if err != nil {
+			return err
+		}
+		mergedCoin := mergedLock.Coins[0]
+
+		for _, lockID := range lockIDs[1:] {
+			lock, err := k.lk.GetLockByID(cacheCtx, lockID)
+			if err != nil {
+				return err
+			}
+			err = k.validateLockForSFDelegate(cacheCtx, lock, sender)
+			if err != nil {
+				return err
+			}
+			lockedCoin := lock.Coins[0]
+			if lockedCoin.Denom != mergedCoin.Denom || lock.Duration != mergedLock.Duration {
+				return sdkerrors.Wrapf(types.ErrLocksNotMergeable, "lock %d locks %s for %s, but lock %d locks %s for %s",
+					lockID, lockedCoin.Denom, lock.Duration, mergedLockID, mergedCoin.Denom, mergedLock.Duration)
+			}
+
+			// Force unlocking sends the tokens back to the owner, from whom they are added to the merged lock.
+			err = k.lk.ForceUnlock(cacheCtx, *lock)
+			if err != nil {
+				return err
+			}
+			_, err = k.lk.AddTokensToLockByID(cacheCtx, mergedLockID, lock.OwnerAddress(), lockedCoin)
+			if err != nil {
+				return err
+			}
+		}
+
+		return k.SuperfluidDelegate(cacheCtx, sender, mergedLockID, valAddr)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return mergedLockID, nil
 }
 
 func (k Keeper) SuperfluidUndelegate(ctx sdk.Context, sender string, lockID uint64) error {
@@ -217,6 +349,9 @@ func (k Keeper) SuperfluidUndelegate(ctx sdk.Context, sender string, lockID uint
 	}
 	k.DeleteLockIdIntermediaryAccountConnection(ctx, lockID)
 
+	// This lock's delegation amount, while it still counts towards the cap of a capped asset.
+	amount := k.GetSuperfluidOSMOTokens(ctx, intermediaryAcc.Denom, lockedCoin.Amount)
+
 	// Delete the old synthetic lockup, and create a new synthetic lockup representing the unstaking
 	synthdenom := stakingSyntheticDenom(lockedCoin.Denom, intermediaryAcc.ValAddr)
 	err = k.lk.DeleteSyntheticLockup(ctx, lockID, synthdenom)
@@ -225,33 +360,195 @@ func (k Keeper) SuperfluidUndelegate(ctx sdk.Context, sender string, lockID uint
 	}
 
 	// undelegate this lock's delegation amount, and burn the minted osmo.
-	amount := k.GetSuperfluidOSMOTokens(ctx, intermediaryAcc.Denom, lockedCoin.Amount)
 	err = k.forceUndelegateAndBurnOsmoTokens(ctx, amount, intermediaryAcc)
 	if err != nil {
 		return err
 	}
 
 	// Create a new synthetic lockup representing the unstaking side.
-	return k.createSyntheticLockup(ctx, lockID, intermediaryAcc, unlockingStatus)
+	err = k.createSyntheticLockup(ctx, lockID, intermediaryAcc, unlockingStatus)
+	if err != nil {
+		return err
+	}
+
+	k.refreshCappedIntermediaryDelegationAmounts(ctx, intermediaryAcc.Denom)
+	return nil
+}
+
+// PartialSuperfluidUndelegate splits coins off the superfluid delegated lock with lockID into a new lock,
+// undelegates only the new lock, and returns its ID.
+// If coins are empty or all of the lock's tokens, the lock itself is undelegated, and lockID is returned.
+func (k Keeper) PartialSuperfluidUndelegate(ctx sdk.Context, sender string, lockID uint64, coins sdk.Coins) (uint64, error) {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return 0, err
+	}
+	if isWholeLock(lock, coins) {
+		return lockID, k.SuperfluidUndelegate(ctx, sender, lockID)
+	}
+	err = k.validateLockForSF(ctx, lock, sender)
+	if err != nil {
+		return 0, err
+	}
+	lockedCoin := lock.Coins[0]
+
+	intermediaryAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
+	if !found {
+		return 0, types.ErrNotSuperfluidUsedLockup
+	}
+
+	// What the lock's delegation shrinks by, so that the rest of the lock stays delegated
+	// exactly as much as if it were delegated on its own.
+	remainingAmount := lockedCoin.Amount.Sub(coins.AmountOf(lockedCoin.Denom))
+	amount := k.GetSuperfluidOSMOTokens(ctx, intermediaryAcc.Denom, lockedCoin.Amount).Sub(
+		k.GetSuperfluidOSMOTokens(ctx, intermediaryAcc.Denom, remainingAmount))
+
+	// The split lock gets a copy of every synthetic lockup of the lock, including its bonded one,
+	// which is replaced with an unbonding one as in SuperfluidUndelegate.
+	splitLock, err := k.lk.SplitLock(ctx, lockID, coins)
+	if err != nil {
+		return 0, err
+	}
+	err = k.lk.DeleteSyntheticLockup(ctx, splitLock.ID, stakingSyntheticDenom(lockedCoin.Denom, intermediaryAcc.ValAddr))
+	if err != nil {
+		return 0, err
+	}
+
+	// Undelegate and burn it.
+	if amount.IsPositive() {
+		err = k.forceUndelegateAndBurnOsmoTokens(ctx, amount, intermediaryAcc)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = k.createSyntheticLockup(ctx, splitLock.ID, intermediaryAcc, unlockingStatus)
+	if err != nil {
+		return 0, err
+	}
+
+	k.refreshCappedIntermediaryDelegationAmounts(ctx, intermediaryAcc.Denom)
+	return splitLock.ID, nil
+}
+
+// SuperfluidRedelegate moves the superfluid delegation of the lock with lockID to newValAddr without unbonding it,
+// and returns the validator it moved from.
+// Like a staking redelegation, the delegation to newValAddr starts right away, and the lock keeps an unbonding
+// synthetic lockup on its previous validator for the unbonding period, through which SlashLockupsForValidatorSlash
+// still slashes it for its previous validator. Until then, the lock can't be redelegated again,
+// as staking prohibits transitive redelegations.
+// It can't redelegate with staking's BeginRedelegation, which keeps the redelegated delegation with its delegator,
+// whereas each intermediary account only delegates to its own validator. Staking would also redelegate every lock
+// moving between the same two validators as one redelegation of the old intermediary account, of which it only allows
+// max entries per unbonding period. So it undelegates from the old intermediary account and delegates from the new one,
+// and slashing the lock for its previous validator shrinks its new delegation, as staking does for redelegations.
+func (k Keeper) SuperfluidRedelegate(ctx sdk.Context, sender string, lockID uint64, newValAddr string) (string, error) {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return "", err
+	}
+	err = k.validateLockForSF(ctx, lock, sender)
+	if err != nil {
+		return "", err
+	}
+	lockedCoin := lock.Coins[0]
+
+	oldAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
+	if !found {
+		return "", types.ErrNotSuperfluidUsedLockup
+	}
+	if oldAcc.ValAddr == newValAddr {
+		return "", types.ErrSameValidatorRedelegation
+	}
+	if _, err := k.validateValAddrForDelegate(ctx, newValAddr); err != nil {
+		return "", err
+	}
+
+	// A bonded lock only has an unbonding synthetic lockup while a previous redelegation of it matures.
+	for _, synthLock := range k.lk.GetAllSyntheticLockupsByLockup(ctx, lockID) {
+		if synthLock.IsUnlocking() {
+			return "", sdkerrors.Wrapf(stakingtypes.ErrTransitiveRedelegation,
+				"lock %d is redelegating from %s until %s", lockID, synthLock.SynthDenom, synthLock.EndTime)
+		}
+	}
+
+	amount := k.GetSuperfluidOSMOTokens(ctx, oldAcc.Denom, lockedCoin.Amount)
+	if amount.IsZero() {
+		return "", types.ErrOsmoEquivalentZeroNotAllowed
+	}
+
+	// Undelegate from the old validator, keeping the lock liable for its slashes while unbonding.
+	k.DeleteLockIdIntermediaryAccountConnection(ctx, lockID)
+	err = k.lk.DeleteSyntheticLockup(ctx, lockID, stakingSyntheticDenom(lockedCoin.Denom, oldAcc.ValAddr))
+	if err != nil {
+		return "", err
+	}
+	err = k.forceUndelegateAndBurnOsmoTokens(ctx, amount, oldAcc)
+	if err != nil {
+		return "", err
+	}
+	err = k.createSyntheticLockup(ctx, lockID, oldAcc, unlockingStatus)
+	if err != nil {
+		return "", err
+	}
+
+	// Delegate to the new validator.
+	newAcc, err := k.GetOrCreateIntermediaryAccount(ctx, lockedCoin.Denom, newValAddr)
+	if err != nil {
+		return "", err
+	}
+	k.SetLockIdIntermediaryAccountConnection(ctx, lockID, newAcc)
+	err = k.createSyntheticLockup(ctx, lockID, newAcc, bondedStatus)
+	if err != nil {
+		return "", err
+	}
+
+	return oldAcc.ValAddr, k.mintOsmoTokensAndDelegate(ctx, amount, newAcc)
 }
 
 func (k Keeper) SuperfluidUnbondLock(ctx sdk.Context, underlyingLockId uint64, sender string) error {
+	_, err := k.PartialSuperfluidUnbondLock(ctx, underlyingLockId, sender, sdk.Coins{})
+	return err
+}
+
+// PartialSuperfluidUnbondLock splits coins off the superfluid undelegating lock with underlyingLockId into a new lock,
+// which keeps the lock's unbonding synthetic lockups, starts unlocking only the new lock, and returns its ID.
+// If coins are empty or all of the lock's tokens, the lock itself starts unlocking, and underlyingLockId is returned.
+func (k Keeper) PartialSuperfluidUnbondLock(ctx sdk.Context, underlyingLockId uint64, sender string, coins sdk.Coins) (uint64, error) {
 	lock, err := k.lk.GetLockByID(ctx, underlyingLockId)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	err = k.validateLockForSF(ctx, lock, sender)
 	if err != nil {
-		return err
+		return 0, err
 	}
+	// The lock can have more than one unbonding synthetic lockup if it was redelegated before it was undelegated.
 	synthLocks := k.lk.GetAllSyntheticLockupsByLockup(ctx, underlyingLockId)
-	if len(synthLocks) != 1 {
-		return types.ErrNotSuperfluidUsedLockup
+	if len(synthLocks) == 0 {
+		return 0, types.ErrNotSuperfluidUsedLockup
 	}
-	if !synthLocks[0].IsUnlocking() {
-		return types.ErrBondingLockupNotSupported
+	for _, synthLock := range synthLocks {
+		if !synthLock.IsUnlocking() {
+			return 0, types.ErrBondingLockupNotSupported
+		}
+	}
+
+	unlockingLockId := underlyingLockId
+	if !isWholeLock(lock, coins) {
+		splitLock, err := k.lk.SplitLock(ctx, underlyingLockId, coins)
+		if err != nil {
+			return 0, err
+		}
+		unlockingLockId = splitLock.ID
 	}
-	return k.lk.BeginForceUnlock(ctx, underlyingLockId, sdk.Coins{})
+	return unlockingLockId, k.lk.BeginForceUnlock(ctx, unlockingLockId, sdk.Coins{})
+}
+
+// isWholeLock returns whether coins are empty or all of the lock's tokens,
+// which msgs that act on part of a lock take as the whole lock.
+func isWholeLock(lock *lockuptypes.PeriodLock, coins sdk.Coins) bool {
+	return coins.Empty() || (coins.IsAllLTE(lock.Coins) && lock.Coins.IsAllLTE(coins))
 }
 
 func (k Keeper) alreadySuperfluidStaking(ctx sdk.Context, lockID uint64) bool {
@@ -363,23 +660,33 @@ func (k Keeper) TotalBondedTokens(ctx sdk.Context) sdk.Int {
 
 // IterateDelegations implements govtypes.StakingKeeper
 // Iterates through staking keeper's delegations, and then all of the superfluid delegations.
+// This lets the gov tally pass the votes of superfluid delegators through the intermediary accounts:
+// the share of an intermediary account's delegation that backs a delegator's lock is counted as the
+// delegator's own delegation, so when they vote it's deducted from their validator's vote.
+// Only bonded synthetic lockups are included, as the intermediary account no longer delegates for
+// lockups that are superfluid undelegating, or that were redelegated away from the validator.
 func (k Keeper) IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress, fn func(int64, stakingtypes.DelegationI) bool) {
 	// call the callback with the non-superfluid delegations
 	var index int64
-	k.sk.IterateDelegations(ctx, delegator, func(i int64, delegation stakingtypes.DelegationI) (stop bool) {
-		index = i
-		return fn(i, delegation)
+	stopped := false
+	k.sk.IterateDelegations(ctx, delegator, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+		stopped = fn(index, delegation)
+		index++
+		return stopped
 	})
+	if stopped {
+		return
+	}
 
 	synthlocks := k.lk.GetAllSyntheticLockupsByAddr(ctx, delegator)
-	for i, lock := range synthlocks {
+	for _, synthlock := range synthlocks {
 		// get locked coin from the lock ID
-		interim, ok := k.GetIntermediaryAccountFromLockId(ctx, lock.UnderlyingLockId)
-		if !ok {
+		interim, ok := k.GetIntermediaryAccountFromLockId(ctx, synthlock.UnderlyingLockId)
+		if !ok || synthlock.SynthDenom != stakingSyntheticDenom(interim.Denom, interim.ValAddr) {
 			continue
 		}
 
-		lock, err := k.lk.GetLockByID(ctx, lock.UnderlyingLockId)
+		lock, err := k.lk.GetLockByID(ctx, synthlock.UnderlyingLockId)
 		if err != nil {
 			ctx.Logger().Error("lockup retrieval failed with underlying lock", "Lock", lock, "Error", err)
 			continue
@@ -391,27 +698,23 @@ func (k Keeper) IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress, fn
 			continue
 		}
 
-		// get osmo-equivalent token amount
-		amount := k.GetSuperfluidOSMOTokens(ctx, interim.Denom, coin.Amount)
-
-		// get validator shares equivalent to the token amount
 		valAddr, err := sdk.ValAddressFromBech32(interim.ValAddr)
 		if err != nil {
 			ctx.Logger().Error("failed to decode validator address", "Intermediary", interim.ValAddr, "LockID", lock.ID, "Error", err)
 			continue
 		}
 
-		validator, found := k.sk.GetValidator(ctx, valAddr)
+		// get the part of the intermediary account's delegation shares backed by the lock,
+		// so that the deductions from the validator never exceed what the intermediary account delegates.
+		interimDelegation, found := k.sk.GetDelegation(ctx, interim.GetAccAddress(), valAddr)
 		if !found {
-			ctx.Logger().Error("validator does not exist for lock", "Validator", valAddr, "LockID", lock.ID)
 			continue
 		}
-
-		shares, err := validator.SharesFromTokens(amount)
-		if err != nil {
-			// tokens are not valid. continue.
+		totalLocked := k.GetTotalSyntheticAssetsLocked(ctx, synthlock.SynthDenom)
+		if !totalLocked.IsPositive() {
 			continue
 		}
+		shares := interimDelegation.Shares.MulInt(coin.Amount).QuoInt(totalLocked)
 
 		// construct delegation and call callback
 		delegation := stakingtypes.Delegation{
@@ -421,6 +724,9 @@ func (k Keeper) IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress, fn
 		}
 
 		// if valid delegation has been found, increment delegation index
-		fn(index+int64(i), delegation)
+		if fn(index, delegation) {
+			return
+		}
+		index++
 	}
 }
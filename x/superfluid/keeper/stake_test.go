@@ -12,6 +12,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -253,6 +254,145 @@ func (suite *KeeperTestSuite) TestSuperfluidDelegate() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMergeLocksAndSuperfluidDelegate() {
+	testCases := []struct {
+		name string
+		// denom indexes and durations of the locks to merge, the first of which is merged into
+		lpIndexes []int64
+		durations []time.Duration
+		expErr    bool
+	}{
+		{
+			"merge a single lock",
+			[]int64{0},
+			[]time.Duration{0},
+			false,
+		},
+		{
+			"merge multiple locks",
+			[]int64{0, 0, 0},
+			[]time.Duration{0, 0, 0},
+			false,
+		},
+		{
+			"merge locks of different denoms",
+			[]int64{0, 1},
+			[]time.Duration{0, 0},
+			true,
+		},
+		{
+			"merge locks of different durations",
+			[]int64{0, 0},
+			[]time.Duration{0, time.Hour},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			delAddr := CreateRandomAccounts(1)[0]
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+			unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+
+			// create separate locks, as locking with the msg server would add to an existing lock
+			lockIds := []uint64{}
+			for i, lpIndex := range tc.lpIndexes {
+				coins := sdk.Coins{sdk.NewInt64Coin(denoms[lpIndex], 1000000)}
+				suite.FundAcc(delAddr, coins)
+				lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, delAddr, coins, unbondingDuration+tc.durations[i])
+				suite.Require().NoError(err)
+				lockIds = append(lockIds, lock.ID)
+			}
+
+			mergedLockId, err := suite.App.SuperfluidKeeper.MergeLocksAndSuperfluidDelegate(suite.Ctx, delAddr.String(), lockIds, valAddrs[0].String())
+			if tc.expErr {
+				suite.Require().Error(err)
+
+				// nothing is merged or delegated
+				for _, lockId := range lockIds {
+					lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockId)
+					suite.Require().NoError(err)
+					suite.Require().Equal(sdk.NewInt(1000000), lock.Coins[0].Amount)
+					_, found := suite.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(suite.Ctx, lockId)
+					suite.Require().False(found)
+				}
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(lockIds[0], mergedLockId)
+
+			// the merged lock has the tokens of every lock, and the others are gone
+			mergedLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, mergedLockId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewInt(int64(1000000*len(lockIds))), mergedLock.Coins.AmountOf(denoms[0]))
+			suite.Require().False(mergedLock.IsUnlocking())
+			for _, lockId := range lockIds[1:] {
+				_, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockId)
+				suite.Require().Error(err)
+			}
+			suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, delAddr).Empty())
+
+			// the merged lock is superfluid delegated
+			acc, found := suite.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(suite.Ctx, mergedLockId)
+			suite.Require().True(found)
+			suite.Require().Equal(valAddrs[0].String(), acc.ValAddr)
+			_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, mergedLockId, keeper.StakingSyntheticDenom(denoms[0], valAddrs[0].String()))
+			suite.Require().NoError(err)
+			suite.checkIntermediaryAccountDelegations([]types.SuperfluidIntermediaryAccount{acc})
+			suite.Require().Equal(
+				suite.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(suite.Ctx, denoms[0], mergedLock.Coins.AmountOf(denoms[0])),
+				suite.App.SuperfluidKeeper.GetExpectedDelegationAmount(suite.Ctx, acc))
+
+			// check invariant is fine
+			reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+			suite.Require().False(broken, reason)
+
+			// superfluid delegated locks can't be merged again
+			_, err = suite.App.SuperfluidKeeper.MergeLocksAndSuperfluidDelegate(suite.Ctx, delAddr.String(), []uint64{mergedLockId}, valAddrs[0].String())
+			suite.Require().ErrorIs(err, types.ErrAlreadyUsedSuperfluidLockup)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMergeLocksAndSuperfluidDelegateDuplicateLocks() {
+	suite.SetupTest()
+
+	delAddr := CreateRandomAccounts(1)[0]
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+	unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+
+	lockIds := []uint64{}
+	for i := 0; i < 2; i++ {
+		coins := sdk.Coins{sdk.NewInt64Coin(denoms[0], 1000000)}
+		suite.FundAcc(delAddr, coins)
+		lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, delAddr, coins, unbondingDuration)
+		suite.Require().NoError(err)
+		lockIds = append(lockIds, lock.ID)
+	}
+
+	for _, duplicatedLockIds := range [][]uint64{
+		{lockIds[0], lockIds[1], lockIds[1]},
+		{lockIds[0], lockIds[1], lockIds[0]},
+	} {
+		_, err := suite.App.SuperfluidKeeper.MergeLocksAndSuperfluidDelegate(suite.Ctx, delAddr.String(), duplicatedLockIds, valAddrs[0].String())
+		suite.Require().ErrorIs(err, types.ErrLocksNotMergeable)
+	}
+
+	// nothing is merged or delegated
+	for _, lockId := range lockIds {
+		lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockId)
+		suite.Require().NoError(err)
+		suite.Require().Equal(sdk.NewInt(1000000), lock.Coins[0].Amount)
+		_, found := suite.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(suite.Ctx, lockId)
+		suite.Require().False(found)
+	}
+}
+
 func (suite *KeeperTestSuite) TestSuperfluidUndelegate() {
 	testCases := []struct {
 		name                  string
@@ -527,155 +667,317 @@ func (suite *KeeperTestSuite) TestSuperfluidUnbondLock() {
 	}
 }
 
-// func (suite *KeeperTestSuite) TestSuperfluidRedelegate() {
-// 	testCases := []struct {
-// 		name                    string
-// 		validatorStats          []stakingtypes.BondStatus
-// 		superDelegations        []superfluidDelegation
-// 		superRedelegations      []superfluidRedelegation
-// 		expSuperRedelegationErr []bool
-// 	}{
-// 		{
-// 			"with single validator and single superfluid delegation with single redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{false},
-// 		},
-// 		{
-// 			"with multiple superfluid delegations with single redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}, {0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{false},
-// 		},
-// 		{
-// 			"with multiple superfluid delegations with multiple redelegations",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}, {0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}, {2, 0, 1}}, // lock1 => val0 -> val1, lock2 => val0 -> val1
-// 			[]bool{false, false},
-// 		},
-// 		{
-// 			"try redelegating back from new validator to original validator",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}, {0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}, {1, 1, 0}}, // lock1 => val0 -> val1, lock1 => val1 -> val0
-// 			[]bool{false, true},
-// 		},
-// 		{
-// 			"not available lock id redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{2, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{true},
-// 		},
-// 		{
-// 			"redelegation for same validator",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 0}}, // lock1 => val0 -> val0
-// 			[]bool{true},
-// 		},
-// 	}
-
-// 	for _, tc := range testCases {
-// 		tc := tc
-// 		suite.Run(tc.name, func() {
-// 			suite.SetupTest()
-
-// 			poolId := suite.createGammPool([]string{appparams.BaseCoinUnit, "foo"})
-// 			suite.Require().Equal(poolId, uint64(1))
-
-// 			// setup validators
-// 			valAddrs := suite.SetupValidators(tc.validatorStats)
-
-// 			// setup superfluid delegations
-// 			intermediaryAccs, _ := suite.SetupSuperfluidDelegations(valAddrs, tc.superDelegations)
-// 			suite.checkIntermediaryAccountDelegations(intermediaryAccs)
-
-// 			// execute redelegation and check changes on store
-// 			for index, srd := range tc.superRedelegations {
-// 				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
-// 				if err != nil {
-// 					lock = &lockuptypes.PeriodLock{}
-// 				}
-
-// 				// superfluid redelegate
-// 				err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
-// 				if tc.expSuperRedelegationErr[index] {
-// 					suite.Require().Error(err)
-// 					continue
-// 				}
-// 				suite.Require().NoError(err)
-
-// 				// check previous validator bonding synthetic lockup deletion
-// 				_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				suite.Require().Error(err)
-
-// 				// check unbonding synthetic lockup creation
-// 				params := suite.App.SuperfluidKeeper.GetParams(suite.Ctx)
-// 				synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.UnstakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				suite.Require().NoError(err)
-// 				suite.Require().Equal(synthLock.UnderlyingLockId, srd.lockId)
-// 				suite.Require().Equal(synthLock.Suffix, keeper.UnstakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				suite.Require().Equal(synthLock.EndTime, suite.Ctx.BlockTime().Add(params.UnbondingDuration))
-
-// 				// check synthetic lockup creation
-// 				synthLock2, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSuffix(valAddrs[srd.newValIndex].String()))
-// 				suite.Require().NoError(err)
-// 				suite.Require().Equal(synthLock2.UnderlyingLockId, srd.lockId)
-// 				suite.Require().Equal(synthLock2.Suffix, keeper.StakingSuffix(valAddrs[srd.newValIndex].String()))
-// 				suite.Require().Equal(synthLock2.EndTime, time.Time{})
-
-// 				// check intermediary account creation
-// 				lock, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
-// 				suite.Require().NoError(err)
-
-// 				expAcc := types.NewSuperfluidIntermediaryAccount(lock.Coins[0].Denom, valAddrs[srd.newValIndex].String(), 1)
-// 				gotAcc := suite.App.SuperfluidKeeper.GetIntermediaryAccount(suite.Ctx, expAcc.GetAccAddress())
-// 				suite.Require().Equal(gotAcc.Denom, expAcc.Denom)
-// 				suite.Require().Equal(gotAcc.ValAddr, expAcc.ValAddr)
-
-// 				// check gauge creation
-// 				gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gotAcc.GaugeId)
-// 				suite.Require().NoError(err)
-// 				suite.Require().Equal(gauge.Id, gotAcc.GaugeId)
-// 				suite.Require().Equal(gauge.IsPerpetual, true)
-// 				suite.Require().Equal(gauge.DistributeTo, lockuptypes.QueryCondition{
-// 					LockQueryType: lockuptypes.ByDuration,
-// 					Denom:         expAcc.Denom + keeper.StakingSuffix(valAddrs[srd.newValIndex].String()),
-// 					Duration:      params.UnbondingDuration,
-// 				})
-// 				suite.Require().Equal(gauge.Coins, sdk.Coins(nil))
-// 				suite.Require().Equal(gauge.StartTime, suite.Ctx.BlockTime())
-// 				suite.Require().Equal(gauge.NumEpochsPaidOver, uint64(1))
-// 				suite.Require().Equal(gauge.FilledEpochs, uint64(0))
-// 				suite.Require().Equal(gauge.DistributedCoins, sdk.Coins(nil))
-
-// 				// Check lockID connection with intermediary account
-// 				intAcc := suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, srd.lockId)
-// 				suite.Require().Equal(intAcc.String(), expAcc.GetAccAddress().String())
-
-// 				// check delegation from intermediary account to validator
-// 				_, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, expAcc.GetAccAddress(), valAddrs[srd.newValIndex])
-// 				suite.Require().True(found)
-// 			}
-
-// 			// try redelegating twice
-// 			for index, srd := range tc.superRedelegations {
-// 				if tc.expSuperRedelegationErr[index] {
-// 					continue
-// 				}
-// 				cacheCtx, _ := suite.Ctx.CacheContext()
-// 				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
-// 				suite.Require().NoError(err)
-// 				err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(cacheCtx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
-// 				suite.Require().Error(err)
-// 			}
-// 		})
-// 	}
-// }
+func (suite *KeeperTestSuite) TestPartialSuperfluidUndelegateAndUnbondLock() {
+	suite.SetupTest()
+
+	// Generate delegator addresses
+	delAddrs := CreateRandomAccounts(1)
+
+	// setup validators
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+	// setup superfluid delegations
+	intermediaryAccs, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+	suite.checkIntermediaryAccountDelegations(intermediaryAccs)
+	lock := locks[0]
+	intermediaryAcc := intermediaryAccs[0]
+	valAddr := intermediaryAcc.ValAddr
+	unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+
+	// amounts that aren't part of the lock can't be undelegated
+	for _, invalidCoins := range []sdk.Coins{
+		{sdk.NewInt64Coin(denoms[0], 1000001)},
+		{sdk.NewInt64Coin(denoms[1], 100000)},
+	} {
+		_, err := suite.App.SuperfluidKeeper.PartialSuperfluidUndelegate(suite.Ctx, lock.Owner, lock.ID, invalidCoins)
+		suite.Require().Error(err)
+	}
+
+	// undelegate a tenth of the lock
+	splitLockId, err := suite.App.SuperfluidKeeper.PartialSuperfluidUndelegate(suite.Ctx, lock.Owner, lock.ID, sdk.Coins{sdk.NewInt64Coin(denoms[0], 100000)})
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(lock.ID, splitLockId)
+
+	// the rest of the lock stays delegated
+	updatedLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(900000), updatedLock.Coins.AmountOf(denoms[0]))
+	_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, lock.ID, keeper.StakingSyntheticDenom(denoms[0], valAddr))
+	suite.Require().NoError(err)
+	acc, found := suite.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(suite.Ctx, lock.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(intermediaryAcc.GetAccAddress(), acc.GetAccAddress())
+	expectedDelegation := suite.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(suite.Ctx, denoms[0], sdk.NewInt(900000))
+	suite.Require().Equal(expectedDelegation, suite.App.SuperfluidKeeper.GetExpectedDelegationAmount(suite.Ctx, intermediaryAcc))
+
+	// the split lock is undelegating
+	splitLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, splitLockId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(100000), splitLock.Coins.AmountOf(denoms[0]))
+	suite.Require().False(splitLock.IsUnlocking())
+	_, found = suite.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(suite.Ctx, splitLockId)
+	suite.Require().False(found)
+	_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, splitLockId, keeper.StakingSyntheticDenom(denoms[0], valAddr))
+	suite.Require().Error(err)
+	synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, splitLockId, keeper.UnstakingSyntheticDenom(denoms[0], valAddr))
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.Ctx.BlockTime().Add(unbondingDuration), synthLock.EndTime)
+
+	// check invariant is fine
+	reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+	suite.Require().False(broken, reason)
+
+	// the delegated lock can't be unbonded, partially or not
+	_, err = suite.App.SuperfluidKeeper.PartialSuperfluidUnbondLock(suite.Ctx, lock.ID, lock.Owner, sdk.Coins{sdk.NewInt64Coin(denoms[0], 100000)})
+	suite.Require().ErrorIs(err, types.ErrBondingLockupNotSupported)
+
+	// unbond half of the split lock
+	unlockingLockId, err := suite.App.SuperfluidKeeper.PartialSuperfluidUnbondLock(suite.Ctx, splitLockId, lock.Owner, sdk.Coins{sdk.NewInt64Coin(denoms[0], 50000)})
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(splitLockId, unlockingLockId)
+	splitLock, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, splitLockId)
+	suite.Require().NoError(err)
+	suite.Require().False(splitLock.IsUnlocking())
+	unlockingLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, unlockingLockId)
+	suite.Require().NoError(err)
+	suite.Require().True(unlockingLock.IsUnlocking())
+	suite.Require().Equal(sdk.NewInt(50000), unlockingLock.Coins.AmountOf(denoms[0]))
+
+	// the unlocking lock stays liable for slashes of the validator while unbonding
+	_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, unlockingLockId, keeper.UnstakingSyntheticDenom(denoms[0], valAddr))
+	suite.Require().NoError(err)
+	validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+	suite.Require().True(found)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	power := sdk.TokensToConsensusPower(validator.Tokens, sdk.DefaultPowerReduction)
+	suite.App.StakingKeeper.Slash(suite.Ctx, consAddr, suite.Ctx.BlockHeight(), power, sdk.NewDecWithPrec(5, 2))
+	unlockingLock, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, unlockingLockId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(47500), unlockingLock.Coins.AmountOf(denoms[0]))
+
+	// once the lockup unbonding duration passes, only the unlocking lock is withdrawn
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(unbondingDuration))
+	suite.App.LockupKeeper.DeleteAllMaturedSyntheticLocks(suite.Ctx)
+	suite.App.LockupKeeper.WithdrawAllMaturedLocks(suite.Ctx)
+	_, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, unlockingLockId)
+	suite.Require().Error(err)
+	balances := suite.App.BankKeeper.GetAllBalances(suite.Ctx, lock.OwnerAddress())
+	suite.Require().Equal(sdk.NewInt(47500), balances.AmountOf(denoms[0]))
+	_, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, splitLockId)
+	suite.Require().NoError(err)
+
+	reason, broken = keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+	suite.Require().False(broken, reason)
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidRedelegate() {
+	testCases := []struct {
+		name                    string
+		validatorStats          []stakingtypes.BondStatus
+		delegatorNumber         int
+		superDelegations        []superfluidDelegation
+		superRedelegations      []superfluidRedelegation
+		expSuperRedelegationErr []bool
+	}{
+		{
+			"with single superfluid delegation and single redelegation",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			1,
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
+			[]bool{false},
+		},
+		{
+			"with multiple superfluid delegations and single redelegation",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			2,
+			[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
+			[]bool{false},
+		},
+		{
+			"with multiple superfluid delegations and multiple redelegations",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			2,
+			[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}, {2, 0, 1}}, // lock1 => val0 -> val1, lock2 => val0 -> val1
+			[]bool{false, false},
+		},
+		{
+			"try redelegating back from new validator to original validator",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			1,
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}, {1, 1, 0}}, // lock1 => val0 -> val1, lock1 => val1 -> val0
+			[]bool{false, true},
+		},
+		{
+			"not available lock id redelegation",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			1,
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{2, 0, 1}}, // lock2 => val0 -> val1
+			[]bool{true},
+		},
+		{
+			"redelegation for same validator",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			1,
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 0}}, // lock1 => val0 -> val0
+			[]bool{true},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// Generate delegator addresses
+			delAddrs := CreateRandomAccounts(tc.delegatorNumber)
+
+			// setup validators
+			valAddrs := suite.SetupValidators(tc.validatorStats)
+
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+			// setup superfluid delegations
+			intermediaryAccs, _ := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, tc.superDelegations, denoms)
+			suite.checkIntermediaryAccountDelegations(intermediaryAccs)
+
+			// execute redelegation and check changes on store
+			for index, srd := range tc.superRedelegations {
+				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
+				if err != nil {
+					lock = &lockuptypes.PeriodLock{}
+				}
+
+				// superfluid redelegate
+				oldValAddr, err := suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
+				if tc.expSuperRedelegationErr[index] {
+					suite.Require().Error(err)
+					continue
+				}
+				suite.Require().NoError(err)
+				suite.Require().Equal(valAddrs[srd.oldValIndex].String(), oldValAddr)
+
+				// check previous validator bonding synthetic lockup deletion
+				_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSyntheticDenom(lock.Coins[0].Denom, oldValAddr))
+				suite.Require().Error(err)
+
+				// check unbonding synthetic lockup creation, which keeps the lock liable for slashes of the previous validator
+				unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+				synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.UnstakingSyntheticDenom(lock.Coins[0].Denom, oldValAddr))
+				suite.Require().NoError(err)
+				suite.Require().Equal(synthLock.EndTime, suite.Ctx.BlockTime().Add(unbondingDuration))
+
+				// check new validator bonding synthetic lockup creation
+				newValAddr := valAddrs[srd.newValIndex]
+				synthLock, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSyntheticDenom(lock.Coins[0].Denom, newValAddr.String()))
+				suite.Require().NoError(err)
+				suite.Require().Equal(synthLock.EndTime, time.Time{})
+
+				// check lockID connection with the new intermediary account
+				expAcc := types.NewSuperfluidIntermediaryAccount(lock.Coins[0].Denom, newValAddr.String(), 0)
+				gotAcc, found := suite.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(suite.Ctx, srd.lockId)
+				suite.Require().True(found)
+				suite.Require().Equal(expAcc.GetAccAddress(), gotAcc.GetAccAddress())
+
+				// check delegation from intermediary account to the new validator
+				_, found = suite.App.StakingKeeper.GetDelegation(suite.Ctx, expAcc.GetAccAddress(), newValAddr)
+				suite.Require().True(found)
+			}
+
+			// check invariant is fine
+			reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+			suite.Require().False(broken, reason)
+
+			// try redelegating twice, which staking forbids as a transitive redelegation
+			for index, srd := range tc.superRedelegations {
+				if tc.expSuperRedelegationErr[index] {
+					continue
+				}
+				cacheCtx, _ := suite.Ctx.CacheContext()
+				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
+				suite.Require().NoError(err)
+				_, err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(cacheCtx, lock.Owner, srd.lockId, valAddrs[srd.oldValIndex].String())
+				suite.Require().ErrorIs(err, stakingtypes.ErrTransitiveRedelegation)
+			}
+
+			// once the redelegations mature, the locks can be redelegated again
+			unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(unbondingDuration))
+			suite.App.LockupKeeper.DeleteAllMaturedSyntheticLocks(suite.Ctx)
+			for index, srd := range tc.superRedelegations {
+				if tc.expSuperRedelegationErr[index] {
+					continue
+				}
+				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
+				suite.Require().NoError(err)
+				_, err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, srd.lockId, valAddrs[srd.oldValIndex].String())
+				suite.Require().NoError(err)
+			}
+
+			reason, broken = keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+			suite.Require().False(broken, reason)
+		})
+	}
+}
+
+// TestSuperfluidRedelegateWithoutStakingRedelegation shows why SuperfluidRedelegate doesn't redelegate
+// with staking's BeginRedelegation, which keeps the delegation with the intermediary account of the old validator,
+// and limits the redelegations of all locks from one validator to another to staking's max entries.
+func (suite *KeeperTestSuite) TestSuperfluidRedelegateWithoutStakingRedelegation() {
+	suite.SetupTest()
+	maxEntries := int(suite.App.StakingKeeper.MaxEntries(suite.Ctx))
+	delAddrs := CreateRandomAccounts(maxEntries + 1)
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+	superDelegations := []superfluidDelegation{}
+	for i := range delAddrs {
+		superDelegations = append(superDelegations, superfluidDelegation{int64(i), 0, 0, 1000000})
+	}
+	intermediaryAccs, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, superDelegations, denoms)
+	oldAcc := intermediaryAccs[0]
+	newAcc := types.NewSuperfluidIntermediaryAccount(denoms[0], valAddrs[1].String(), 0)
+
+	// a staking redelegation of the old intermediary account's delegation stays the old intermediary account's,
+	// which superfluid staking only tracks at the old validator
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	delegation, found := suite.App.StakingKeeper.GetDelegation(cacheCtx, oldAcc.GetAccAddress(), valAddrs[0])
+	suite.Require().True(found)
+	shares := delegation.Shares.QuoInt64(int64(len(locks) + 1))
+	_, err := suite.App.StakingKeeper.BeginRedelegation(cacheCtx, oldAcc.GetAccAddress(), valAddrs[0], valAddrs[1], shares)
+	suite.Require().NoError(err)
+	_, found = suite.App.StakingKeeper.GetDelegation(cacheCtx, oldAcc.GetAccAddress(), valAddrs[1])
+	suite.Require().True(found)
+	_, found = suite.App.StakingKeeper.GetDelegation(cacheCtx, newAcc.GetAccAddress(), valAddrs[1])
+	suite.Require().False(found)
+
+	// and every lock redelegated between the same validators adds an entry to the same staking redelegation,
+	// so that only max entries of them could be redelegated per unbonding period
+	for i := 1; i < maxEntries; i++ {
+		_, err = suite.App.StakingKeeper.BeginRedelegation(cacheCtx, oldAcc.GetAccAddress(), valAddrs[0], valAddrs[1], shares)
+		suite.Require().NoError(err)
+	}
+	_, err = suite.App.StakingKeeper.BeginRedelegation(cacheCtx, oldAcc.GetAccAddress(), valAddrs[0], valAddrs[1], shares)
+	suite.Require().ErrorIs(err, stakingtypes.ErrMaxRedelegationEntries)
+
+	// superfluid redelegations of more locks than that go through, to the new intermediary account
+	for _, lock := range locks {
+		_, err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, lock.ID, valAddrs[1].String())
+		suite.Require().NoError(err)
+	}
+	_, found = suite.App.StakingKeeper.GetDelegation(suite.Ctx, oldAcc.GetAccAddress(), valAddrs[1])
+	suite.Require().False(found)
+	suite.checkIntermediaryAccountDelegations([]types.SuperfluidIntermediaryAccount{newAcc})
+	reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+	suite.Require().False(broken, reason)
+}
 
 func (suite *KeeperTestSuite) TestRefreshIntermediaryDelegationAmounts() {
 	testCases := []struct {
@@ -913,6 +1215,148 @@ func (suite *KeeperTestSuite) TestRefreshIntermediaryDelegationAmounts() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRefreshIntermediaryDelegationAmountsWithRiskParams() {
+	suite.SetupTest()
+
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+	delAddrs := CreateRandomAccounts(3)
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	intermediaryAccs, _ := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}, {1, 1, 0, 1000000}}, denoms)
+
+	// total tokens delegated by the intermediary accounts
+	totalDelegatedTokens := func() sdk.Int {
+		total := sdk.ZeroInt()
+		for _, acc := range intermediaryAccs {
+			valAddr, err := sdk.ValAddressFromBech32(acc.ValAddr)
+			suite.Require().NoError(err)
+			delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, acc.GetAccAddress(), valAddr)
+			suite.Require().True(found)
+			validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+			suite.Require().True(found)
+			total = total.Add(validator.TokensFromShares(delegation.Shares).TruncateInt())
+		}
+		return total
+	}
+	checkInvariant := func() {
+		reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+		suite.Require().False(broken, reason)
+	}
+	asset := suite.App.SuperfluidKeeper.GetSuperfluidAsset(suite.Ctx, denoms[0])
+	minRiskFactorAmount := suite.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(suite.Ctx, denoms[0], sdk.NewInt(1000000))
+	suite.Require().Equal(minRiskFactorAmount.MulRaw(2), totalDelegatedTokens())
+
+	// a risk factor below the minimum risk factor param changes nothing
+	riskFactor := sdk.NewDecWithPrec(1, 1)
+	asset.RiskFactor = &riskFactor
+	suite.App.SuperfluidKeeper.SetSuperfluidAsset(suite.Ctx, asset)
+	suite.Require().Equal(minRiskFactorAmount, suite.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(suite.Ctx, denoms[0], sdk.NewInt(1000000)))
+
+	// a higher risk factor cuts the superfluid OSMO of the asset
+	riskFactor = sdk.NewDecWithPrec(8, 1)
+	asset.RiskFactor = &riskFactor
+	suite.App.SuperfluidKeeper.SetSuperfluidAsset(suite.Ctx, asset)
+	suite.App.SuperfluidKeeper.RefreshIntermediaryDelegationAmounts(suite.Ctx)
+	riskFactorAmount := suite.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(suite.Ctx, denoms[0], sdk.NewInt(1000000))
+	suite.Require().True(riskFactorAmount.LT(minRiskFactorAmount))
+	suite.Require().Equal(riskFactorAmount.MulRaw(2), totalDelegatedTokens())
+	checkInvariant()
+
+	// a max superfluid OSMO above the total changes nothing
+	maxSuperfluidOsmo := riskFactorAmount.MulRaw(2)
+	asset.MaxSuperfluidOsmo = &maxSuperfluidOsmo
+	suite.App.SuperfluidKeeper.SetSuperfluidAsset(suite.Ctx, asset)
+	suite.App.SuperfluidKeeper.RefreshIntermediaryDelegationAmounts(suite.Ctx)
+	suite.Require().Equal(maxSuperfluidOsmo, totalDelegatedTokens())
+
+	// a lower max superfluid OSMO caps the total, without changing the multiplier
+	multiplier := suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, denoms[0])
+	maxSuperfluidOsmo = riskFactorAmount
+	asset.MaxSuperfluidOsmo = &maxSuperfluidOsmo
+	suite.App.SuperfluidKeeper.SetSuperfluidAsset(suite.Ctx, asset)
+	suite.App.SuperfluidKeeper.RefreshIntermediaryDelegationAmounts(suite.Ctx)
+	suite.Require().True(totalDelegatedTokens().LTE(maxSuperfluidOsmo))
+	suite.Require().True(totalDelegatedTokens().GTE(maxSuperfluidOsmo.SubRaw(2)))
+	suite.Require().Equal(multiplier, suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, denoms[0]))
+	checkInvariant()
+
+	// a new superfluid delegation stays within the cap
+	lock := suite.SetupSuperfluidDelegate(delAddrs[2], valAddrs[1], denoms[0], 1000000)
+	suite.Require().True(totalDelegatedTokens().LTE(maxSuperfluidOsmo))
+	suite.Require().True(totalDelegatedTokens().GTE(maxSuperfluidOsmo.SubRaw(3)))
+	checkInvariant()
+
+	// undelegating it gives its share of the cap back to the other delegations
+	err := suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, lock.Owner, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().True(totalDelegatedTokens().LTE(maxSuperfluidOsmo))
+	suite.Require().True(totalDelegatedTokens().GTE(maxSuperfluidOsmo.SubRaw(2)))
+	checkInvariant()
+
+	// a raised max superfluid OSMO applies at the next refresh rather than the next epoch's multiplier
+	maxSuperfluidOsmo = riskFactorAmount.MulRaw(2)
+	asset.MaxSuperfluidOsmo = &maxSuperfluidOsmo
+	suite.App.SuperfluidKeeper.SetSuperfluidAsset(suite.Ctx, asset)
+	suite.App.SuperfluidKeeper.RefreshIntermediaryDelegationAmounts(suite.Ctx)
+	suite.Require().Equal(maxSuperfluidOsmo, totalDelegatedTokens())
+	checkInvariant()
+}
+
+// TestRefreshCappedIntermediaryDelegationAmountsManyValidators checks that the refreshes, which read the total
+// superfluid delegation of a capped denom once rather than for every intermediary account, delegate each of many
+// intermediary accounts of the denom what GetExpectedDelegationAmount expects of it.
+func (suite *KeeperTestSuite) TestRefreshCappedIntermediaryDelegationAmountsManyValidators() {
+	suite.SetupTest()
+
+	numVals := 12
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+	delAddrs := CreateRandomAccounts(numVals + 1)
+	bondStatuses := []stakingtypes.BondStatus{}
+	superDelegations := []superfluidDelegation{}
+	for i := 0; i < numVals; i++ {
+		bondStatuses = append(bondStatuses, stakingtypes.Bonded)
+		superDelegations = append(superDelegations, superfluidDelegation{int64(i), int64(i), 0, int64(i+1) * 1000000})
+	}
+	valAddrs := suite.SetupValidators(bondStatuses)
+	intermediaryAccs, _ := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, superDelegations, denoms)
+	suite.Require().Len(intermediaryAccs, numVals)
+
+	// checks that every intermediary account delegates what is expected of it, and returns their total delegation
+	checkDelegations := func() sdk.Int {
+		total := sdk.ZeroInt()
+		for _, acc := range suite.App.SuperfluidKeeper.GetAllIntermediaryAccounts(suite.Ctx) {
+			valAddr, err := sdk.ValAddressFromBech32(acc.ValAddr)
+			suite.Require().NoError(err)
+			delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, acc.GetAccAddress(), valAddr)
+			suite.Require().True(found)
+			validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+			suite.Require().True(found)
+			tokens := validator.TokensFromShares(delegation.Shares).TruncateInt()
+			suite.Require().Equal(suite.App.SuperfluidKeeper.GetExpectedDelegationAmount(suite.Ctx, acc), tokens)
+			total = total.Add(tokens)
+		}
+		reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+		suite.Require().False(broken, reason)
+		return total
+	}
+	uncappedTotal := checkDelegations()
+
+	// capping the denom at half of its superfluid OSMO scales every intermediary account down at the next refresh
+	maxSuperfluidOsmo := uncappedTotal.QuoRaw(2)
+	asset := suite.App.SuperfluidKeeper.GetSuperfluidAsset(suite.Ctx, denoms[0])
+	asset.MaxSuperfluidOsmo = &maxSuperfluidOsmo
+	suite.App.SuperfluidKeeper.SetSuperfluidAsset(suite.Ctx, asset)
+	suite.App.SuperfluidKeeper.RefreshIntermediaryDelegationAmounts(suite.Ctx)
+	total := checkDelegations()
+	suite.Require().True(total.LTE(maxSuperfluidOsmo))
+	suite.Require().True(total.GTE(maxSuperfluidOsmo.SubRaw(int64(numVals))))
+
+	// a new superfluid delegation refreshes every intermediary account of the denom
+	suite.SetupSuperfluidDelegate(delAddrs[numVals], valAddrs[0], denoms[0], 1000000)
+	total = checkDelegations()
+	suite.Require().True(total.LTE(maxSuperfluidOsmo))
+	suite.Require().True(total.GTE(maxSuperfluidOsmo.SubRaw(int64(numVals))))
+}
+
 func (suite *KeeperTestSuite) TestSuperfluidDelegationGovernanceVoting() {
 	testCases := []struct {
 		name              string
@@ -1040,3 +1484,57 @@ func (suite *KeeperTestSuite) TestSuperfluidDelegationGovernanceVoting() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSuperfluidDelegatorVoteOverridesValidator() {
+	suite.SetupTest()
+
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+	delAddrs := CreateRandomAccounts(1)
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	_, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+	superfluidOsmo := suite.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(suite.Ctx, denoms[0], sdk.NewInt(1000000))
+
+	proposal, err := suite.App.GovKeeper.SubmitProposal(suite.Ctx, govtypes.NewTextProposal("title", "description"))
+	suite.Require().NoError(err)
+	suite.App.GovKeeper.ActivateVotingPeriod(suite.Ctx, proposal)
+
+	// tally in a cache context, as tallying deletes the votes
+	tally := func() govtypes.TallyResult {
+		cacheCtx, _ := suite.Ctx.CacheContext()
+		_, _, tallyResults := suite.App.GovKeeper.Tally(cacheCtx, proposal)
+		return tallyResults
+	}
+	validatorTokens := func(valAddr sdk.ValAddress) sdk.Int {
+		validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+		suite.Require().True(found)
+		return validator.GetTokens()
+	}
+
+	// the validator votes with the intermediary account's delegation
+	err = suite.App.GovKeeper.AddVote(suite.Ctx, proposal.ProposalId, sdk.AccAddress(valAddrs[0]), govtypes.NewNonSplitVoteOption(govtypes.OptionYes))
+	suite.Require().NoError(err)
+	tallyResults := tally()
+	suite.Require().Equal(validatorTokens(valAddrs[0]), tallyResults.Yes)
+	suite.Require().True(tallyResults.No.IsZero())
+
+	// the superfluid delegator overrides the validator's vote with their own
+	err = suite.App.GovKeeper.AddVote(suite.Ctx, proposal.ProposalId, delAddrs[0], govtypes.NewNonSplitVoteOption(govtypes.OptionNo))
+	suite.Require().NoError(err)
+	tallyResults = tally()
+	suite.Require().Equal(superfluidOsmo, tallyResults.No)
+	suite.Require().Equal(validatorTokens(valAddrs[0]).Sub(superfluidOsmo), tallyResults.Yes)
+
+	// after redelegating, the delegator's vote is counted once, at the new validator
+	_, err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, delAddrs[0].String(), locks[0].ID, valAddrs[1].String())
+	suite.Require().NoError(err)
+	tallyResults = tally()
+	suite.Require().Equal(superfluidOsmo, tallyResults.No)
+	suite.Require().Equal(validatorTokens(valAddrs[0]), tallyResults.Yes)
+
+	// after undelegating, the delegator has no superfluid vote left
+	err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, delAddrs[0].String(), locks[0].ID)
+	suite.Require().NoError(err)
+	tallyResults = tally()
+	suite.Require().True(tallyResults.No.IsZero())
+	suite.Require().Equal(validatorTokens(valAddrs[0]), tallyResults.Yes)
+}
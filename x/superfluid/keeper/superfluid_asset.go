@@ -21,21 +21,29 @@ func (k Keeper) BeginUnwindSuperfluidAsset(ctx sdk.Context, epochNum int64, asse
 	k.DeleteSuperfluidAsset(ctx, asset.Denom)
 }
 
+// GetRiskFactor returns the risk factor of asset, which is its own risk factor
+// if it is higher than the minimum risk factor param.
+func (k Keeper) GetRiskFactor(ctx sdk.Context, asset types.SuperfluidAsset) sdk.Dec {
+	minRiskFactor := k.GetParams(ctx).MinimumRiskFactor
+	if asset.RiskFactor != nil && asset.RiskFactor.GT(minRiskFactor) {
+		return *asset.RiskFactor
+	}
+	return minRiskFactor
+}
+
 // Returns amount * (1 - k.RiskFactor(asset))
-// Fow now, the risk factor is a global constant.
-// It will move towards per pool functions.
 func (k Keeper) GetRiskAdjustedOsmoValue(ctx sdk.Context, asset types.SuperfluidAsset, amount sdk.Int) sdk.Int {
-	minRiskFactor := k.GetParams(ctx).MinimumRiskFactor
-	return amount.Sub(amount.ToDec().Mul(minRiskFactor).RoundInt())
+	riskFactor := k.GetRiskFactor(ctx, asset)
+	return amount.Sub(amount.ToDec().Mul(riskFactor).RoundInt())
 }
 
 // y = x - (x * minRisk)
 // y = x (1 - minRisk)
 // y / (1 - minRisk) = x
 
-func (k Keeper) UnriskAdjustOsmoValue(ctx sdk.Context, amount sdk.Dec) sdk.Dec {
-	minRiskFactor := k.GetParams(ctx).MinimumRiskFactor
-	return amount.Quo(sdk.OneDec().Sub(minRiskFactor))
+func (k Keeper) UnriskAdjustOsmoValue(ctx sdk.Context, asset types.SuperfluidAsset, amount sdk.Dec) sdk.Dec {
+	riskFactor := k.GetRiskFactor(ctx, asset)
+	return amount.Quo(sdk.OneDec().Sub(riskFactor))
 }
 
 func (k Keeper) AddNewSuperfluidAsset(ctx sdk.Context, asset types.SuperfluidAsset) {
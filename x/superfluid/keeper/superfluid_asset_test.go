@@ -50,4 +50,21 @@ func (suite *KeeperTestSuite) TestGetRiskAdjustedOsmoValue() {
 		sdk.NewInt(100),
 	)
 	suite.Require().Equal(sdk.NewInt(50), adjustedValue)
+
+	// the asset's risk factor is used when it's higher than the minimum risk factor
+	riskFactor := sdk.NewDecWithPrec(6, 1)
+	adjustedValue = suite.App.SuperfluidKeeper.GetRiskAdjustedOsmoValue(
+		suite.Ctx,
+		types.SuperfluidAsset{Denom: "gamm/pool/1", AssetType: types.SuperfluidAssetTypeLPShare, RiskFactor: &riskFactor},
+		sdk.NewInt(100),
+	)
+	suite.Require().Equal(sdk.NewInt(40), adjustedValue)
+
+	riskFactor = sdk.NewDecWithPrec(1, 1)
+	adjustedValue = suite.App.SuperfluidKeeper.GetRiskAdjustedOsmoValue(
+		suite.Ctx,
+		types.SuperfluidAsset{Denom: "gamm/pool/1", AssetType: types.SuperfluidAssetTypeLPShare, RiskFactor: &riskFactor},
+		sdk.NewInt(100),
+	)
+	suite.Require().Equal(sdk.NewInt(50), adjustedValue)
 }
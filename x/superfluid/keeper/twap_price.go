@@ -1,7 +1,11 @@
 package keeper
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/gogo/protobuf/proto"
+	"github.com/osmosis-labs/osmosis/v7/osmomath"
 	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
 
@@ -9,6 +13,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// weightedPool is implemented by pools whose spot prices are set by the weights of their assets,
+// such as balancer pools.
+type weightedPool interface {
+	GetTokenWeight(denom string) (sdk.Int, error)
+	GetTotalWeight() sdk.Int
+}
+
 // This function calculates the osmo equivalent worth of an LP share.
 // It is intended to eventually use the TWAP of the worth of an LP share
 // once that is exposed from the gamm module.
@@ -17,6 +28,65 @@ func (k Keeper) calculateOsmoBackingPerShare(pool gammtypes.PoolI, osmoInPool sd
 	return twap
 }
 
+// calculateTwapOsmoBackingPerShare calculates the osmo equivalent worth of an LP share,
+// from the geometric TWAP of the pool's prices since startTime.
+//
+// Swaps move a weighted pool's balances, but keep its invariant V = prod_i B_i^{w_i} constant
+// (up to swap fees). So rather than reading the OSMO balance of the pool, we compute the OSMO
+// balance the pool would have for its current invariant, if its prices were the TWAPs:
+// for the OSMO price of asset i, p_i = (B_osmo / w_osmo) / (B_i / w_i), this is
+//
+//	B_osmo = V * prod_{i != osmo} (p_i * w_osmo / w_i)^{w_i}
+//
+// with normalized weights. This is computed in log2 space, to avoid fractional powers of large balances.
+// Manipulating the pool's balances right before this is called thus only changes the result
+// by as much as the manipulated price moves the TWAP.
+func (k Keeper) calculateTwapOsmoBackingPerShare(ctx sdk.Context, pool gammtypes.PoolI, bondDenom string, startTime time.Time) (sdk.Dec, error) {
+	wPool, ok := pool.(weightedPool)
+	if !ok {
+		return sdk.Dec{}, fmt.Errorf("pool %d is not a weighted pool, can not compute its twap osmo backing", pool.GetId())
+	}
+	totalWeight := osmomath.NewDecFromBigInt(wPool.GetTotalWeight().BigInt())
+	osmoWeight, err := wPool.GetTokenWeight(bondDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	log2OsmoWeight := osmomath.NewDecFromBigInt(osmoWeight.BigInt()).LogBase2()
+
+	log2OsmoBacking := osmomath.ZeroDec()
+	for _, asset := range pool.GetTotalPoolLiquidity(ctx) {
+		weight, err := wPool.GetTokenWeight(asset.Denom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		if !asset.Amount.IsPositive() || !weight.IsPositive() {
+			return sdk.Dec{}, fmt.Errorf("pool %d has no balance or weight for %s", pool.GetId(), asset.Denom)
+		}
+		normalizedWeight := osmomath.NewDecFromBigInt(weight.BigInt()).Quo(totalWeight)
+
+		// w_i * log2(B_i)
+		log2Term := osmomath.NewDecFromBigInt(asset.Amount.BigInt()).LogBase2()
+		if asset.Denom != bondDenom {
+			twap, err := k.tk.GetGeometricTwapToNow(ctx, pool.GetId(), bondDenom, asset.Denom, startTime)
+			if err != nil {
+				return sdk.Dec{}, err
+			}
+			if !twap.IsPositive() {
+				return sdk.Dec{}, fmt.Errorf("pool %d has a non-positive twap for %s: %s", pool.GetId(), asset.Denom, twap)
+			}
+			// + w_i * log2(p_i * w_osmo / w_i)
+			log2Term = log2Term.
+				Add(osmomath.BigDecFromSDKDec(twap).LogBase2()).
+				Add(log2OsmoWeight).
+				Sub(osmomath.NewDecFromBigInt(weight.BigInt()).LogBase2())
+		}
+		log2OsmoBacking = log2OsmoBacking.Add(normalizedWeight.Mul(log2Term))
+	}
+
+	osmoBacking := osmomath.Exp2(log2OsmoBacking)
+	return osmoBacking.Quo(osmomath.NewDecFromBigInt(pool.GetTotalShares().BigInt())).SDKDec(), nil
+}
+
 func (k Keeper) SetOsmoEquivalentMultiplier(ctx sdk.Context, epoch int64, denom string, multiplier sdk.Dec) {
 	store := ctx.KVStore(k.storeKey)
 	prefixStore := prefix.NewStore(store, types.KeyPrefixTokenMultiplier)
@@ -32,14 +102,40 @@ func (k Keeper) SetOsmoEquivalentMultiplier(ctx sdk.Context, epoch int64, denom
 	prefixStore.Set([]byte(denom), bz)
 }
 
+// GetSuperfluidOSMOTokens returns how much OSMO amount of denom delegates, which is its risk adjusted OSMO value.
+// If what all superfluid delegations of denom are worth exceeds its max superfluid OSMO,
+// it is scaled down so that they delegate the max superfluid OSMO in total.
 func (k Keeper) GetSuperfluidOSMOTokens(ctx sdk.Context, denom string, amount sdk.Int) sdk.Int {
-	multiplier := k.GetOsmoEquivalentMultiplier(ctx, denom)
+	asset := k.GetSuperfluidAsset(ctx, denom)
+	if asset.MaxSuperfluidOsmo == nil {
+		return k.getRiskAdjustedOsmoTokens(ctx, asset, amount)
+	}
+	return k.getSuperfluidOSMOTokensWithTotal(ctx, asset, amount, k.getTotalSuperfluidDelegatedAmount(ctx, denom))
+}
+
+// getSuperfluidOSMOTokensWithTotal returns GetSuperfluidOSMOTokens of amount of asset, given the total amount of asset
+// superfluid delegated, which reading takes a pass over all intermediary accounts.
+// Callers scaling the amounts of many intermediary accounts or locks read the total once and pass it here.
+func (k Keeper) getSuperfluidOSMOTokensWithTotal(ctx sdk.Context, asset types.SuperfluidAsset, amount, totalAmount sdk.Int) sdk.Int {
+	osmoAmount := k.getRiskAdjustedOsmoTokens(ctx, asset, amount)
+	if asset.MaxSuperfluidOsmo == nil || osmoAmount.IsZero() {
+		return osmoAmount
+	}
+
+	totalOsmoAmount := k.getRiskAdjustedOsmoTokens(ctx, asset, totalAmount)
+	if totalOsmoAmount.LTE(*asset.MaxSuperfluidOsmo) {
+		return osmoAmount
+	}
+	return osmoAmount.Mul(*asset.MaxSuperfluidOsmo).Quo(totalOsmoAmount)
+}
+
+func (k Keeper) getRiskAdjustedOsmoTokens(ctx sdk.Context, asset types.SuperfluidAsset, amount sdk.Int) sdk.Int {
+	multiplier := k.GetOsmoEquivalentMultiplier(ctx, asset.Denom)
 	if multiplier.IsZero() {
 		return sdk.ZeroInt()
 	}
 
 	decAmt := multiplier.Mul(amount.ToDec())
-	asset := k.GetSuperfluidAsset(ctx, denom)
 	return k.GetRiskAdjustedOsmoValue(ctx, asset, decAmt.RoundInt())
 }
 
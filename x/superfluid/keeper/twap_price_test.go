@@ -1,9 +1,14 @@
 package keeper_test
 
 import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func (suite *KeeperTestSuite) TestOsmoEquivalentMultiplierSetGetDeleteFlow() {
@@ -50,3 +55,168 @@ func (suite *KeeperTestSuite) TestOsmoEquivalentMultiplierSetGetDeleteFlow() {
 	multiplier = suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, "gamm/pool/1")
 	suite.Require().Equal(multiplier, sdk.NewDec(0))
 }
+
+// createZeroFeeBalancerPool creates a balancer pool without swap fees,
+// so that swaps keep the pool invariant constant.
+func (suite *KeeperTestSuite) createZeroFeeBalancerPool(poolAssets []balancer.PoolAsset) uint64 {
+	suite.App.GAMMKeeper.SetParams(suite.Ctx, gammtypes.NewParams(sdk.Coins{}))
+	coins := sdk.Coins{}
+	for _, asset := range poolAssets {
+		coins = coins.Add(asset.Token)
+	}
+	acc := CreateRandomAccounts(1)[0]
+	suite.FundAcc(acc, coins)
+
+	msg := balancer.NewMsgCreateBalancerPool(acc, balancer.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}, poolAssets, "")
+	poolId, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, msg)
+	suite.Require().NoError(err)
+	return poolId
+}
+
+func (suite *KeeperTestSuite) updateMultiplierWithMethod(asset types.SuperfluidAsset, method types.OsmoEquivalentMultiplierMethod) sdk.Dec {
+	params := suite.App.SuperfluidKeeper.GetParams(suite.Ctx)
+	params.OsmoEquivalentMultiplierMethod = method
+	suite.App.SuperfluidKeeper.SetParams(suite.Ctx, params)
+	err := suite.App.SuperfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, asset, 2)
+	suite.Require().NoError(err)
+	return suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, asset.Denom)
+}
+
+// requireApproxEqual checks that actual is within relTolerance of expected, relative to expected.
+func (suite *KeeperTestSuite) requireApproxEqual(expected, actual, relTolerance sdk.Dec) {
+	relDiff := expected.Sub(actual).Abs().Quo(expected)
+	suite.Require().True(relDiff.LTE(relTolerance), "expected %s, got %s (relative difference %s)", expected, actual, relDiff)
+}
+
+// TestTwapOsmoEquivalentMultiplier checks that the twap multiplier method matches the spot method
+// on pools with a stable price, and resists manipulation of the pool right at the epoch boundary.
+func (suite *KeeperTestSuite) TestTwapOsmoEquivalentMultiplier() {
+	bondDenom := "stake"
+	twoAssetPool := []balancer.PoolAsset{
+		{Token: sdk.NewInt64Coin(bondDenom, 2_000_000_000_000), Weight: sdk.NewInt(100)},
+		{Token: sdk.NewInt64Coin("foo", 500_000_000_000), Weight: sdk.NewInt(100)},
+	}
+	threeAssetPool := []balancer.PoolAsset{
+		{Token: sdk.NewInt64Coin(bondDenom, 2_000_000_000_000), Weight: sdk.NewInt(300)},
+		{Token: sdk.NewInt64Coin("foo", 1_000_000_000_000), Weight: sdk.NewInt(100)},
+		{Token: sdk.NewInt64Coin("bar", 300_000_000_000), Weight: sdk.NewInt(200)},
+	}
+
+	testCases := []struct {
+		name       string
+		poolAssets []balancer.PoolAsset
+		// manipulation is swapped into the pool right before the epoch boundary, if set.
+		manipulation    sdk.Coin
+		manipulationOut string
+	}{
+		{
+			name:       "two asset pool, no manipulation",
+			poolAssets: twoAssetPool,
+		},
+		{
+			name:       "three asset weighted pool, no manipulation",
+			poolAssets: threeAssetPool,
+		},
+		{
+			name:            "two asset pool, osmo drained at the epoch boundary",
+			poolAssets:      twoAssetPool,
+			manipulation:    sdk.NewInt64Coin("foo", 1_500_000_000_000),
+			manipulationOut: bondDenom,
+		},
+		{
+			name:            "two asset pool, osmo added at the epoch boundary",
+			poolAssets:      twoAssetPool,
+			manipulation:    sdk.NewInt64Coin(bondDenom, 6_000_000_000_000),
+			manipulationOut: "foo",
+		},
+		{
+			name:            "three asset weighted pool, osmo drained at the epoch boundary",
+			poolAssets:      threeAssetPool,
+			manipulation:    sdk.NewInt64Coin("bar", 900_000_000_000),
+			manipulationOut: bondDenom,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.Require().Equal(bondDenom, suite.App.StakingKeeper.BondDenom(suite.Ctx))
+			// the twap method is the default.
+			suite.Require().Equal(types.OsmoEquivalentMultiplierMethodTwap, types.DefaultParams().OsmoEquivalentMultiplierMethod)
+
+			poolId := suite.createZeroFeeBalancerPool(tc.poolAssets)
+			asset := types.SuperfluidAsset{
+				Denom:     gammtypes.GetPoolShareDenom(poolId),
+				AssetType: types.SuperfluidAssetTypeLPShare,
+			}
+			suite.App.SuperfluidKeeper.AddNewSuperfluidAsset(suite.Ctx, asset)
+			fairMultiplier := tc.poolAssets[0].Token.Amount.ToDec().Quo(gammtypes.InitPoolSharesSupply.ToDec())
+
+			// the superfluid epoch in the test setup lasts an hour.
+			// The manipulation is included in the last block before the epoch boundary.
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour - 5*time.Second))
+			if !tc.manipulation.IsNil() {
+				attacker := CreateRandomAccounts(1)[0]
+				suite.FundAcc(attacker, sdk.NewCoins(tc.manipulation))
+				_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, attacker, poolId, tc.manipulation, tc.manipulationOut, sdk.OneInt())
+				suite.Require().NoError(err)
+			}
+			suite.App.TwapKeeper.EndBlock(suite.Ctx)
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(5 * time.Second))
+
+			spotMultiplier := suite.updateMultiplierWithMethod(asset, types.OsmoEquivalentMultiplierMethodSpot)
+			twapMultiplier := suite.updateMultiplierWithMethod(asset, types.OsmoEquivalentMultiplierMethodTwap)
+
+			if tc.manipulation.IsNil() {
+				suite.Require().Equal(fairMultiplier, spotMultiplier)
+				suite.requireApproxEqual(fairMultiplier, twapMultiplier, sdk.NewDecWithPrec(1, 6))
+				return
+			}
+			// the manipulation moves the spot multiplier by a lot,
+			// while the twap multiplier only moves by the short time the manipulated price was in effect.
+			suite.Require().True(fairMultiplier.Sub(spotMultiplier).Abs().Quo(fairMultiplier).GT(sdk.NewDecWithPrec(5, 1)),
+				"spot multiplier %s did not move from %s", spotMultiplier, fairMultiplier)
+			suite.requireApproxEqual(fairMultiplier, twapMultiplier, sdk.NewDecWithPrec(1, 2))
+		})
+	}
+}
+
+// TestTwapOsmoEquivalentMultiplierFallback checks that assets without a twap over the whole last epoch
+// keep their previous multiplier instead of using the spot osmo backing, which new assets start at.
+func (suite *KeeperTestSuite) TestTwapOsmoEquivalentMultiplierFallback() {
+	suite.SetupTest()
+	params := suite.App.SuperfluidKeeper.GetParams(suite.Ctx)
+	params.OsmoEquivalentMultiplierMethod = types.OsmoEquivalentMultiplierMethodTwap
+	suite.App.SuperfluidKeeper.SetParams(suite.Ctx, params)
+	poolId := suite.createZeroFeeBalancerPool([]balancer.PoolAsset{
+		{Token: sdk.NewInt64Coin("stake", 2_000_000_000_000), Weight: sdk.NewInt(100)},
+		{Token: sdk.NewInt64Coin("foo", 500_000_000_000), Weight: sdk.NewInt(100)},
+	})
+	asset := types.SuperfluidAsset{
+		Denom:     gammtypes.GetPoolShareDenom(poolId),
+		AssetType: types.SuperfluidAssetTypeLPShare,
+	}
+
+	// the pool has no twap yet, so the asset starts at the spot osmo backing,
+	// and can be superfluid delegated right away.
+	suite.App.SuperfluidKeeper.AddNewSuperfluidAsset(suite.Ctx, asset)
+	multiplier := suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, asset.Denom)
+	spotMultiplier := suite.updateMultiplierWithMethod(asset, types.OsmoEquivalentMultiplierMethodSpot)
+	suite.Require().True(spotMultiplier.IsPositive())
+	suite.Require().Equal(spotMultiplier, multiplier)
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	suite.SetupSuperfluidDelegate(CreateRandomAccounts(1)[0], valAddrs[0], asset.Denom, 1_000_000_000_000_000_000)
+
+	// once the asset has a multiplier, it is kept while the twap is unavailable,
+	// even if the spot price moved in the meantime.
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Minute))
+	attacker := CreateRandomAccounts(1)[0]
+	suite.FundAcc(attacker, sdk.NewCoins(sdk.NewInt64Coin("foo", 500_000_000_000)))
+	_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, attacker, poolId, sdk.NewInt64Coin("foo", 500_000_000_000), "stake", sdk.OneInt())
+	suite.Require().NoError(err)
+	twapMultiplier := suite.updateMultiplierWithMethod(asset, types.OsmoEquivalentMultiplierMethodTwap)
+	suite.Require().Equal(spotMultiplier, twapMultiplier)
+}
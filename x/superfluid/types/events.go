@@ -7,6 +7,7 @@ const (
 	TypeEvtSuperfluidDelegate           = "superfluid_delegate"
 	TypeEvtSuperfluidIncreaseDelegation = "superfluid_increase_delegation"
 	TypeEvtSuperfluidUndelegate         = "superfluid_undelegate"
+	TypeEvtSuperfluidRedelegate         = "superfluid_redelegate"
 	TypeEvtSuperfluidUnbondLock         = "superfluid_unbond_lock"
 
 	TypeEvtUnpoolId     = "unpool_pool_id"
@@ -14,7 +15,11 @@ const (
 
 	AttributeDenom               = "denom"
 	AttributeSuperfluidAssetType = "superfluid_asset_type"
+	AttributeRiskFactor          = "risk_factor"
+	AttributeMaxSuperfluidOsmo   = "max_superfluid_osmo"
 	AttributeLockId              = "lock_id"
+	AttributeMergedLockIds       = "merged_lock_ids"
 	AttributeValidator           = "validator"
+	AttributeSrcValidator        = "source_validator"
 	AttributeAmount              = "amount"
 )
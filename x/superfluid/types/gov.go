@@ -59,6 +59,9 @@ func (p *SetSuperfluidAssetsProposal) ValidateBasic() error {
 		default:
 			return fmt.Errorf("unsupported superfluid asset type")
 		}
+		if err = asset.ValidateRiskParams(); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -9,12 +9,13 @@ import (
 
 // constants.
 const (
-	TypeMsgSuperfluidDelegate        = "superfluid_delegate"
-	TypeMsgSuperfluidUndelegate      = "superfluid_undelegate"
-	TypeMsgSuperfluidRedelegate      = "superfluid_redelegate"
-	TypeMsgSuperfluidUnbondLock      = "superfluid_unbond_underlying_lock"
-	TypeMsgLockAndSuperfluidDelegate = "lock_and_superfluid_delegate"
-	TypeMsgUnPoolWhitelistedPool     = "unpool_whitelisted_pool"
+	TypeMsgSuperfluidDelegate              = "superfluid_delegate"
+	TypeMsgSuperfluidUndelegate            = "superfluid_undelegate"
+	TypeMsgSuperfluidRedelegate            = "superfluid_redelegate"
+	TypeMsgSuperfluidUnbondLock            = "superfluid_unbond_underlying_lock"
+	TypeMsgLockAndSuperfluidDelegate       = "lock_and_superfluid_delegate"
+	TypeMsgMergeLocksAndSuperfluidDelegate = "merge_locks_and_superfluid_delegate"
+	TypeMsgUnPoolWhitelistedPool           = "unpool_whitelisted_pool"
 )
 
 var _ sdk.Msg = &MsgSuperfluidDelegate{}
@@ -62,6 +63,16 @@ func NewMsgSuperfluidUndelegate(sender sdk.AccAddress, lockId uint64) *MsgSuperf
 	}
 }
 
+// NewMsgPartialSuperfluidUndelegate creates a message to do superfluid undelegation of coins of the lock.
+// If coins are empty, the whole lock is undelegated.
+func NewMsgPartialSuperfluidUndelegate(sender sdk.AccAddress, lockId uint64, coins sdk.Coins) *MsgSuperfluidUndelegate {
+	return &MsgSuperfluidUndelegate{
+		Sender: sender.String(),
+		LockId: lockId,
+		Coins:  coins,
+	}
+}
+
 func (m MsgSuperfluidUndelegate) Route() string { return RouterKey }
 func (m MsgSuperfluidUndelegate) Type() string  { return TypeMsgSuperfluidUndelegate }
 func (m MsgSuperfluidUndelegate) ValidateBasic() error {
@@ -71,6 +82,9 @@ func (m MsgSuperfluidUndelegate) ValidateBasic() error {
 	if m.LockId == 0 {
 		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
 	}
+	if !m.Coins.IsValid() {
+		return fmt.Errorf("coins to undelegate are invalid: %s", m.Coins)
+	}
 	return nil
 }
 
@@ -83,38 +97,40 @@ func (m MsgSuperfluidUndelegate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
-// var _ sdk.Msg = &MsgSuperfluidRedelegate{}
-
-// // NewMsgSuperfluidRedelegate creates a message to do superfluid redelegation
-// func NewMsgSuperfluidRedelegate(sender sdk.AccAddress, lockId uint64, newValAddr sdk.ValAddress) *MsgSuperfluidRedelegate {
-// 	return &MsgSuperfluidRedelegate{
-// 		Sender:     sender.String(),
-// 		LockId:     lockId,
-// 		NewValAddr: newValAddr.String(),
-// 	}
-// }
-
-// func (m MsgSuperfluidRedelegate) Route() string { return RouterKey }
-// func (m MsgSuperfluidRedelegate) Type() string  { return TypeMsgSuperfluidRedelegate }
-// func (m MsgSuperfluidRedelegate) ValidateBasic() error {
-// 	if m.Sender == "" {
-// 		return fmt.Errorf("sender should not be an empty address")
-// 	}
-// 	if m.LockId == 0 {
-// 		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
-// 	}
-// 	if m.NewValAddr == "" {
-// 		return fmt.Errorf("NewValAddr should not be empty")
-// 	}
-// 	return nil
-// }
-// func (m MsgSuperfluidRedelegate) GetSignBytes() []byte {
-// 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
-// }
-// func (m MsgSuperfluidRedelegate) GetSigners() []sdk.AccAddress {
-// 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
-// 	return []sdk.AccAddress{sender}
-// }
+var _ sdk.Msg = &MsgSuperfluidRedelegate{}
+
+// NewMsgSuperfluidRedelegate creates a message to do superfluid redelegation.
+func NewMsgSuperfluidRedelegate(sender sdk.AccAddress, lockId uint64, newValAddr sdk.ValAddress) *MsgSuperfluidRedelegate {
+	return &MsgSuperfluidRedelegate{
+		Sender:     sender.String(),
+		LockId:     lockId,
+		NewValAddr: newValAddr.String(),
+	}
+}
+
+func (m MsgSuperfluidRedelegate) Route() string { return RouterKey }
+func (m MsgSuperfluidRedelegate) Type() string  { return TypeMsgSuperfluidRedelegate }
+func (m MsgSuperfluidRedelegate) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	if m.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
+	}
+	if m.NewValAddr == "" {
+		return fmt.Errorf("NewValAddr should not be empty")
+	}
+	return nil
+}
+
+func (m MsgSuperfluidRedelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSuperfluidRedelegate) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
 
 var _ sdk.Msg = &MsgSuperfluidUnbondLock{}
 
@@ -126,6 +142,16 @@ func NewMsgSuperfluidUnbondLock(sender sdk.AccAddress, lockID uint64) *MsgSuperf
 	}
 }
 
+// NewMsgPartialSuperfluidUnbondLock creates a message to unbond coins of a lock underlying a superfluid undelegation position.
+// If coins are empty, the whole lock is unbonded.
+func NewMsgPartialSuperfluidUnbondLock(sender sdk.AccAddress, lockID uint64, coins sdk.Coins) *MsgSuperfluidUnbondLock {
+	return &MsgSuperfluidUnbondLock{
+		Sender: sender.String(),
+		LockId: lockID,
+		Coins:  coins,
+	}
+}
+
 func (m MsgSuperfluidUnbondLock) Route() string { return RouterKey }
 func (m MsgSuperfluidUnbondLock) Type() string {
 	return TypeMsgSuperfluidUnbondLock
@@ -138,6 +164,9 @@ func (m MsgSuperfluidUnbondLock) ValidateBasic() error {
 	if m.LockId == 0 {
 		return fmt.Errorf("lockID should be set")
 	}
+	if !m.Coins.IsValid() {
+		return fmt.Errorf("coins to unbond are invalid: %s", m.Coins)
+	}
 	return nil
 }
 
@@ -187,6 +216,54 @@ func (m MsgLockAndSuperfluidDelegate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgMergeLocksAndSuperfluidDelegate{}
+
+// NewMsgMergeLocksAndSuperfluidDelegate creates a message to merge lockup locks and superfluid delegate the merged lock.
+func NewMsgMergeLocksAndSuperfluidDelegate(sender sdk.AccAddress, lockIds []uint64, valAddr sdk.ValAddress) *MsgMergeLocksAndSuperfluidDelegate {
+	return &MsgMergeLocksAndSuperfluidDelegate{
+		Sender:  sender.String(),
+		LockIds: lockIds,
+		ValAddr: valAddr.String(),
+	}
+}
+
+func (m MsgMergeLocksAndSuperfluidDelegate) Route() string { return RouterKey }
+func (m MsgMergeLocksAndSuperfluidDelegate) Type() string {
+	return TypeMsgMergeLocksAndSuperfluidDelegate
+}
+
+func (m MsgMergeLocksAndSuperfluidDelegate) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	if len(m.LockIds) == 0 {
+		return fmt.Errorf("lock ids should not be empty")
+	}
+	seen := make(map[uint64]bool, len(m.LockIds))
+	for _, lockId := range m.LockIds {
+		if lockId == 0 {
+			return fmt.Errorf("lock id should be positive: %d < 0", lockId)
+		}
+		if seen[lockId] {
+			return fmt.Errorf("lock id %d is duplicated", lockId)
+		}
+		seen[lockId] = true
+	}
+	if m.ValAddr == "" {
+		return fmt.Errorf("ValAddr should not be empty")
+	}
+	return nil
+}
+
+func (m MsgMergeLocksAndSuperfluidDelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgMergeLocksAndSuperfluidDelegate) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgUnPoolWhitelistedPool{}
 
 // NewMsgUnPoolWhitelistedPool creates a message to create a lockup lock and superfluid delegation
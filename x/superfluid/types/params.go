@@ -12,6 +12,9 @@ import (
 var (
 	KeyMinimumRiskFactor     = []byte("MinimumRiskFactor")
 	defaultMinimumRiskFactor = sdk.NewDecWithPrec(5, 1) // 50%
+
+	KeyOsmoEquivalentMultiplierMethod     = []byte("OsmoEquivalentMultiplierMethod")
+	defaultOsmoEquivalentMultiplierMethod = OsmoEquivalentMultiplierMethodTwap
 )
 
 // ParamTable for minting module.
@@ -19,28 +22,31 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(minimumRiskFactor sdk.Dec) Params {
+func NewParams(minimumRiskFactor sdk.Dec, osmoEquivalentMultiplierMethod OsmoEquivalentMultiplierMethod) Params {
 	return Params{
-		MinimumRiskFactor: minimumRiskFactor,
+		MinimumRiskFactor:              minimumRiskFactor,
+		OsmoEquivalentMultiplierMethod: osmoEquivalentMultiplierMethod,
 	}
 }
 
 // default minting module parameters.
 func DefaultParams() Params {
 	return Params{
-		MinimumRiskFactor: defaultMinimumRiskFactor, // 5%
+		MinimumRiskFactor:              defaultMinimumRiskFactor, // 5%
+		OsmoEquivalentMultiplierMethod: defaultOsmoEquivalentMultiplierMethod,
 	}
 }
 
 // validate params.
 func (p Params) Validate() error {
-	return nil
+	return ValidateOsmoEquivalentMultiplierMethod(p.OsmoEquivalentMultiplierMethod)
 }
 
 // Implements params.ParamSet.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMinimumRiskFactor, &p.MinimumRiskFactor, ValidateMinimumRiskFactor),
+		paramtypes.NewParamSetPair(KeyOsmoEquivalentMultiplierMethod, &p.OsmoEquivalentMultiplierMethod, ValidateOsmoEquivalentMultiplierMethod),
 	}
 }
 
@@ -57,6 +63,19 @@ func ValidateMinimumRiskFactor(i interface{}) error {
 	return nil
 }
 
+func ValidateOsmoEquivalentMultiplierMethod(i interface{}) error {
+	v, ok := i.(OsmoEquivalentMultiplierMethod)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := OsmoEquivalentMultiplierMethod_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid osmo equivalent multiplier method: %d", v)
+	}
+
+	return nil
+}
+
 func ValidateUnbondingDuration(i interface{}) error {
 	v, ok := i.(time.Duration)
 	if !ok {
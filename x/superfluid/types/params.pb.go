@@ -25,11 +25,48 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OsmoEquivalentMultiplierMethod defines how the OSMO equivalent multiplier
+// of an LP share is computed at every epoch.
+type OsmoEquivalentMultiplierMethod int32
+
+const (
+	// The OSMO backing of an LP share is read from the pool's balances at the
+	// epoch boundary. This can be moved by manipulating the pool's reserves
+	// right at the epoch boundary.
+	OsmoEquivalentMultiplierMethodSpot OsmoEquivalentMultiplierMethod = 0
+	// The OSMO backing of an LP share is the OSMO the pool would hold at the
+	// geometric TWAP of its prices over the last epoch, for the same pool
+	// invariant. Moving the pool's reserves with swaps does not change the
+	// invariant, and only affects the TWAP for the time it is held.
+	OsmoEquivalentMultiplierMethodTwap OsmoEquivalentMultiplierMethod = 1
+)
+
+var OsmoEquivalentMultiplierMethod_name = map[int32]string{
+	0: "OsmoEquivalentMultiplierMethodSpot",
+	1: "OsmoEquivalentMultiplierMethodTwap",
+}
+
+var OsmoEquivalentMultiplierMethod_value = map[string]int32{
+	"OsmoEquivalentMultiplierMethodSpot": 0,
+	"OsmoEquivalentMultiplierMethodTwap": 1,
+}
+
+func (x OsmoEquivalentMultiplierMethod) String() string {
+	return proto.EnumName(OsmoEquivalentMultiplierMethod_name, int32(x))
+}
+
+func (OsmoEquivalentMultiplierMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0985261dfaf2a82e, []int{0}
+}
+
 // Params holds parameters for the superfluid module
 type Params struct {
 	// the risk_factor is to be cut on OSMO equivalent value of lp tokens for
 	// superfluid staking, default: 5%
 	MinimumRiskFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=minimum_risk_factor,json=minimumRiskFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"minimum_risk_factor" yaml:"minimum_risk_factor"`
+	// osmo_equivalent_multiplier_method is how the OSMO equivalent multiplier
+	// of LP shares is computed at every epoch.
+	OsmoEquivalentMultiplierMethod OsmoEquivalentMultiplierMethod `protobuf:"varint,2,opt,name=osmo_equivalent_multiplier_method,json=osmoEquivalentMultiplierMethod,proto3,enum=osmosis.superfluid.OsmoEquivalentMultiplierMethod" json:"osmo_equivalent_multiplier_method,omitempty" yaml:"osmo_equivalent_multiplier_method"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -65,31 +102,46 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetOsmoEquivalentMultiplierMethod() OsmoEquivalentMultiplierMethod {
+	if m != nil {
+		return m.OsmoEquivalentMultiplierMethod
+	}
+	return OsmoEquivalentMultiplierMethodSpot
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.superfluid.OsmoEquivalentMultiplierMethod", OsmoEquivalentMultiplierMethod_name, OsmoEquivalentMultiplierMethod_value)
 	proto.RegisterType((*Params)(nil), "osmosis.superfluid.Params")
 }
 
 func init() { proto.RegisterFile("osmosis/superfluid/params.proto", fileDescriptor_0985261dfaf2a82e) }
 
 var fileDescriptor_0985261dfaf2a82e = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x2e, 0x2d, 0x48, 0x2d, 0x4a, 0xcb, 0x29, 0xcd, 0x4c, 0xd1, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x2a, 0xd0,
-	0x43, 0x28, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58, 0x10, 0x95, 0x52,
-	0x72, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x60, 0x5e, 0x52, 0x69, 0x9a, 0x7e, 0x4a, 0x69,
-	0x51, 0x62, 0x49, 0x66, 0x7e, 0x1e, 0x44, 0x5e, 0xa9, 0x8d, 0x91, 0x8b, 0x2d, 0x00, 0x6c, 0xb4,
-	0x50, 0x0d, 0x97, 0x70, 0x6e, 0x66, 0x5e, 0x66, 0x6e, 0x69, 0x6e, 0x7c, 0x51, 0x66, 0x71, 0x76,
-	0x7c, 0x5a, 0x62, 0x72, 0x49, 0x7e, 0x91, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xcf, 0x89,
-	0x7b, 0xf2, 0x0c, 0xb7, 0xee, 0xc9, 0xab, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7,
-	0xe7, 0xea, 0x27, 0x83, 0x5d, 0x01, 0xa5, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x4b, 0x2a, 0x0b, 0x52,
-	0x8b, 0xf5, 0x5c, 0x52, 0x93, 0x3f, 0xdd, 0x93, 0x97, 0xaa, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0xc2,
-	0x62, 0xa4, 0x52, 0x90, 0x20, 0x54, 0x34, 0x28, 0xb3, 0x38, 0xdb, 0x0d, 0x2c, 0xe6, 0xe4, 0x7f,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xa6, 0x48, 0x56, 0x42, 0xfd, 0xad,
-	0x9b, 0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x97, 0x99, 0xeb, 0x57, 0x20, 0x07, 0x15, 0xd8, 0x15,
-	0x49, 0x6c, 0x60, 0x0f, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x13, 0x35, 0x9c, 0x41, 0x4d,
-	0x01, 0x00, 0x00,
+	// 373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x31, 0x6f, 0xe2, 0x30,
+	0x14, 0xc7, 0x63, 0x74, 0x42, 0xba, 0x0c, 0x27, 0x2e, 0x77, 0x03, 0xca, 0xe0, 0x70, 0x19, 0x10,
+	0x3a, 0x1d, 0xb1, 0xc4, 0xe9, 0x74, 0x52, 0x47, 0xd4, 0x76, 0x2a, 0xa2, 0xa2, 0x9d, 0xba, 0x44,
+	0x4e, 0x62, 0x82, 0x45, 0x8c, 0xd3, 0xd8, 0xa6, 0x45, 0xea, 0x07, 0xe8, 0xd8, 0xef, 0xd0, 0xb1,
+	0x6b, 0x3f, 0x04, 0x23, 0x63, 0xd5, 0x21, 0xaa, 0xe0, 0x1b, 0xf0, 0x09, 0x2a, 0x4c, 0x4a, 0x91,
+	0x5a, 0xc1, 0x64, 0xbf, 0xf7, 0x7e, 0xef, 0xff, 0x9e, 0xfd, 0x9e, 0xe9, 0x70, 0xc1, 0xb8, 0xa0,
+	0x02, 0x09, 0x95, 0x92, 0xac, 0x9f, 0x28, 0x1a, 0xa1, 0x14, 0x67, 0x98, 0x09, 0x2f, 0xcd, 0xb8,
+	0xe4, 0x96, 0x55, 0x00, 0xde, 0x3b, 0x60, 0xff, 0x8c, 0x79, 0xcc, 0x75, 0x18, 0xad, 0x6e, 0x6b,
+	0xd2, 0x86, 0x31, 0xe7, 0x71, 0x42, 0x90, 0xb6, 0x02, 0xd5, 0x47, 0x91, 0xca, 0xb0, 0xa4, 0x7c,
+	0xb4, 0x8e, 0xbb, 0x8f, 0x25, 0xb3, 0x7c, 0xaa, 0xa5, 0xad, 0x1b, 0xf3, 0x07, 0xa3, 0x23, 0xca,
+	0x14, 0xf3, 0x33, 0x2a, 0x86, 0x7e, 0x1f, 0x87, 0x92, 0x67, 0x55, 0x50, 0x03, 0x8d, 0xaf, 0xed,
+	0x93, 0x69, 0xee, 0x18, 0xcf, 0xb9, 0x53, 0x8f, 0xa9, 0x1c, 0xa8, 0xc0, 0x0b, 0x39, 0x43, 0xa1,
+	0xee, 0xa2, 0x38, 0x9a, 0x22, 0x1a, 0x22, 0x39, 0x49, 0x89, 0xf0, 0x0e, 0x49, 0xb8, 0xcc, 0x1d,
+	0x7b, 0x82, 0x59, 0x72, 0xe0, 0x7e, 0x22, 0xe9, 0xf6, 0xbe, 0x17, 0xde, 0x1e, 0x15, 0xc3, 0x63,
+	0xed, 0xb3, 0x1e, 0x80, 0xf9, 0x6b, 0x25, 0xe4, 0x93, 0x4b, 0x45, 0xc7, 0x38, 0x21, 0x23, 0xe9,
+	0x33, 0x95, 0x48, 0x9a, 0x26, 0x94, 0x64, 0x3e, 0x23, 0x72, 0xc0, 0xa3, 0x6a, 0xa9, 0x06, 0x1a,
+	0xdf, 0x5a, 0x2d, 0xef, 0xe3, 0xfb, 0xbd, 0xae, 0x60, 0xfc, 0x68, 0x93, 0xdb, 0xd9, 0xa4, 0x76,
+	0x74, 0x66, 0xfb, 0xcf, 0x32, 0x77, 0x1a, 0xeb, 0x96, 0xf6, 0x96, 0x71, 0x7b, 0x90, 0xef, 0x54,
+	0xfb, 0x3d, 0x36, 0xe1, 0xee, 0x7a, 0x56, 0xdd, 0x74, 0x77, 0x13, 0x67, 0x29, 0x97, 0x15, 0x63,
+	0x3f, 0x77, 0x7e, 0x85, 0xd3, 0x0a, 0xb0, 0xbf, 0xdc, 0xde, 0x43, 0xa3, 0xdd, 0x9d, 0xce, 0x21,
+	0x98, 0xcd, 0x21, 0x78, 0x99, 0x43, 0x70, 0xb7, 0x80, 0xc6, 0x6c, 0x01, 0x8d, 0xa7, 0x05, 0x34,
+	0x2e, 0xfe, 0x6d, 0x0d, 0xa6, 0xf8, 0x9d, 0x66, 0x82, 0x03, 0xf1, 0x66, 0xa0, 0xf1, 0x7f, 0x74,
+	0xbd, 0xbd, 0x50, 0x7a, 0x56, 0x41, 0x59, 0xaf, 0xc1, 0xdf, 0xd7, 0x01, 0x00, 0x34, 0xfa, 0xe7,
+	0x0c, 0x73, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -112,6 +164,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OsmoEquivalentMultiplierMethod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OsmoEquivalentMultiplierMethod))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.MinimumRiskFactor.Size()
 		i -= size
@@ -144,6 +201,9 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.MinimumRiskFactor.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.OsmoEquivalentMultiplierMethod != 0 {
+		n += 1 + sovParams(uint64(m.OsmoEquivalentMultiplierMethod))
+	}
 	return n
 }
 
@@ -216,6 +276,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoEquivalentMultiplierMethod", wireType)
+			}
+			m.OsmoEquivalentMultiplierMethod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OsmoEquivalentMultiplierMethod |= OsmoEquivalentMultiplierMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -13,6 +15,18 @@ func NewSuperfluidAsset(assetType SuperfluidAssetType, denom string) SuperfluidA
 	}
 }
 
+// ValidateRiskParams checks that the risk factor of the asset, if set, is in [0, 1),
+// and that its max superfluid OSMO, if set, is not negative.
+func (a SuperfluidAsset) ValidateRiskParams() error {
+	if a.RiskFactor != nil && (a.RiskFactor.IsNegative() || a.RiskFactor.GTE(sdk.OneDec())) {
+		return fmt.Errorf("risk factor of %s must be in [0, 1), got %s", a.Denom, a.RiskFactor)
+	}
+	if a.MaxSuperfluidOsmo != nil && a.MaxSuperfluidOsmo.IsNegative() {
+		return fmt.Errorf("max superfluid osmo of %s must not be negative, got %s", a.Denom, a.MaxSuperfluidOsmo)
+	}
+	return nil
+}
+
 func NewSuperfluidIntermediaryAccount(denom string, valAddr string, gaugeId uint64) SuperfluidIntermediaryAccount {
 	return SuperfluidIntermediaryAccount{
 		Denom:   denom,
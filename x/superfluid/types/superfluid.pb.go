@@ -55,6 +55,13 @@ func (SuperfluidAssetType) EnumDescriptor() ([]byte, []int) {
 type SuperfluidAsset struct {
 	Denom     string              `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	AssetType SuperfluidAssetType `protobuf:"varint,2,opt,name=asset_type,json=assetType,proto3,enum=osmosis.superfluid.SuperfluidAssetType" json:"asset_type,omitempty"`
+	// risk_factor is cut on the OSMO equivalent value of the asset instead of
+	// the minimum_risk_factor param, if it is higher. Unset means the
+	// minimum_risk_factor param.
+	RiskFactor *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=risk_factor,json=riskFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"risk_factor,omitempty" yaml:"risk_factor"`
+	// max_superfluid_osmo caps the total OSMO superfluid delegated for the asset.
+	// Unset means no cap.
+	MaxSuperfluidOsmo *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_superfluid_osmo,json=maxSuperfluidOsmo,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_superfluid_osmo,omitempty" yaml:"max_superfluid_osmo"`
 }
 
 func (m *SuperfluidAsset) Reset()         { *m = SuperfluidAsset{} }
@@ -395,51 +402,55 @@ func init() {
 }
 
 var fileDescriptor_79d3c29d82dbb734 = []byte{
-	// 694 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0xd4, 0x40,
-	0x14, 0xdf, 0xb2, 0x2b, 0xb0, 0x83, 0xd1, 0xa5, 0x10, 0x5c, 0x36, 0xa1, 0x8b, 0x25, 0x91, 0x0d,
-	0x84, 0x36, 0x60, 0x8c, 0x09, 0xb7, 0x05, 0x34, 0x21, 0x41, 0x20, 0x45, 0x63, 0xc2, 0x65, 0x33,
-	0xed, 0x0c, 0xdd, 0xc9, 0x4e, 0x3b, 0xa5, 0x33, 0xad, 0xee, 0xcd, 0x23, 0x47, 0x3f, 0x02, 0x89,
-	0x37, 0x3f, 0x84, 0x67, 0x8e, 0x1c, 0x8d, 0x07, 0x34, 0x70, 0xf1, 0xcc, 0x27, 0x30, 0x9d, 0x76,
-	0xbb, 0x15, 0x30, 0xea, 0xa9, 0x33, 0xef, 0xf7, 0xde, 0xef, 0xfd, 0xde, 0x9f, 0x0e, 0x58, 0x60,
-	0xdc, 0x63, 0x9c, 0x70, 0x93, 0x47, 0x01, 0x0e, 0x8f, 0x68, 0x44, 0x50, 0xe1, 0x68, 0x04, 0x21,
-	0x13, 0x4c, 0x55, 0x33, 0x27, 0x63, 0x88, 0x34, 0xa6, 0x5d, 0xe6, 0x32, 0x09, 0x9b, 0xc9, 0x29,
-	0xf5, 0x6c, 0x68, 0x2e, 0x63, 0x2e, 0xc5, 0xa6, 0xbc, 0xd9, 0xd1, 0x91, 0x89, 0xa2, 0x10, 0x0a,
-	0xc2, 0xfc, 0x0c, 0x6f, 0xde, 0xc4, 0x05, 0xf1, 0x30, 0x17, 0xd0, 0x0b, 0x06, 0x04, 0x8e, 0xcc,
-	0x65, 0xda, 0x90, 0x63, 0x33, 0x5e, 0xb5, 0xb1, 0x80, 0xab, 0xa6, 0xc3, 0x48, 0x46, 0xa0, 0xf7,
-	0xc1, 0xc3, 0x83, 0x5c, 0x44, 0x9b, 0x73, 0x2c, 0xd4, 0x69, 0x70, 0x0f, 0x61, 0x9f, 0x79, 0x75,
-	0x65, 0x5e, 0x69, 0x55, 0xad, 0xf4, 0xa2, 0xbe, 0x04, 0x00, 0x26, 0x70, 0x47, 0xf4, 0x03, 0x5c,
-	0x1f, 0x99, 0x57, 0x5a, 0x0f, 0xd6, 0x16, 0x8d, 0xdb, 0x85, 0x18, 0x37, 0xe8, 0x5e, 0xf7, 0x03,
-	0x6c, 0x55, 0xe1, 0xe0, 0xb8, 0x3e, 0x7e, 0x72, 0xda, 0x2c, 0xfd, 0x3c, 0x6d, 0x2a, 0x7a, 0x0f,
-	0xcc, 0x0d, 0x7d, 0xb7, 0x7d, 0x81, 0x43, 0x0f, 0x23, 0x02, 0xc3, 0x7e, 0xdb, 0x71, 0x58, 0xe4,
-	0xff, 0x49, 0xc8, 0x2c, 0x18, 0x8f, 0x21, 0xed, 0x40, 0x84, 0x42, 0x29, 0xa3, 0x6a, 0x8d, 0xc5,
-	0x90, 0xb6, 0x11, 0x0a, 0x13, 0xc8, 0x85, 0x91, 0x8b, 0x3b, 0x04, 0xd5, 0xcb, 0xf3, 0x4a, 0xab,
-	0x62, 0x8d, 0xc9, 0xfb, 0x36, 0xd2, 0xbf, 0x28, 0x40, 0xdb, 0xe3, 0x1e, 0x7b, 0x71, 0x1c, 0x91,
-	0x18, 0x52, 0xec, 0x8b, 0x57, 0x11, 0x15, 0x24, 0xa0, 0x04, 0x87, 0x16, 0x76, 0x58, 0x88, 0xd4,
-	0xc7, 0xe0, 0x3e, 0x0e, 0x98, 0xd3, 0xed, 0xf8, 0x91, 0x67, 0xe3, 0x50, 0x66, 0x2d, 0x5b, 0x13,
-	0xd2, 0xb6, 0x2b, 0x4d, 0x43, 0x45, 0x23, 0x45, 0x45, 0x0e, 0x00, 0x5e, 0x4e, 0x26, 0x13, 0x57,
-	0x37, 0x36, 0xcf, 0x2e, 0x9a, 0xa5, 0x6f, 0x17, 0xcd, 0x27, 0x2e, 0x11, 0xdd, 0xc8, 0x36, 0x1c,
-	0xe6, 0x99, 0xd9, 0x28, 0xd2, 0xcf, 0x0a, 0x47, 0x3d, 0x33, 0xe9, 0x25, 0x37, 0xb6, 0xb0, 0x73,
-	0x7d, 0xd1, 0x9c, 0xec, 0x43, 0x8f, 0xae, 0xeb, 0x43, 0x26, 0xdd, 0x2a, 0xd0, 0xea, 0xd7, 0x23,
-	0xa0, 0x31, 0x6c, 0xd7, 0x16, 0xa6, 0xd8, 0x95, 0x8b, 0x90, 0x89, 0x5f, 0x06, 0x93, 0x28, 0xb5,
-	0xb1, 0x50, 0xf6, 0x06, 0x73, 0x9e, 0xf5, 0xad, 0x96, 0x03, 0xed, 0xd4, 0x9e, 0x38, 0xc7, 0x90,
-	0x12, 0xf4, 0x9b, 0x73, 0x5a, 0x52, 0x2d, 0x07, 0x06, 0xce, 0xef, 0x72, 0x66, 0xc2, 0xfc, 0x0e,
-	0xf4, 0x92, 0xd1, 0xc8, 0x22, 0x27, 0xd6, 0x66, 0x8d, 0xb4, 0x16, 0x23, 0xd9, 0x2e, 0x23, 0xdb,
-	0x2e, 0x63, 0x93, 0x11, 0x7f, 0xc3, 0x4c, 0xea, 0xff, 0xfc, 0xbd, 0xb9, 0xf8, 0x0f, 0xf5, 0x27,
-	0x01, 0xb9, 0x4a, 0xc2, 0xfc, 0xb6, 0xcc, 0xa1, 0x7e, 0x50, 0x40, 0x1d, 0xe7, 0xe3, 0xea, 0x70,
-	0x01, 0x7b, 0x18, 0x0d, 0x04, 0x54, 0xfe, 0x26, 0x60, 0xf9, 0x7f, 0x92, 0xcf, 0x0c, 0xf3, 0x1c,
-	0xc8, 0x34, 0xa9, 0x04, 0xfd, 0x18, 0x2c, 0xec, 0x30, 0xa7, 0xb7, 0x7d, 0xd7, 0x7a, 0x6e, 0x32,
-	0xdf, 0xc7, 0x4e, 0xa2, 0x57, 0x7d, 0x04, 0xc6, 0x28, 0x73, 0x7a, 0xc9, 0xda, 0x29, 0x72, 0xed,
-	0x46, 0xa9, 0x8c, 0x52, 0x57, 0xc1, 0x34, 0x29, 0x44, 0x76, 0x60, 0x1a, 0x9a, 0xf5, 0x7a, 0x8a,
-	0xdc, 0x66, 0xd5, 0x97, 0xc0, 0xcc, 0x1b, 0x3f, 0x60, 0x8c, 0xbe, 0xed, 0x12, 0x81, 0x29, 0xe1,
-	0x02, 0xa3, 0x7d, 0xc6, 0x28, 0x57, 0x6b, 0xa0, 0x4c, 0x50, 0x32, 0xd4, 0x72, 0xab, 0x62, 0x25,
-	0xc7, 0xa5, 0x43, 0x30, 0x75, 0xc7, 0xdf, 0xa6, 0xce, 0x81, 0xd9, 0x3b, 0xcc, 0xbb, 0x50, 0x90,
-	0x18, 0xd7, 0x4a, 0xaa, 0x56, 0x5c, 0xa4, 0x1c, 0xde, 0xd9, 0x3f, 0xe8, 0xc2, 0x10, 0xd7, 0x94,
-	0x46, 0xe5, 0xe4, 0x93, 0x56, 0xda, 0xd8, 0x3b, 0xbb, 0xd4, 0x94, 0xf3, 0x4b, 0x4d, 0xf9, 0x71,
-	0xa9, 0x29, 0x1f, 0xaf, 0xb4, 0xd2, 0xf9, 0x95, 0x56, 0xfa, 0x7a, 0xa5, 0x95, 0x0e, 0x9f, 0x15,
-	0xba, 0x9a, 0xfd, 0xff, 0x2b, 0x14, 0xda, 0x7c, 0x70, 0x31, 0xe3, 0xe7, 0xe6, 0xfb, 0xe2, 0xfb,
-	0x27, 0x1b, 0x6d, 0x8f, 0xca, 0x07, 0xe7, 0xe9, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc1, 0xfb,
-	0xa8, 0x75, 0x22, 0x05, 0x00, 0x00,
+	// 758 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x4f, 0xfb, 0x36,
+	0x1c, 0x6d, 0xda, 0xee, 0x0f, 0x98, 0x69, 0x2b, 0x01, 0xb1, 0x52, 0x89, 0x84, 0x05, 0x69, 0x20,
+	0x10, 0x89, 0x60, 0x9a, 0x26, 0x71, 0x5a, 0x81, 0xa1, 0x55, 0x62, 0x80, 0xc2, 0xa6, 0x49, 0x5c,
+	0x22, 0x37, 0x36, 0xa9, 0x55, 0x27, 0x0e, 0xb1, 0xd3, 0xb5, 0xb7, 0x1d, 0x39, 0xee, 0x23, 0x20,
+	0xed, 0xb6, 0x0f, 0xb1, 0x33, 0x47, 0x4e, 0xd3, 0xb4, 0x43, 0x37, 0xc1, 0x65, 0x67, 0x3e, 0xc1,
+	0x64, 0x27, 0x4d, 0x3b, 0xe8, 0xf4, 0x87, 0x53, 0xfc, 0xf3, 0xb3, 0xdf, 0x7b, 0xbf, 0x67, 0xc7,
+	0x60, 0x9d, 0xf1, 0x90, 0x71, 0xc2, 0x1d, 0x9e, 0xc6, 0x38, 0xb9, 0xa2, 0x29, 0x41, 0x13, 0x43,
+	0x3b, 0x4e, 0x98, 0x60, 0xba, 0x9e, 0x2f, 0xb2, 0xc7, 0x48, 0x63, 0x29, 0x60, 0x01, 0x53, 0xb0,
+	0x23, 0x47, 0xd9, 0xca, 0x86, 0x11, 0x30, 0x16, 0x50, 0xec, 0xa8, 0xaa, 0x9d, 0x5e, 0x39, 0x28,
+	0x4d, 0xa0, 0x20, 0x2c, 0xca, 0x71, 0xf3, 0x39, 0x2e, 0x48, 0x88, 0xb9, 0x80, 0x61, 0x3c, 0x22,
+	0xf0, 0x95, 0x96, 0xd3, 0x86, 0x1c, 0x3b, 0xbd, 0xdd, 0x36, 0x16, 0x70, 0xd7, 0xf1, 0x19, 0xc9,
+	0x09, 0xac, 0xdf, 0xcb, 0xe0, 0xe3, 0x8b, 0xc2, 0x45, 0x93, 0x73, 0x2c, 0xf4, 0x25, 0xf0, 0x01,
+	0xc2, 0x11, 0x0b, 0xeb, 0xda, 0x9a, 0xb6, 0x39, 0xe7, 0x66, 0x85, 0x7e, 0x0c, 0x00, 0x94, 0xb0,
+	0x27, 0x06, 0x31, 0xae, 0x97, 0xd7, 0xb4, 0xcd, 0x8f, 0xf6, 0x36, 0xec, 0x97, 0x9d, 0xd8, 0xcf,
+	0xe8, 0xbe, 0x1b, 0xc4, 0xd8, 0x9d, 0x83, 0xa3, 0xa1, 0x0e, 0xc1, 0x7c, 0x42, 0x78, 0xd7, 0xbb,
+	0x82, 0xbe, 0x60, 0x49, 0xbd, 0x22, 0x35, 0x0e, 0xbe, 0xfa, 0x73, 0x68, 0x7e, 0x16, 0x10, 0xd1,
+	0x49, 0xdb, 0xb6, 0xcf, 0x42, 0x27, 0x77, 0x9d, 0x7d, 0x76, 0x38, 0xea, 0x3a, 0x52, 0x95, 0xdb,
+	0x47, 0xd8, 0x7f, 0x1a, 0x9a, 0xfa, 0x00, 0x86, 0x74, 0xdf, 0x9a, 0xa0, 0xb1, 0x5c, 0x20, 0xab,
+	0x63, 0x55, 0xe8, 0x7d, 0xb0, 0x18, 0xc2, 0xbe, 0x37, 0xf6, 0xe4, 0x49, 0x9e, 0x7a, 0x55, 0x49,
+	0x7d, 0xf3, 0x4a, 0xa9, 0x56, 0x24, 0x9e, 0x86, 0x66, 0x23, 0x93, 0x9a, 0x42, 0x67, 0xb9, 0x0b,
+	0x21, 0xec, 0x8f, 0x9b, 0x3d, 0xe3, 0x21, 0xdb, 0x9f, 0xbd, 0xb9, 0x35, 0x4b, 0xff, 0xdc, 0x9a,
+	0x9a, 0xd5, 0x05, 0xab, 0x63, 0xac, 0x15, 0x09, 0x9c, 0x84, 0x18, 0x11, 0x98, 0x0c, 0x9a, 0xbe,
+	0xcf, 0xd2, 0xe8, 0xff, 0x52, 0x5e, 0x01, 0xb3, 0x3d, 0x48, 0x3d, 0x88, 0x50, 0xa2, 0x32, 0x9e,
+	0x73, 0x67, 0x7a, 0x90, 0x36, 0x11, 0x4a, 0x24, 0x14, 0xc0, 0x34, 0xc0, 0x1e, 0x41, 0x2a, 0xb5,
+	0xaa, 0x3b, 0xa3, 0xea, 0x16, 0xb2, 0x7e, 0xd3, 0x80, 0x21, 0xf5, 0xbf, 0xbe, 0x4e, 0x49, 0x0f,
+	0x52, 0x1c, 0x89, 0x6f, 0x53, 0x2a, 0x48, 0x4c, 0x09, 0x4e, 0x5c, 0xec, 0xb3, 0x04, 0xe9, 0x9f,
+	0x82, 0x0f, 0x71, 0xcc, 0xfc, 0x8e, 0x17, 0xa5, 0x61, 0x1b, 0x27, 0x4a, 0xb5, 0xe2, 0xce, 0xab,
+	0xb9, 0x53, 0x35, 0x35, 0x76, 0x54, 0x9e, 0x74, 0xe4, 0x03, 0x10, 0x16, 0x64, 0xf9, 0x71, 0x1d,
+	0xde, 0x0d, 0xcd, 0xd2, 0x9b, 0x8e, 0x6c, 0x21, 0xcf, 0xb1, 0x60, 0xb2, 0xdc, 0x09, 0x5a, 0xeb,
+	0xa9, 0x0c, 0x1a, 0xe3, 0xb8, 0x8e, 0x30, 0xc5, 0x81, 0xba, 0xe6, 0xb9, 0xf9, 0x6d, 0xb0, 0x80,
+	0xb2, 0x39, 0x96, 0xa8, 0x6c, 0x30, 0xe7, 0x79, 0x6e, 0xb5, 0x02, 0x68, 0x66, 0xf3, 0x72, 0x71,
+	0x0f, 0x52, 0x82, 0xfe, 0xb3, 0x38, 0x6b, 0xa9, 0x56, 0x00, 0xa3, 0xc5, 0x3f, 0x16, 0xcc, 0x84,
+	0x45, 0x1e, 0x0c, 0xe5, 0xd1, 0xa8, 0x26, 0xe7, 0xf7, 0x56, 0xec, 0xac, 0x17, 0x5b, 0xfe, 0x3b,
+	0x76, 0xfe, 0xef, 0xd8, 0x87, 0x8c, 0x44, 0x07, 0x8e, 0xec, 0xff, 0xd7, 0xbf, 0xcc, 0x8d, 0x57,
+	0xf4, 0x2f, 0x37, 0x14, 0x2e, 0x09, 0x8b, 0x9a, 0x4a, 0x43, 0xff, 0x49, 0x03, 0x75, 0x5c, 0x1c,
+	0x97, 0xc7, 0x05, 0xec, 0x62, 0x34, 0x32, 0x50, 0x7d, 0x9f, 0x81, 0xed, 0xb7, 0x88, 0x2f, 0x8f,
+	0x75, 0x2e, 0x94, 0x4c, 0x66, 0xc1, 0xba, 0x06, 0xeb, 0x27, 0xcc, 0xef, 0xb6, 0xa6, 0x5d, 0xcf,
+	0x43, 0x16, 0x45, 0xd8, 0x97, 0x7e, 0xf5, 0x4f, 0xc0, 0x0c, 0x65, 0x7e, 0x57, 0x5e, 0x3b, 0x4d,
+	0x5d, 0xbb, 0x77, 0x54, 0xed, 0xd2, 0x77, 0xc1, 0x12, 0x99, 0xd8, 0xe9, 0xc1, 0x6c, 0x6b, 0x9e,
+	0xf5, 0x22, 0x79, 0xc9, 0x6a, 0x6d, 0x81, 0xe5, 0xef, 0xa3, 0x98, 0x31, 0xfa, 0x43, 0x87, 0x08,
+	0x4c, 0x09, 0x17, 0x18, 0x9d, 0x33, 0x46, 0xb9, 0x5e, 0x03, 0x15, 0x82, 0xe4, 0xa1, 0x56, 0x36,
+	0xab, 0xae, 0x1c, 0x6e, 0x5d, 0x82, 0xc5, 0x29, 0x4f, 0x89, 0xbe, 0x0a, 0x56, 0xa6, 0x4c, 0x9f,
+	0x42, 0x41, 0x7a, 0xb8, 0x56, 0xd2, 0x0d, 0xd0, 0x98, 0x02, 0x9f, 0x9c, 0x5f, 0x74, 0x60, 0x82,
+	0x6b, 0x5a, 0xa3, 0x7a, 0xf3, 0x8b, 0x51, 0x3a, 0x38, 0xbb, 0x7b, 0x30, 0xb4, 0xfb, 0x07, 0x43,
+	0xfb, 0xfb, 0xc1, 0xd0, 0x7e, 0x7e, 0x34, 0x4a, 0xf7, 0x8f, 0x46, 0xe9, 0x8f, 0x47, 0xa3, 0x74,
+	0xf9, 0xc5, 0x44, 0xaa, 0xf9, 0xe3, 0xb6, 0x43, 0x61, 0x9b, 0x8f, 0x0a, 0xa7, 0xf7, 0xa5, 0xd3,
+	0x9f, 0x7c, 0xdd, 0x55, 0xd0, 0xed, 0x77, 0xea, 0x39, 0xfd, 0xfc, 0xdf, 0x01, 0x00, 0x4d, 0x80,
+	0x0b, 0x07, 0x00, 0x06, 0x00, 0x00,
 }
 
 func (this *SuperfluidAsset) Equal(that interface{}) bool {
@@ -467,6 +478,20 @@ func (this *SuperfluidAsset) Equal(that interface{}) bool {
 	if this.AssetType != that1.AssetType {
 		return false
 	}
+	if that1.RiskFactor == nil {
+		if this.RiskFactor != nil {
+			return false
+		}
+	} else if !this.RiskFactor.Equal(*that1.RiskFactor) {
+		return false
+	}
+	if that1.MaxSuperfluidOsmo == nil {
+		if this.MaxSuperfluidOsmo != nil {
+			return false
+		}
+	} else if !this.MaxSuperfluidOsmo.Equal(*that1.MaxSuperfluidOsmo) {
+		return false
+	}
 	return true
 }
 func (m *SuperfluidAsset) Marshal() (dAtA []byte, err error) {
@@ -489,6 +514,30 @@ func (m *SuperfluidAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSuperfluidOsmo != nil {
+		{
+			size := m.MaxSuperfluidOsmo.Size()
+			i -= size
+			if _, err := m.MaxSuperfluidOsmo.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RiskFactor != nil {
+		{
+			size := m.RiskFactor.Size()
+			i -= size
+			if _, err := m.RiskFactor.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.AssetType != 0 {
 		i = encodeVarintSuperfluid(dAtA, i, uint64(m.AssetType))
 		i--
@@ -750,6 +799,14 @@ func (m *SuperfluidAsset) Size() (n int) {
 	if m.AssetType != 0 {
 		n += 1 + sovSuperfluid(uint64(m.AssetType))
 	}
+	if m.RiskFactor != nil {
+		l = m.RiskFactor.Size()
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	if m.MaxSuperfluidOsmo != nil {
+		l = m.MaxSuperfluidOsmo.Size()
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
 	return n
 }
 
@@ -932,6 +989,78 @@ func (m *SuperfluidAsset) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RiskFactor = &v
+			if err := m.RiskFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSuperfluidOsmo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxSuperfluidOsmo = &v
+			if err := m.MaxSuperfluidOsmo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSuperfluid(dAtA[iNdEx:])
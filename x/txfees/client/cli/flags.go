@@ -0,0 +1,10 @@
+package cli
+
+const (
+	// Will be parsed to []uint64.
+	FlagRoutePoolIds = "route-pool-ids"
+	// Will be parsed to []string.
+	FlagRouteDenoms = "route-denoms"
+	// Will be parsed to string.
+	FlagTxFile = "tx-file"
+)
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -10,7 +11,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 )
 
 // GetQueryCmd returns the cli query commands for this module.
@@ -28,6 +31,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdFeeTokens(),
 		GetCmdDenomPoolID(),
 		GetCmdBaseDenom(),
+		GetCmdPendingConversions(),
+		GetCmdEstimateFee(),
 	)
 
 	return cmd
@@ -142,3 +147,105 @@ $ %s query txfees base-denom
 
 	return cmd
 }
+
+// GetCmdPendingConversions returns the fee token balances still to be swapped into the base denom.
+func GetCmdPendingConversions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-conversions",
+		Short: "Query the fee token balances still to be swapped into the base denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fee token balances still to be swapped into the base denom, in the multi block epoch swap mode
+
+Example:
+$ %s query txfees pending-conversions
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingConversions(cmd.Context(), &types.QueryPendingConversionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdEstimateFee returns the minimum fee in a fee token that the node accepts for a tx wanting the given gas.
+func GetCmdEstimateFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-fee [gas-wanted] [denom]",
+		Short: "Query the minimum fee in a fee token that the node accepts for a tx wanting the given gas",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the minimum fee in a fee token that the node accepts into its mempool for a tx wanting the given gas.
+The denom defaults to the base denom. The mempool's fee filters are matched against the msgs of
+the tx in the --tx-file, such as one generated with --generate-only.
+
+Example:
+$ %s query txfees estimate-fee 200000 uion
+$ %s query txfees estimate-fee 200000 uion --tx-file tx.json
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			gasWanted, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			denom := ""
+			if len(args) > 1 {
+				denom = args[1]
+			}
+			var msgs []sdk.Msg
+			txFile, err := cmd.Flags().GetString(FlagTxFile)
+			if err != nil {
+				return err
+			}
+			if txFile != "" {
+				tx, err := authclient.ReadTxFromFile(clientCtx, txFile)
+				if err != nil {
+					return err
+				}
+				msgs = tx.GetMsgs()
+			}
+
+			req, err := types.NewQueryEstimateFeeRequest(gasWanted, denom, msgs)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateFee(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagTxFile, "", "file with the JSON encoded tx whose msgs the fee filters are matched against")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
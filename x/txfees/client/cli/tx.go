@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"errors"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -13,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 )
 
@@ -49,9 +51,15 @@ func NewCmdSubmitUpdateFeeTokenProposal() *cobra.Command {
 				return err
 			}
 
+			route, err := feeTokenRoute(cmd)
+			if err != nil {
+				return err
+			}
+
 			feeToken := types.FeeToken{
 				Denom:  denom,
 				PoolID: pool_id,
+				Route:  route,
 			}
 
 			title, err := cmd.Flags().GetString(cli.FlagTitle)
@@ -93,9 +101,39 @@ func NewCmdSubmitUpdateFeeTokenProposal() *cobra.Command {
 	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
 	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().StringSlice(FlagRoutePoolIds, []string{}, "pool ids of the fee token's route into the base denom, starting with poolId")
+	cmd.Flags().StringSlice(FlagRouteDenoms, []string{}, "output denoms of the fee token's route into the base denom, ending with the base denom")
 	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.MarkFlagRequired(cli.FlagTitle)
 	_ = cmd.MarkFlagRequired(cli.FlagDescription)
 
 	return cmd
 }
+
+// feeTokenRoute parses the fee token route from the route flags, if they are set.
+func feeTokenRoute(cmd *cobra.Command) ([]gammtypes.SwapAmountInRoute, error) {
+	routePoolIds, err := cmd.Flags().GetStringSlice(FlagRoutePoolIds)
+	if err != nil {
+		return nil, err
+	}
+	routeDenoms, err := cmd.Flags().GetStringSlice(FlagRouteDenoms)
+	if err != nil {
+		return nil, err
+	}
+	if len(routePoolIds) != len(routeDenoms) {
+		return nil, errors.New("route pool ids and denoms should have the same length")
+	}
+
+	route := []gammtypes.SwapAmountInRoute{}
+	for i, poolIdStr := range routePoolIds {
+		poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		route = append(route, gammtypes.SwapAmountInRoute{
+			PoolId:        poolId,
+			TokenOutDenom: routeDenoms[i],
+		})
+	}
+	return route, nil
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	txfeestypes "github.com/osmosis-labs/osmosis/v7/x/txfees/types"
+)
+
+// EndBlock swaps the next part of every pending conversion into the base denom,
+// and transfers the base denom received to the fee collector.
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	pendingConversions := k.GetPendingConversions(ctx)
+	if len(pendingConversions) == 0 {
+		return
+	}
+
+	nonNativeFeeAddr := k.accountKeeper.GetModuleAddress(txfeestypes.NonNativeFeeCollectorName)
+	baseDenom, _ := k.GetBaseDenom(ctx)
+
+	for _, conversion := range pendingConversions {
+		amount := sdk.MinInt(conversion.Remaining, conversion.AmountPerBlock)
+		// Only what is still held by the module account can be swapped.
+		amount = sdk.MinInt(amount, k.bankKeeper.GetBalance(ctx, nonNativeFeeAddr, conversion.Denom).Amount)
+		if !amount.IsPositive() {
+			k.deletePendingConversion(ctx, conversion.Denom)
+			continue
+		}
+
+		tokenIn := sdk.NewCoin(conversion.Denom, amount)
+		swapped, err := k.swapFeeTokenToBaseDenom(ctx, nonNativeFeeAddr, conversion.SwapRoutes(baseDenom), tokenIn, conversion.MinPrice)
+		if remaining := amount.Sub(swapped); remaining.IsPositive() {
+			ctx.EventManager().EmitEvent(txfeestypes.CreateEpochSwapCarriedOverEvent(
+				conversion.PoolID, sdk.NewCoin(conversion.Denom, remaining), err.Error()))
+		}
+
+		conversion.Remaining = conversion.Remaining.Sub(swapped)
+		if conversion.Remaining.IsPositive() {
+			k.setPendingConversion(ctx, conversion)
+		} else {
+			k.deletePendingConversion(ctx, conversion.Denom)
+		}
+	}
+
+	k.sendBaseDenomToFeeCollector(ctx)
+}
@@ -2,11 +2,11 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
-	"github.com/osmosis-labs/osmosis/v7/x/txfees/keeper/txfee_filters"
 	"github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -88,6 +88,10 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		}
 	}
 
+	// TODO: Once the SDK and Tendermint carry a tx priority into the mempool, set it in CheckTx with
+	// ctx.WithPriority(mfd.TxFeesKeeper.GetTxPriority(ctx, feeCoins, feeTx.GetGas())),
+	// so that the mempool orders txs by the base denom value of their fee per gas.
+
 	return next(ctx, tx, simulate)
 }
 
@@ -115,15 +119,80 @@ func (k Keeper) IsSufficientFee(ctx sdk.Context, minBaseGasPrice sdk.Dec, gasReq
 	return nil
 }
 
+// TxPriorityGasPriceScale scales the base denom gas price of a tx into its integer priority,
+// so that gas prices below one base denom unit per gas, e.g. .0025 uosmo, still order txs.
+const TxPriorityGasPriceScale = 1_000_000
+
+// GetTxPriority returns the mempool priority of a tx paying feeCoins for gasWanted gas,
+// which is the base denom value of its fee per gas, scaled by TxPriorityGasPriceScale.
+// A fee in a fee token is valued at its current spot price, as in IsSufficientFee,
+// so that txs paying in different fee tokens are ordered by what their fee is worth.
+func (k Keeper) GetTxPriority(ctx sdk.Context, feeCoins sdk.Coins, gasWanted uint64) (int64, error) {
+	if len(feeCoins) == 0 || gasWanted == 0 {
+		return 0, nil
+	}
+	if len(feeCoins) > 1 {
+		return 0, types.ErrTooManyFeeCoins
+	}
+
+	convertedFee, err := k.ConvertToBaseToken(ctx, feeCoins[0])
+	if err != nil {
+		return 0, err
+	}
+
+	priority := convertedFee.Amount.MulRaw(TxPriorityGasPriceScale).Quo(sdk.NewIntFromUint64(gasWanted))
+	if !priority.IsInt64() {
+		return math.MaxInt64, nil
+	}
+	return priority.Int64(), nil
+}
+
 func (mfd MempoolFeeDecorator) GetMinBaseGasPriceForTx(ctx sdk.Context, baseDenom string, tx sdk.FeeTx) sdk.Dec {
 	cfgMinGasPrice := ctx.MinGasPrices().AmountOf(baseDenom)
-	if tx.GetGas() >= mfd.Opts.HighGasTxThreshold {
-		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, mfd.Opts.MinGasPriceForHighGasTx)
+	return mfd.Opts.MinBaseGasPrice(cfgMinGasPrice, tx.GetGas(), tx.GetMsgs())
+}
+
+// EstimateFee returns the minimum fee in denom that this node accepts into its mempool,
+// for a tx with msgs wanting gasWanted gas, at current spot prices. It applies the same rules as the MempoolFeeDecorator,
+// with the node's minimum gas prices taken from ctx. It also returns the minimum base denom gas price the fee is derived from.
+// Consensus accepts any fee in the base denom or a whitelisted fee token, so it adds no further minimum.
+func (k Keeper) EstimateFee(ctx sdk.Context, gasWanted uint64, denom string, msgs []sdk.Msg) (sdk.Coin, sdk.Dec, error) {
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, sdk.Dec{}, err
+	}
+	if denom == "" {
+		denom = baseDenom
+	}
+	if denom != baseDenom {
+		if _, err := k.GetFeeToken(ctx, denom); err != nil {
+			return sdk.Coin{}, sdk.Dec{}, err
+		}
+	}
+
+	opts := *k.mempoolFeeOpts
+	if gasWanted > opts.MaxGasWantedPerTx {
+		msg := "Too much gas wanted: %d, maximum is %d"
+		return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, msg, gasWanted, opts.MaxGasWantedPerTx)
+	}
+
+	minBaseGasPrice := opts.MinBaseGasPrice(ctx.MinGasPrices().AmountOf(baseDenom), gasWanted, msgs)
+	// This matches the required fee of IsSufficientFee.
+	requiredBaseFee := minBaseGasPrice.Mul(sdk.NewDec(int64(gasWanted))).Ceil().RoundInt()
+	if denom == baseDenom || requiredBaseFee.IsZero() {
+		return sdk.NewCoin(denom, requiredBaseFee), minBaseGasPrice, nil
+	}
+
+	spotPrice, err := k.CalcFeeSpotPrice(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, sdk.Dec{}, err
 	}
-	if txfee_filters.IsArbTxLoose(tx) {
-		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, mfd.Opts.MinGasPriceForArbitrageTx)
+	if !spotPrice.IsPositive() {
+		return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidFeeToken, "%s has no spot price", denom)
 	}
-	return cfgMinGasPrice
+	// The smallest amount whose conversion to the base denom is at least the required base fee.
+	requiredFee := requiredBaseFee.ToDec().Quo(spotPrice).Ceil().TruncateInt()
+	return sdk.NewCoin(denom, requiredFee), minBaseGasPrice, nil
 }
 
 // DeductFeeDecorator deducts fees from the first signer of the tx.
@@ -9,6 +9,7 @@ import (
 
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v7/x/txfees/keeper"
 	"github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 )
@@ -239,3 +240,230 @@ func (suite *KeeperTestSuite) TestFeeDecorator() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestEstimateFee() {
+	suite.SetupTest(false)
+	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+
+	// uion is worth 3 base denom.
+	uion := "uion"
+	uionPoolId := suite.PrepareUni2PoolWithAssets(
+		sdk.NewInt64Coin(baseDenom, 1500),
+		sdk.NewInt64Coin(uion, 500),
+	)
+	suite.ExecuteUpgradeFeeTokenProposal(uion, uionPoolId)
+
+	mempoolFeeOpts := types.NewDefaultMempoolFeeOptions()
+	mempoolFeeOpts.MinGasPriceForHighGasTx = sdk.MustNewDecFromStr("0.0025")
+	mempoolFeeOpts.FeeFilters = append(types.DefaultFeeFilters(sdk.MustNewDecFromStr("0.1")), types.FeeFilter{
+		Name:               "uion pool",
+		Matcher:            types.PoolMatcher{PoolIds: []uint64{uionPoolId}},
+		GasPriceMultiplier: sdk.NewDec(2),
+	})
+	suite.App.TxFeesKeeper.SetMempoolFeeOptions(mempoolFeeOpts)
+	defer suite.App.TxFeesKeeper.SetMempoolFeeOptions(types.NewDefaultMempoolFeeOptions())
+
+	swapMsg := func(poolId uint64, tokenIn, tokenOutDenom string) sdk.Msg {
+		return &gammtypes.MsgSwapExactAmountIn{
+			Sender:            suite.TestAccs[0].String(),
+			Routes:            []gammtypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}},
+			TokenIn:           sdk.NewInt64Coin(tokenIn, 10),
+			TokenOutMinAmount: sdk.OneInt(),
+		}
+	}
+
+	tests := []struct {
+		name                    string
+		minGasPrices            sdk.DecCoins
+		gasWanted               uint64
+		denom                   string
+		msgs                    []sdk.Msg
+		expectedFee             sdk.Coin
+		expectedMinBaseGasPrice sdk.Dec
+		expectPass              bool
+	}{
+		{
+			name:                    "no min gas price",
+			minGasPrices:            sdk.NewDecCoins(),
+			gasWanted:               10000,
+			denom:                   uion,
+			expectedFee:             sdk.NewInt64Coin(uion, 0),
+			expectedMinBaseGasPrice: sdk.ZeroDec(),
+			expectPass:              true,
+		},
+		{
+			name:                    "base denom",
+			minGasPrices:            sdk.NewDecCoins(sdk.NewDecCoinFromDec(baseDenom, sdk.MustNewDecFromStr("0.1"))),
+			gasWanted:               10001,
+			denom:                   "",
+			expectedFee:             sdk.NewInt64Coin(baseDenom, 1001),
+			expectedMinBaseGasPrice: sdk.MustNewDecFromStr("0.1"),
+			expectPass:              true,
+		},
+		{
+			name:                    "fee token rounds up",
+			minGasPrices:            sdk.NewDecCoins(sdk.NewDecCoinFromDec(baseDenom, sdk.MustNewDecFromStr("0.1"))),
+			gasWanted:               10001,
+			denom:                   uion,
+			expectedFee:             sdk.NewInt64Coin(uion, 334),
+			expectedMinBaseGasPrice: sdk.MustNewDecFromStr("0.1"),
+			expectPass:              true,
+		},
+		{
+			name:                    "high gas tx",
+			minGasPrices:            sdk.NewDecCoins(),
+			gasWanted:               mempoolFeeOpts.HighGasTxThreshold,
+			denom:                   uion,
+			expectedFee:             sdk.NewInt64Coin(uion, 834),
+			expectedMinBaseGasPrice: sdk.MustNewDecFromStr("0.0025"),
+			expectPass:              true,
+		},
+		{
+			name:                    "arbitrage tx",
+			minGasPrices:            sdk.NewDecCoins(),
+			gasWanted:               10000,
+			denom:                   uion,
+			msgs:                    []sdk.Msg{swapMsg(uionPoolId+1, uion, uion)},
+			expectedFee:             sdk.NewInt64Coin(uion, 334),
+			expectedMinBaseGasPrice: sdk.MustNewDecFromStr("0.1"),
+			expectPass:              true,
+		},
+		{
+			name:                    "pool filter multiplies the min gas price",
+			minGasPrices:            sdk.NewDecCoins(sdk.NewDecCoinFromDec(baseDenom, sdk.MustNewDecFromStr("0.1"))),
+			gasWanted:               10000,
+			denom:                   baseDenom,
+			msgs:                    []sdk.Msg{swapMsg(uionPoolId, uion, baseDenom)},
+			expectedFee:             sdk.NewInt64Coin(baseDenom, 2000),
+			expectedMinBaseGasPrice: sdk.MustNewDecFromStr("0.2"),
+			expectPass:              true,
+		},
+		{
+			name:                    "filters apply in order",
+			minGasPrices:            sdk.NewDecCoins(),
+			gasWanted:               10000,
+			denom:                   baseDenom,
+			msgs:                    []sdk.Msg{swapMsg(uionPoolId, uion, uion)},
+			expectedFee:             sdk.NewInt64Coin(baseDenom, 2000),
+			expectedMinBaseGasPrice: sdk.MustNewDecFromStr("0.2"),
+			expectPass:              true,
+		},
+		{
+			name:         "too much gas wanted",
+			minGasPrices: sdk.NewDecCoins(),
+			gasWanted:    mempoolFeeOpts.MaxGasWantedPerTx + 1,
+			denom:        uion,
+			expectPass:   false,
+		},
+		{
+			name:         "not a fee token",
+			minGasPrices: sdk.NewDecCoins(),
+			gasWanted:    10000,
+			denom:        "foo",
+			expectPass:   false,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			ctx := suite.Ctx.WithMinGasPrices(tc.minGasPrices)
+			fee, minBaseGasPrice, err := suite.App.TxFeesKeeper.EstimateFee(ctx, tc.gasWanted, tc.denom, tc.msgs)
+			if !tc.expectPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedFee, fee)
+			suite.Require().Equal(tc.expectedMinBaseGasPrice, minBaseGasPrice)
+
+			// the estimated fee is the smallest one the mempool accepts.
+			err = suite.App.TxFeesKeeper.IsSufficientFee(ctx, minBaseGasPrice, tc.gasWanted, fee)
+			suite.Require().NoError(err)
+			if fee.IsPositive() {
+				err = suite.App.TxFeesKeeper.IsSufficientFee(ctx, minBaseGasPrice, tc.gasWanted, fee.SubAmount(sdk.OneInt()))
+				suite.Require().Error(err)
+			}
+		})
+	}
+
+	// the query matches the fee filters against the packed msgs.
+	req, err := types.NewQueryEstimateFeeRequest(10000, baseDenom, []sdk.Msg{swapMsg(uionPoolId+1, uion, uion)})
+	suite.Require().NoError(err)
+	res, err := suite.queryClient.EstimateFee(suite.Ctx.Context(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(baseDenom, 1000), res.Fee)
+}
+
+func (suite *KeeperTestSuite) TestGetTxPriority() {
+	suite.SetupTest(false)
+	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+
+	// uion is worth 3 base denom.
+	uion := "uion"
+	uionPoolId := suite.PrepareUni2PoolWithAssets(
+		sdk.NewInt64Coin(baseDenom, 1500),
+		sdk.NewInt64Coin(uion, 500),
+	)
+	suite.ExecuteUpgradeFeeTokenProposal(uion, uionPoolId)
+
+	tests := []struct {
+		name             string
+		fee              sdk.Coins
+		gasWanted        uint64
+		expectedPriority int64
+		expectPass       bool
+	}{
+		{
+			name:             "no fee",
+			fee:              sdk.NewCoins(),
+			gasWanted:        10000,
+			expectedPriority: 0,
+			expectPass:       true,
+		},
+		{
+			name:             "base denom fee",
+			fee:              sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 25)),
+			gasWanted:        10000,
+			expectedPriority: 2500,
+			expectPass:       true,
+		},
+		{
+			name:             "fee token fee is valued in the base denom",
+			fee:              sdk.NewCoins(sdk.NewInt64Coin(uion, 25)),
+			gasWanted:        10000,
+			expectedPriority: 7500,
+			expectPass:       true,
+		},
+		{
+			name:             "no gas wanted",
+			fee:              sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 25)),
+			gasWanted:        0,
+			expectedPriority: 0,
+			expectPass:       true,
+		},
+		{
+			name:       "not a fee token",
+			fee:        sdk.NewCoins(sdk.NewInt64Coin("uatom", 25)),
+			gasWanted:  10000,
+			expectPass: false,
+		},
+		{
+			name:       "too many fee coins",
+			fee:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 25), sdk.NewInt64Coin(uion, 25)),
+			gasWanted:  10000,
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			priority, err := suite.App.TxFeesKeeper.GetTxPriority(suite.Ctx, tc.fee, tc.gasWanted)
+			if !tc.expectPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedPriority, priority)
+		})
+	}
+}
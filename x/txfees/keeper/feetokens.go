@@ -43,9 +43,16 @@ func (k Keeper) CalcFeeSpotPrice(ctx sdk.Context, inputDenom string) (sdk.Dec, e
 		return sdk.Dec{}, err
 	}
 
-	spotPrice, err := k.spotPriceCalculator.CalculateSpotPrice(ctx, feeToken.PoolID, baseDenom, feeToken.Denom)
-	if err != nil {
-		return sdk.Dec{}, err
+	// The spot price of the fee token in the base denom is the product of the spot prices along its route.
+	spotPrice := sdk.OneDec()
+	tokenInDenom := feeToken.Denom
+	for _, hop := range feeToken.SwapRoutes(baseDenom) {
+		hopSpotPrice, err := k.spotPriceCalculator.CalculateSpotPrice(ctx, hop.PoolId, hop.TokenOutDenom, tokenInDenom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		spotPrice = spotPrice.Mul(hopSpotPrice)
+		tokenInDenom = hop.TokenOutDenom
 	}
 	return spotPrice, nil
 }
@@ -82,6 +89,8 @@ func (k Keeper) SetBaseDenom(ctx sdk.Context, denom string) error {
 // - The denom is not the base denom
 // - The gamm pool exists
 // - The gamm pool includes the base token and fee token.
+// If the fee token has a route, the last two are instead checked for every hop on it,
+// and its last hop must be the only one into the base token.
 func (k Keeper) ValidateFeeToken(ctx sdk.Context, feeToken types.FeeToken) error {
 	baseDenom, err := k.GetBaseDenom(ctx)
 	if err != nil {
@@ -90,13 +99,28 @@ func (k Keeper) ValidateFeeToken(ctx sdk.Context, feeToken types.FeeToken) error
 	if baseDenom == feeToken.Denom {
 		return sdkerrors.Wrap(types.ErrInvalidFeeToken, "cannot add basedenom as a whitelisted fee token")
 	}
-	// This not returning an error implies that:
-	// - feeToken.Denom exists
-	// - feeToken.PoolID exists
-	// - feeToken.PoolID has both feeToken.Denom and baseDenom
-	_, err = k.spotPriceCalculator.CalculateSpotPrice(ctx, feeToken.PoolID, feeToken.Denom, baseDenom)
+	if err := feeToken.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidFeeToken, err.Error())
+	}
+
+	routes := feeToken.SwapRoutes(baseDenom)
+	tokenInDenom := feeToken.Denom
+	for i, hop := range routes {
+		if isLastHop := i == len(routes)-1; isLastHop != (hop.TokenOutDenom == baseDenom) {
+			return sdkerrors.Wrapf(types.ErrInvalidFeeToken, "route of %s must output basedenom on its last hop only", feeToken.Denom)
+		}
+		// This not returning an error implies that:
+		// - tokenInDenom exists
+		// - hop.PoolId exists
+		// - hop.PoolId has both tokenInDenom and hop.TokenOutDenom
+		_, err = k.spotPriceCalculator.CalculateSpotPrice(ctx, hop.PoolId, tokenInDenom, hop.TokenOutDenom)
+		if err != nil {
+			return err
+		}
+		tokenInDenom = hop.TokenOutDenom
+	}
 
-	return err
+	return nil
 }
 
 // GetFeeToken returns the fee token record for a specific denom.
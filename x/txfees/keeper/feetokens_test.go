@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -219,3 +220,114 @@ func (suite *KeeperTestSuite) TestFeeTokenConversions() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestFeeTokenRoutes() {
+	suite.SetupTest(false)
+	baseDenom := sdk.DefaultBondDenom
+
+	// foo is worth 0.5 base denom, and atom 3 foo, so 1.5 base denom.
+	fooPoolId := suite.PrepareUni2PoolWithAssets(
+		sdk.NewInt64Coin(baseDenom, 500),
+		sdk.NewInt64Coin("foo", 1000),
+	)
+	atomPoolId := suite.PrepareUni2PoolWithAssets(
+		sdk.NewInt64Coin("foo", 600),
+		sdk.NewInt64Coin("atom", 200),
+	)
+	atomBasePoolId := suite.PrepareUni2PoolWithAssets(
+		sdk.NewInt64Coin(baseDenom, 500),
+		sdk.NewInt64Coin("atom", 500),
+	)
+	barPoolId := suite.PrepareUni2PoolWithAssets(
+		sdk.NewInt64Coin("bar", 500),
+		sdk.NewInt64Coin("foo", 500),
+	)
+
+	tests := []struct {
+		name              string
+		feeToken          types.FeeToken
+		expectPass        bool
+		expectedSpotPrice sdk.Dec
+	}{
+		{
+			name: "route through foo",
+			feeToken: types.FeeToken{Denom: "atom", PoolID: atomPoolId, Route: []gammtypes.SwapAmountInRoute{
+				{PoolId: atomPoolId, TokenOutDenom: "foo"},
+				{PoolId: fooPoolId, TokenOutDenom: baseDenom},
+			}},
+			expectPass:        true,
+			expectedSpotPrice: sdk.NewDecWithPrec(15, 1),
+		},
+		{
+			name: "single hop route",
+			feeToken: types.FeeToken{Denom: "foo", PoolID: fooPoolId, Route: []gammtypes.SwapAmountInRoute{
+				{PoolId: fooPoolId, TokenOutDenom: baseDenom},
+			}},
+			expectPass:        true,
+			expectedSpotPrice: sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			name: "route not starting with the pool id",
+			feeToken: types.FeeToken{Denom: "atom", PoolID: fooPoolId, Route: []gammtypes.SwapAmountInRoute{
+				{PoolId: atomPoolId, TokenOutDenom: "foo"},
+				{PoolId: fooPoolId, TokenOutDenom: baseDenom},
+			}},
+			expectPass: false,
+		},
+		{
+			name: "route not ending in the base denom",
+			feeToken: types.FeeToken{Denom: "atom", PoolID: atomPoolId, Route: []gammtypes.SwapAmountInRoute{
+				{PoolId: atomPoolId, TokenOutDenom: "foo"},
+				{PoolId: barPoolId, TokenOutDenom: "bar"},
+			}},
+			expectPass: false,
+		},
+		{
+			name: "route reaching the base denom before its last hop",
+			feeToken: types.FeeToken{Denom: "atom", PoolID: atomBasePoolId, Route: []gammtypes.SwapAmountInRoute{
+				{PoolId: atomBasePoolId, TokenOutDenom: baseDenom},
+				{PoolId: fooPoolId, TokenOutDenom: "foo"},
+			}},
+			expectPass: false,
+		},
+		{
+			name: "route through a pool without the hop's denoms",
+			feeToken: types.FeeToken{Denom: "atom", PoolID: atomPoolId, Route: []gammtypes.SwapAmountInRoute{
+				{PoolId: atomPoolId, TokenOutDenom: "foo"},
+				{PoolId: barPoolId, TokenOutDenom: baseDenom},
+			}},
+			expectPass: false,
+		},
+		{
+			name: "route swapping back into the fee token",
+			feeToken: types.FeeToken{Denom: "atom", PoolID: atomPoolId, Route: []gammtypes.SwapAmountInRoute{
+				{PoolId: atomPoolId, TokenOutDenom: "foo"},
+				{PoolId: atomPoolId, TokenOutDenom: "atom"},
+				{PoolId: atomBasePoolId, TokenOutDenom: baseDenom},
+			}},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			err := suite.App.TxFeesKeeper.ValidateFeeToken(suite.Ctx, tc.feeToken)
+			if !tc.expectPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			prop := types.NewUpdateFeeTokenProposal("Test Proposal", "test", tc.feeToken)
+			err = suite.App.TxFeesKeeper.HandleUpdateFeeTokenProposal(suite.Ctx, &prop)
+			suite.Require().NoError(err)
+
+			spotPrice, err := suite.App.TxFeesKeeper.CalcFeeSpotPrice(suite.Ctx, tc.feeToken.Denom)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedSpotPrice, spotPrice)
+			converted, err := suite.App.TxFeesKeeper.ConvertToBaseToken(suite.Ctx, sdk.NewInt64Coin(tc.feeToken.Denom, 10))
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedSpotPrice.MulInt64(10).RoundInt(), converted.Amount)
+		})
+	}
+}
@@ -17,6 +17,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if err != nil {
 		panic(err)
 	}
+	k.SetParams(ctx, genState.Params)
+	for _, conversion := range genState.PendingConversions {
+		k.setPendingConversion(ctx, conversion)
+	}
 }
 
 // ExportGenesis returns the txfees module's exported genesis.
@@ -24,5 +28,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Basedenom, _ = k.GetBaseDenom(ctx)
 	genesis.Feetokens = k.GetFeeTokens(ctx)
+	genesis.Params = k.GetParams(ctx)
+	genesis.PendingConversions = k.GetPendingConversions(ctx)
 	return genesis
 }
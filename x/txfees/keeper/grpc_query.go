@@ -81,3 +81,30 @@ func (q Querier) BaseDenom(ctx context.Context, _ *types.QueryBaseDenomRequest)
 
 	return &types.QueryBaseDenomResponse{BaseDenom: baseDenom}, nil
 }
+
+func (q Querier) PendingConversions(ctx context.Context, _ *types.QueryPendingConversionsRequest) (*types.QueryPendingConversionsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pendingConversions := q.Keeper.GetPendingConversions(sdkCtx)
+
+	return &types.QueryPendingConversionsResponse{PendingConversions: pendingConversions}, nil
+}
+
+func (q Querier) EstimateFee(ctx context.Context, req *types.QueryEstimateFeeRequest) (*types.QueryEstimateFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	msgs, err := req.GetSdkMsgs()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	fee, minBaseGasPrice, err := q.Keeper.EstimateFee(sdkCtx, req.GasWanted, req.Denom, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEstimateFeeResponse{Fee: fee, MinBaseGasPrice: minBaseGasPrice}, nil
+}
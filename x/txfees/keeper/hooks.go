@@ -1,21 +1,46 @@
 package keeper
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v7/osmoutils"
 	epochstypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	txfeestypes "github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 )
 
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {}
 
-// at the end of each epoch, swap all non-OSMO fees into OSMO and transfer to fee module account
+// maxEpochSwapHalvings is how many times the amount of a fee token swapped at the end of an epoch
+// is halved, when swapping all of it would sell it too far below its reference price.
+const maxEpochSwapHalvings = 4
+
+// at the end of each EpochIdentifier epoch, swap all non-OSMO fees into OSMO and transfer to fee module account.
+// In the multi block epoch swap mode, the swaps are instead recorded as pending conversions,
+// which are carried out in parts by the end blockers of the following blocks.
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	params := k.GetParams(ctx)
+	if epochIdentifier != params.EpochIdentifier {
+		return
+	}
 	nonNativeFeeAddr := k.accountKeeper.GetModuleAddress(txfeestypes.NonNativeFeeCollectorName)
 	baseDenom, _ := k.GetBaseDenom(ctx)
 	feeTokens := k.GetFeeTokens(ctx)
 
+	// Whatever wasn't converted over the last epoch is included in the balances below.
+	k.deleteAllPendingConversions(ctx)
+
+	// The reference price of every fee token is its twap over the epoch that just ended.
+	epochDuration := k.epochKeeper.GetEpochInfo(ctx, epochIdentifier).Duration
+	if err := k.validateTwapKeepPeriod(ctx, epochDuration); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("not swapping fee tokens: %s", err))
+		return
+	}
+	twapStartTime := ctx.BlockTime().Add(-epochDuration)
+
 	for _, feetoken := range feeTokens {
 		if feetoken.Denom == baseDenom {
 			continue
@@ -25,18 +50,56 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			continue
 		}
 
-		// Do the swap of this fee token denom to base denom.
-		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
-			// We allow full slippage. Theres not really an effective way to bound slippage until TWAP's land,
-			// but even then the point is a bit moot.
-			// The only thing that could be done is a costly griefing attack to reduce the amount of osmo given as tx fees.
-			// However the idea of the txfees FeeToken gating is that the pool is sufficiently liquid for that base token.
-			minAmountOut := sdk.ZeroInt()
-			_, err := k.gammKeeper.SwapExactAmountIn(cacheCtx, nonNativeFeeAddr, feetoken.PoolID, coinBalance, baseDenom, minAmountOut)
-			return err
-		})
+		routes := feetoken.SwapRoutes(baseDenom)
+		referencePrice, err := k.routeTwapToNow(ctx, feetoken.Denom, routes, twapStartTime)
+		if err != nil {
+			k.Logger(ctx).Info(fmt.Sprintf("not swapping %s, as its twap is unavailable: %s", coinBalance, err))
+			ctx.EventManager().EmitEvent(txfeestypes.CreateEpochSwapCarriedOverEvent(feetoken.PoolID, coinBalance, err.Error()))
+			continue
+		}
+		minPrice := referencePrice.Mul(sdk.OneDec().Sub(params.MaxEpochSwapPriceDeviation))
+
+		if params.EpochSwapMode == txfeestypes.EpochSwapModeMultiBlock {
+			// The first part is converted in this block's end blocker.
+			blocks := sdk.NewIntFromUint64(params.EpochSwapBlocks)
+			k.setPendingConversion(ctx, txfeestypes.PendingConversion{
+				Denom:          feetoken.Denom,
+				PoolID:         feetoken.PoolID,
+				Remaining:      coinBalance.Amount,
+				AmountPerBlock: coinBalance.Amount.Add(blocks).Sub(sdk.OneInt()).Quo(blocks),
+				MinPrice:       minPrice,
+				Route:          feetoken.Route,
+			})
+			continue
+		}
+
+		swapped, err := k.swapFeeTokenToBaseDenom(ctx, nonNativeFeeAddr, routes, coinBalance, minPrice)
+		if remaining := coinBalance.Amount.Sub(swapped); remaining.IsPositive() {
+			ctx.EventManager().EmitEvent(txfeestypes.CreateEpochSwapCarriedOverEvent(
+				feetoken.PoolID, sdk.NewCoin(feetoken.Denom, remaining), err.Error()))
+		}
 	}
 
+	k.sendBaseDenomToFeeCollector(ctx)
+}
+
+// validateTwapKeepPeriod checks that the twap records are kept for at least the epoch duration,
+// so that the twap over the epoch can be computed.
+func (k Keeper) validateTwapKeepPeriod(ctx sdk.Context, epochDuration time.Duration) error {
+	keepPeriod := k.twapKeeper.GetParams(ctx).RecordHistoryKeepPeriod
+	if keepPeriod < epochDuration {
+		return fmt.Errorf("twap record history keep period %s is shorter than the %s epoch duration %s",
+			keepPeriod, k.GetParams(ctx).EpochIdentifier, epochDuration)
+	}
+	return nil
+}
+
+// sendBaseDenomToFeeCollector sends all of the txfee payout denom in the non native fee collector
+// to the fee collector.
+func (k Keeper) sendBaseDenomToFeeCollector(ctx sdk.Context) {
+	nonNativeFeeAddr := k.accountKeeper.GetModuleAddress(txfeestypes.NonNativeFeeCollectorName)
+	baseDenom, _ := k.GetBaseDenom(ctx)
+
 	// Get all of the txfee payout denom in the module account
 	baseDenomCoins := sdk.NewCoins(k.bankKeeper.GetBalance(ctx, nonNativeFeeAddr, baseDenom))
 
@@ -46,6 +109,65 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	})
 }
 
+// routeTwapToNow returns the price of tokenInDenom in the last output denom of routes,
+// as the product of the twaps since startTime of every hop on them.
+func (k Keeper) routeTwapToNow(ctx sdk.Context, tokenInDenom string, routes []gammtypes.SwapAmountInRoute, startTime time.Time) (sdk.Dec, error) {
+	price := sdk.OneDec()
+	for _, hop := range routes {
+		// The twap is quoted in the hop's output denom per input denom.
+		hopPrice, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, hop.PoolId, hop.TokenOutDenom, tokenInDenom, startTime)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		price = price.Mul(hopPrice)
+		tokenInDenom = hop.TokenOutDenom
+	}
+	return price, nil
+}
+
+// swapFeeTokenToBaseDenom swaps tokenIn into the base denom along routes,
+// selling it for no less than minPrice, in the base denom per fee token.
+// If swapping all of tokenIn would fall below that price, successively halved amounts of it
+// are tried instead. It returns the amount of tokenIn that was swapped, and if that isn't all of it,
+// the error of the last swap attempt. Whatever isn't swapped stays in the sender's account.
+func (k Keeper) swapFeeTokenToBaseDenom(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []gammtypes.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	minPrice sdk.Dec,
+) (swapped sdk.Int, err error) {
+	poolId := routes[0].PoolId
+	baseDenom := routes[len(routes)-1].TokenOutDenom
+
+	swapAmount := tokenIn.Amount
+	for i := 0; i <= maxEpochSwapHalvings && swapAmount.IsPositive(); i++ {
+		swapIn := sdk.NewCoin(tokenIn.Denom, swapAmount)
+		minAmountOut := minPrice.MulInt(swapAmount).TruncateInt()
+		var amountOut sdk.Int
+		err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			if len(routes) == 1 {
+				amountOut, err = k.gammKeeper.SwapExactAmountIn(cacheCtx, sender, poolId, swapIn, baseDenom, minAmountOut)
+			} else {
+				amountOut, err = k.gammKeeper.MultihopSwapExactAmountIn(cacheCtx, sender, routes, swapIn, minAmountOut)
+			}
+			return err
+		})
+		if err == nil {
+			ctx.EventManager().EmitEvent(txfeestypes.CreateEpochSwapEvent(
+				poolId, swapIn, sdk.NewCoin(baseDenom, amountOut), sdk.NewCoin(baseDenom, minAmountOut)))
+			if swapAmount.LT(tokenIn.Amount) {
+				err = fmt.Errorf("swapping more than %s would exceed the max epoch swap price deviation", swapIn)
+			}
+			return swapAmount, err
+		}
+		swapAmount = swapAmount.QuoRaw(2)
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("not swapping %s, as no part of it could be swapped within the max price deviation: %s", tokenIn, err))
+	return sdk.ZeroInt(), err
+}
+
 // Hooks wrapper struct for incentives keeper
 type Hooks struct {
 	k Keeper
@@ -11,7 +11,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 )
 
-var defaultPooledAssetAmount = int64(500)
+var defaultPooledAssetAmount = int64(500000)
 
 func (suite *KeeperTestSuite) preparePool(denom string) (poolID uint64, pool gammtypes.PoolI) {
 	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
@@ -25,6 +25,12 @@ func (suite *KeeperTestSuite) preparePool(denom string) (poolID uint64, pool gam
 	return poolID, pool
 }
 
+// afterEpochCtx returns a context an epoch and a minute after the current one.
+func (suite *KeeperTestSuite) afterEpochCtx(epochIdentifier string) sdk.Context {
+	epochDuration := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, epochIdentifier).Duration
+	return suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(epochDuration).Add(time.Minute))
+}
+
 func (suite *KeeperTestSuite) TestTxFeesAfterEpochEnd() {
 	suite.SetupTest(false)
 	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
@@ -74,12 +80,266 @@ func (suite *KeeperTestSuite) TestTxFeesAfterEpochEnd() {
 	suite.Require().True(suite.App.BankKeeper.HasBalance(suite.Ctx, moduleAddrNonNativeFee, coins[1]))
 	suite.Require().True(suite.App.BankKeeper.HasBalance(suite.Ctx, moduleAddrNonNativeFee, coins[2]))
 
-	params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
-	futureCtx := suite.Ctx.WithBlockTime(time.Now().Add(time.Minute))
+	params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+	// the pools must be older than an epoch, for their twap over it to be available.
+	futureCtx := suite.afterEpochCtx(params.EpochIdentifier)
 
-	suite.App.EpochsKeeper.AfterEpochEnd(futureCtx, params.DistrEpochIdentifier, int64(1))
+	suite.App.EpochsKeeper.AfterEpochEnd(futureCtx, params.EpochIdentifier, int64(1))
 
 	moduleBaseDenomBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddrFee, baseDenom)
 	suite.Require().Empty(suite.App.BankKeeper.GetAllBalances(suite.Ctx, moduleAddrNonNativeFee))
 	suite.Require().True(moduleBaseDenomBalance.Amount.GTE(fullExpectedOutput.Amount))
 }
+
+func (suite *KeeperTestSuite) TestTxFeesAfterEpochEndPriceBound() {
+	uion := "uion"
+
+	tests := map[string]struct {
+		feeAmount int64
+		// olderThanEpoch is whether the pool was created more than an epoch before the epoch end.
+		olderThanEpoch bool
+		// manipulation is swapped into the pool right before the epoch end.
+		manipulation         sdk.Coin
+		expectedSwapped      sdk.Int
+		expectedCarriedOver  sdk.Int
+		expectCarriedOverEvt bool
+	}{
+		"small amount is swapped in full": {
+			feeAmount:           10000,
+			olderThanEpoch:      true,
+			expectedSwapped:     sdk.NewInt(10000),
+			expectedCarriedOver: sdk.ZeroInt(),
+		},
+		// swapping 100000 into the pool sells it ~17% below its price, 50000 ~9%, and 25000 ~5%.
+		"large amount is partially swapped, rest carried over": {
+			feeAmount:            100000,
+			olderThanEpoch:       true,
+			expectedSwapped:      sdk.NewInt(25000),
+			expectedCarriedOver:  sdk.NewInt(75000),
+			expectCarriedOverEvt: true,
+		},
+		"manipulated pool is not swapped into": {
+			feeAmount:            10000,
+			olderThanEpoch:       true,
+			manipulation:         sdk.NewInt64Coin(uion, 100000),
+			expectedSwapped:      sdk.ZeroInt(),
+			expectedCarriedOver:  sdk.NewInt(10000),
+			expectCarriedOverEvt: true,
+		},
+		"pool without a twap over the epoch is not swapped into": {
+			feeAmount:            10000,
+			olderThanEpoch:       false,
+			expectedSwapped:      sdk.ZeroInt(),
+			expectedCarriedOver:  sdk.NewInt(10000),
+			expectCarriedOverEvt: true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest(false)
+			baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+			epochIdentifier := suite.App.TxFeesKeeper.GetParams(suite.Ctx).EpochIdentifier
+
+			ctx := suite.Ctx
+			if tc.olderThanEpoch {
+				ctx = suite.afterEpochCtx(epochIdentifier)
+			}
+			poolId, _ := suite.preparePool(uion)
+
+			fees := sdk.NewCoins(sdk.NewInt64Coin(uion, tc.feeAmount))
+			suite.FundAcc(suite.TestAccs[1], fees)
+			err := suite.App.BankKeeper.SendCoinsFromAccountToModule(ctx, suite.TestAccs[1], types.NonNativeFeeCollectorName, fees)
+			suite.Require().NoError(err)
+
+			if !tc.manipulation.IsNil() {
+				suite.FundAcc(suite.TestAccs[2], sdk.NewCoins(tc.manipulation))
+				_, err = suite.App.GAMMKeeper.SwapExactAmountIn(ctx, suite.TestAccs[2], poolId, tc.manipulation, baseDenom, sdk.OneInt())
+				suite.Require().NoError(err)
+			}
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			suite.App.TxFeesKeeper.AfterEpochEnd(ctx, epochIdentifier, 1)
+
+			moduleAddrNonNativeFee := suite.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+			carriedOver := suite.App.BankKeeper.GetBalance(ctx, moduleAddrNonNativeFee, uion)
+			suite.Require().Equal(tc.expectedCarriedOver, carriedOver.Amount)
+
+			swapped := sdk.ZeroInt()
+			carriedOverEvt := false
+			for _, event := range ctx.EventManager().Events() {
+				switch event.Type {
+				case types.TypeEvtEpochSwap:
+					for _, attr := range event.Attributes {
+						if string(attr.Key) == types.AttributeKeyTokensIn {
+							tokenIn, err := sdk.ParseCoinNormalized(string(attr.Value))
+							suite.Require().NoError(err)
+							swapped = swapped.Add(tokenIn.Amount)
+						}
+					}
+				case types.TypeEvtEpochSwapCarriedOver:
+					carriedOverEvt = true
+				}
+			}
+			suite.Require().Equal(tc.expectedSwapped, swapped)
+			suite.Require().Equal(tc.expectCarriedOverEvt, carriedOverEvt)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTxFeesAfterEpochEndEpochIdentifier() {
+	tests := map[string]struct {
+		epochIdentifier         string
+		recordHistoryKeepPeriod time.Duration
+		expectSwapped           bool
+	}{
+		"configured epoch": {
+			epochIdentifier:         types.DefaultEpochIdentifier,
+			recordHistoryKeepPeriod: 48 * time.Hour,
+			expectSwapped:           true,
+		},
+		"other epoch": {
+			epochIdentifier:         "week",
+			recordHistoryKeepPeriod: 48 * time.Hour,
+			expectSwapped:           false,
+		},
+		"twap keep period shorter than the epoch": {
+			epochIdentifier:         types.DefaultEpochIdentifier,
+			recordHistoryKeepPeriod: time.Hour,
+			expectSwapped:           false,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest(false)
+			suite.preparePool("uion")
+			twapParams := suite.App.TwapKeeper.GetParams(suite.Ctx)
+			twapParams.RecordHistoryKeepPeriod = tc.recordHistoryKeepPeriod
+			suite.App.TwapKeeper.SetParams(suite.Ctx, twapParams)
+
+			ctx := suite.afterEpochCtx(types.DefaultEpochIdentifier)
+			fees := sdk.NewCoins(sdk.NewInt64Coin("uion", 10000))
+			suite.FundAcc(suite.TestAccs[1], fees)
+			err := suite.App.BankKeeper.SendCoinsFromAccountToModule(ctx, suite.TestAccs[1], types.NonNativeFeeCollectorName, fees)
+			suite.Require().NoError(err)
+
+			suite.App.TxFeesKeeper.AfterEpochEnd(ctx, tc.epochIdentifier, 1)
+
+			moduleAddrNonNativeFee := suite.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+			if tc.expectSwapped {
+				suite.Require().Empty(suite.App.BankKeeper.GetAllBalances(ctx, moduleAddrNonNativeFee))
+			} else {
+				suite.Require().Equal(fees, suite.App.BankKeeper.GetAllBalances(ctx, moduleAddrNonNativeFee))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTxFeesMultiBlockEpochSwap() {
+	suite.SetupTest(false)
+	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+	epochIdentifier := suite.App.TxFeesKeeper.GetParams(suite.Ctx).EpochIdentifier
+	uion := "uion"
+	poolId, _ := suite.preparePool(uion)
+
+	params := suite.App.TxFeesKeeper.GetParams(suite.Ctx)
+	params.EpochSwapMode = types.EpochSwapModeMultiBlock
+	params.EpochSwapBlocks = 3
+	suite.App.TxFeesKeeper.SetParams(suite.Ctx, params)
+
+	ctx := suite.afterEpochCtx(epochIdentifier)
+	fees := sdk.NewCoins(sdk.NewInt64Coin(uion, 10000))
+	suite.FundAcc(suite.TestAccs[1], fees)
+	err := suite.App.BankKeeper.SendCoinsFromAccountToModule(ctx, suite.TestAccs[1], types.NonNativeFeeCollectorName, fees)
+	suite.Require().NoError(err)
+
+	suite.App.TxFeesKeeper.AfterEpochEnd(ctx, epochIdentifier, 1)
+
+	// nothing is swapped at the epoch end, only recorded as pending.
+	moduleAddrNonNativeFee := suite.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+	moduleAddrFee := suite.App.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	suite.Require().Equal(fees, suite.App.BankKeeper.GetAllBalances(ctx, moduleAddrNonNativeFee))
+	res, err := suite.queryClient.PendingConversions(sdk.WrapSDKContext(ctx), &types.QueryPendingConversionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.PendingConversions, 1)
+	pending := res.PendingConversions[0]
+	suite.Require().Equal(uion, pending.Denom)
+	suite.Require().Equal(poolId, pending.PoolID)
+	suite.Require().Equal(sdk.NewInt(10000), pending.Remaining)
+	suite.Require().Equal(sdk.NewInt(3334), pending.AmountPerBlock)
+
+	// another epoch ending in the same block keeps the pending conversions.
+	suite.App.EpochsKeeper.AfterEpochEnd(ctx, "week", 1)
+	suite.Require().Equal(res.PendingConversions, suite.App.TxFeesKeeper.GetPendingConversions(ctx))
+
+	// each block converts the amount per block, until nothing remains.
+	expectedRemaining := []int64{6666, 3332, 0}
+	feeCollectorBalance := suite.App.BankKeeper.GetBalance(ctx, moduleAddrFee, baseDenom)
+	for _, remaining := range expectedRemaining {
+		suite.App.TxFeesKeeper.EndBlock(ctx)
+
+		suite.Require().Equal(sdk.NewInt(remaining), suite.App.BankKeeper.GetBalance(ctx, moduleAddrNonNativeFee, uion).Amount)
+		newFeeCollectorBalance := suite.App.BankKeeper.GetBalance(ctx, moduleAddrFee, baseDenom)
+		suite.Require().True(newFeeCollectorBalance.Amount.GT(feeCollectorBalance.Amount))
+		feeCollectorBalance = newFeeCollectorBalance
+
+		pendingConversions := suite.App.TxFeesKeeper.GetPendingConversions(ctx)
+		if remaining > 0 {
+			suite.Require().Len(pendingConversions, 1)
+			suite.Require().Equal(sdk.NewInt(remaining), pendingConversions[0].Remaining)
+		} else {
+			suite.Require().Empty(pendingConversions)
+		}
+	}
+	suite.Require().Empty(suite.App.BankKeeper.GetAllBalances(ctx, moduleAddrNonNativeFee))
+
+	// fees collected during the conversion wait for the next epoch end.
+	suite.FundAcc(suite.TestAccs[1], fees)
+	err = suite.App.BankKeeper.SendCoinsFromAccountToModule(ctx, suite.TestAccs[1], types.NonNativeFeeCollectorName, fees)
+	suite.Require().NoError(err)
+	suite.App.TxFeesKeeper.EndBlock(ctx)
+	suite.Require().Equal(fees, suite.App.BankKeeper.GetAllBalances(ctx, moduleAddrNonNativeFee))
+}
+
+func (suite *KeeperTestSuite) TestTxFeesAfterEpochEndRoute() {
+	suite.SetupTest(false)
+	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+	epochIdentifier := suite.App.TxFeesKeeper.GetParams(suite.Ctx).EpochIdentifier
+
+	// atom is only liquid against foo, which is a fee token itself.
+	fooPoolId, _ := suite.preparePool("foo")
+	atomPoolId := suite.PrepareUni2PoolWithAssets(
+		sdk.NewInt64Coin("foo", defaultPooledAssetAmount),
+		sdk.NewInt64Coin("atom", defaultPooledAssetAmount),
+	)
+	route := []gammtypes.SwapAmountInRoute{
+		{PoolId: atomPoolId, TokenOutDenom: "foo"},
+		{PoolId: fooPoolId, TokenOutDenom: baseDenom},
+	}
+	prop := types.NewUpdateFeeTokenProposal("Test Proposal", "test", types.FeeToken{Denom: "atom", PoolID: atomPoolId, Route: route})
+	err := suite.App.TxFeesKeeper.HandleUpdateFeeTokenProposal(suite.Ctx, &prop)
+	suite.Require().NoError(err)
+
+	ctx := suite.afterEpochCtx(epochIdentifier)
+	fees := sdk.NewCoins(sdk.NewInt64Coin("atom", 10000))
+	suite.FundAcc(suite.TestAccs[1], fees)
+	err = suite.App.BankKeeper.SendCoinsFromAccountToModule(ctx, suite.TestAccs[1], types.NonNativeFeeCollectorName, fees)
+	suite.Require().NoError(err)
+
+	// the expected output of swapping along the route, from a cached context.
+	suite.FundAcc(suite.TestAccs[2], fees)
+	cacheCtx, _ := ctx.CacheContext()
+	expectedOut, err := suite.App.GAMMKeeper.MultihopSwapExactAmountIn(cacheCtx, suite.TestAccs[2], route, sdk.NewInt64Coin("atom", 10000), sdk.OneInt())
+	suite.Require().NoError(err)
+
+	moduleAddrFee := suite.App.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	moduleAddrNonNativeFee := suite.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+	feeCollectorBalance := suite.App.BankKeeper.GetBalance(ctx, moduleAddrFee, baseDenom)
+
+	suite.App.TxFeesKeeper.AfterEpochEnd(ctx, epochIdentifier, 1)
+
+	suite.Require().Empty(suite.App.BankKeeper.GetAllBalances(ctx, moduleAddrNonNativeFee))
+	newFeeCollectorBalance := suite.App.BankKeeper.GetBalance(ctx, moduleAddrFee, baseDenom)
+	suite.Require().Equal(expectedOut, newFeeCollectorBalance.Amount.Sub(feeCollectorBalance.Amount))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 )
@@ -16,13 +17,20 @@ type Keeper struct {
 	cdc      codec.Codec
 	storeKey sdk.StoreKey
 
+	paramSpace paramtypes.Subspace
+
 	accountKeeper             types.AccountKeeper
 	bankKeeper                types.BankKeeper
 	epochKeeper               types.EpochKeeper
 	gammKeeper                types.GammKeeper
+	twapKeeper                types.TwapKeeper
 	spotPriceCalculator       types.SpotPriceCalculator
 	feeCollectorName          string
 	nonNativeFeeCollectorName string
+
+	// mempoolFeeOpts are this node's local mempool fee settings, used for fee estimation.
+	// They are shared by all copies of the keeper, so that they can be set after it is copied into the app's modules.
+	mempoolFeeOpts *types.MempoolFeeOptions
 }
 
 func NewKeeper(
@@ -31,24 +39,41 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	epochKeeper types.EpochKeeper,
 	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
 	gammKeeper types.GammKeeper,
+	twapKeeper types.TwapKeeper,
 	spotPriceCalculator types.SpotPriceCalculator,
 	feeCollectorName string,
 	nonNativeFeeCollectorName string,
 ) Keeper {
+	mempoolFeeOpts := types.NewDefaultMempoolFeeOptions()
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		cdc:                       cdc,
 		accountKeeper:             accountKeeper,
 		bankKeeper:                bankKeeper,
 		epochKeeper:               epochKeeper,
 		storeKey:                  storeKey,
+		paramSpace:                paramSpace,
 		gammKeeper:                gammKeeper,
+		twapKeeper:                twapKeeper,
 		spotPriceCalculator:       spotPriceCalculator,
 		feeCollectorName:          feeCollectorName,
 		nonNativeFeeCollectorName: nonNativeFeeCollectorName,
+		mempoolFeeOpts:            &mempoolFeeOpts,
 	}
 }
 
+// SetMempoolFeeOptions sets this node's local mempool fee settings, for fee estimation.
+func (k Keeper) SetMempoolFeeOptions(opts types.MempoolFeeOptions) {
+	*k.mempoolFeeOpts = opts
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/txfees/types"
+)
+
+func (k Keeper) getPendingConversionsStore(ctx sdk.Context) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.PendingConversionsStorePrefix)
+}
+
+// GetPendingConversions returns the pending conversions of all fee tokens, ordered by denom.
+func (k Keeper) GetPendingConversions(ctx sdk.Context) []types.PendingConversion {
+	prefixStore := k.getPendingConversionsStore(ctx)
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	pendingConversions := []types.PendingConversion{}
+
+	for ; iterator.Valid(); iterator.Next() {
+		pendingConversion := types.PendingConversion{}
+
+		err := proto.Unmarshal(iterator.Value(), &pendingConversion)
+		if err != nil {
+			panic(err)
+		}
+
+		pendingConversions = append(pendingConversions, pendingConversion)
+	}
+	return pendingConversions
+}
+
+// setPendingConversion sets the pending conversion of a fee token.
+func (k Keeper) setPendingConversion(ctx sdk.Context, pendingConversion types.PendingConversion) {
+	bz, err := proto.Marshal(&pendingConversion)
+	if err != nil {
+		panic(err)
+	}
+	k.getPendingConversionsStore(ctx).Set([]byte(pendingConversion.Denom), bz)
+}
+
+// deletePendingConversion deletes the pending conversion of a fee token.
+func (k Keeper) deletePendingConversion(ctx sdk.Context, denom string) {
+	k.getPendingConversionsStore(ctx).Delete([]byte(denom))
+}
+
+// deleteAllPendingConversions deletes the pending conversions of all fee tokens.
+func (k Keeper) deleteAllPendingConversions(ctx sdk.Context) {
+	for _, pendingConversion := range k.GetPendingConversions(ctx) {
+		k.deletePendingConversion(ctx, pendingConversion.Denom)
+	}
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	TypeEvtEpochSwap            = "epoch_swap"
+	TypeEvtEpochSwapCarriedOver = "epoch_swap_carried_over"
+
+	AttributeValueCategory  = ModuleName
+	AttributeKeyPoolId      = "pool_id"
+	AttributeKeyTokensIn    = "tokens_in"
+	AttributeKeyTokensOut   = "tokens_out"
+	AttributeKeyMinOut      = "min_tokens_out"
+	AttributeKeyCarriedOver = "carried_over"
+	AttributeKeyReason      = "reason"
+)
+
+// CreateEpochSwapEvent creates the event emitted when non-base fee tokens are
+// swapped into the base denom at the end of an epoch.
+func CreateEpochSwapEvent(poolId uint64, tokenIn sdk.Coin, tokenOut sdk.Coin, minTokenOut sdk.Coin) sdk.Event {
+	return sdk.NewEvent(
+		TypeEvtEpochSwap,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(AttributeKeyTokensIn, tokenIn.String()),
+		sdk.NewAttribute(AttributeKeyTokensOut, tokenOut.String()),
+		sdk.NewAttribute(AttributeKeyMinOut, minTokenOut.String()),
+	)
+}
+
+// CreateEpochSwapCarriedOverEvent creates the event emitted when non-base fee
+// tokens are left unswapped at the end of an epoch, to be swapped in a later one.
+func CreateEpochSwapCarriedOverEvent(poolId uint64, carriedOver sdk.Coin, reason string) sdk.Event {
+	return sdk.NewEvent(
+		TypeEvtEpochSwapCarriedOver,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(AttributeKeyCarriedOver, carriedOver.String()),
+		sdk.NewAttribute(AttributeKeyReason, reason),
+	)
+}
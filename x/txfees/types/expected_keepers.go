@@ -1,10 +1,14 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	epochstypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v7/x/gamm/twap/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
 // SpotPriceCalculator defines the contract that must be fulfilled by a spot price calculator
@@ -23,6 +27,19 @@ type GammKeeper interface {
 		tokenOutDenom string,
 		tokenOutMinAmount sdk.Int,
 	) (tokenOutAmount sdk.Int, err error)
+	MultihopSwapExactAmountIn(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		routes []gammtypes.SwapAmountInRoute,
+		tokenIn sdk.Coin,
+		tokenOutMinAmount sdk.Int,
+	) (tokenOutAmount sdk.Int, err error)
+}
+
+// TwapKeeper defines the contract needed to retrieve time weighted average prices.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (sdk.Dec, error)
+	GetParams(ctx sdk.Context) twaptypes.Params
 }
 
 // AccountKeeper defines the contract needed for AccountKeeper related APIs.
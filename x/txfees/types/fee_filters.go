@@ -0,0 +1,180 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// MsgsMatcher matches txs by their msgs.
+type MsgsMatcher interface {
+	MatchMsgs(msgs []sdk.Msg) bool
+}
+
+var (
+	_ MsgsMatcher = ArbitrageMatcher{}
+	_ MsgsMatcher = MsgTypeMatcher{}
+	_ MsgsMatcher = PoolMatcher{}
+	_ MsgsMatcher = AllOfMatcher{}
+)
+
+// ArbitrageMatcher matches txs that look like arbitrage, as defined by IsArbMsgsLoose.
+type ArbitrageMatcher struct{}
+
+func (ArbitrageMatcher) MatchMsgs(msgs []sdk.Msg) bool {
+	return IsArbMsgsLoose(msgs)
+}
+
+// We check if a tx is an arbitrage for the mempool right now by seeing:
+// 1) does start token of a msg = final token of msg (definitionally correct)
+// 2) does it have multiple swap messages, with different tx ins. If so, we assume its an arb.
+//   - This has false positives, but is intended to avoid the obvious solution of splitting
+//     an arb into multiple messages.
+//
+// 3) We record all denoms seen across all swaps, and see if any duplicates. (TODO)
+// 4) Contains both JoinPool and ExitPool messages in one tx.
+//   - Has some false positives, but they seem relatively contrived.
+//
+// TODO: Move the first component to a future router module.
+func IsArbMsgsLoose(msgs []sdk.Msg) bool {
+	swapInDenom := ""
+	lpTypesSeen := make(map[gammtypes.LiquidityChangeType]bool, 2)
+
+	for _, m := range msgs {
+		// (4) Check that the tx doesn't have both JoinPool & ExitPool msgs
+		lpMsg, isLpMsg := m.(gammtypes.LiquidityChangeMsg)
+		if isLpMsg {
+			lpTypesSeen[lpMsg.LiquidityChangeType()] = true
+			if len(lpTypesSeen) > 1 {
+				return true
+			}
+		}
+
+		swapMsg, isSwapMsg := m.(gammtypes.SwapMsgRoute)
+		if !isSwapMsg {
+			continue
+		}
+
+		// (1) Check that swap denom in != swap denom out
+		if swapMsg.TokenInDenom() == swapMsg.TokenOutDenom() {
+			return true
+		}
+
+		// (2)
+		if swapInDenom != "" && swapMsg.TokenInDenom() != swapInDenom {
+			return true
+		}
+		swapInDenom = swapMsg.TokenInDenom()
+	}
+
+	return false
+}
+
+// MsgTypeMatcher matches txs with any msg of one of the type urls, e.g. "/osmosis.gamm.v1beta1.MsgJoinPool".
+type MsgTypeMatcher struct {
+	MsgTypeURLs []string
+}
+
+func (m MsgTypeMatcher) MatchMsgs(msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		for _, typeURL := range m.MsgTypeURLs {
+			if msgTypeURL == typeURL {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// PoolMatcher matches txs with any gamm msg that touches one of the pools,
+// either directly or on a swap route.
+type PoolMatcher struct {
+	PoolIds []uint64
+}
+
+func (m PoolMatcher) MatchMsgs(msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		for _, poolId := range msgPoolIds(msg) {
+			for _, matchedPoolId := range m.PoolIds {
+				if poolId == matchedPoolId {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// msgPoolIds returns the ids of the gamm pools that msg touches.
+func msgPoolIds(msg sdk.Msg) []uint64 {
+	switch msg := msg.(type) {
+	case *gammtypes.MsgSwapExactAmountIn:
+		poolIds := make([]uint64, 0, len(msg.Routes))
+		for _, route := range msg.Routes {
+			poolIds = append(poolIds, route.PoolId)
+		}
+		return poolIds
+	case *gammtypes.MsgSwapExactAmountOut:
+		poolIds := make([]uint64, 0, len(msg.Routes))
+		for _, route := range msg.Routes {
+			poolIds = append(poolIds, route.PoolId)
+		}
+		return poolIds
+	case interface{ GetPoolId() uint64 }:
+		return []uint64{msg.GetPoolId()}
+	}
+	return nil
+}
+
+// AllOfMatcher matches txs that are matched by all of its matchers.
+type AllOfMatcher []MsgsMatcher
+
+func (m AllOfMatcher) MatchMsgs(msgs []sdk.Msg) bool {
+	for _, matcher := range m {
+		if !matcher.MatchMsgs(msgs) {
+			return false
+		}
+	}
+	return len(m) > 0
+}
+
+// FeeFilter raises the minimum gas price, in the base denom, that the mempool accepts for the txs it matches.
+type FeeFilter struct {
+	Name    string
+	Matcher MsgsMatcher
+	// GasPriceMultiplier, if set, multiplies the minimum gas price of matching txs.
+	GasPriceMultiplier sdk.Dec
+	// MinGasPrice, if set, is the lowest minimum gas price of matching txs.
+	MinGasPrice sdk.Dec
+}
+
+// DefaultFeeFilters returns the filters that every node applies,
+// which charge arbitrage txs a minimum gas price of arbitrageMinGasPrice.
+func DefaultFeeFilters(arbitrageMinGasPrice sdk.Dec) []FeeFilter {
+	return []FeeFilter{
+		{
+			Name:        "arbitrage",
+			Matcher:     ArbitrageMatcher{},
+			MinGasPrice: arbitrageMinGasPrice,
+		},
+	}
+}
+
+// ApplyFeeFilters returns the minimum gas price of a tx with msgs, after raising minGasPrice
+// by every filter that matches the tx, in order.
+// A filter's multiplier applies to the minimum gas price as raised by the filters before it.
+func ApplyFeeFilters(filters []FeeFilter, minGasPrice sdk.Dec, msgs []sdk.Msg) sdk.Dec {
+	for _, filter := range filters {
+		if !filter.Matcher.MatchMsgs(msgs) {
+			continue
+		}
+		if !filter.GasPriceMultiplier.IsNil() {
+			minGasPrice = minGasPrice.Mul(filter.GasPriceMultiplier)
+		}
+		if !filter.MinGasPrice.IsNil() {
+			minGasPrice = sdk.MaxDec(minGasPrice, filter.MinGasPrice)
+		}
+	}
+	return minGasPrice
+}
@@ -0,0 +1,130 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v7/x/txfees/types"
+)
+
+func swapMsg(tokenInDenom string, hops ...gammtypes.SwapAmountInRoute) *gammtypes.MsgSwapExactAmountIn {
+	return &gammtypes.MsgSwapExactAmountIn{
+		Routes:            hops,
+		TokenIn:           sdk.NewInt64Coin(tokenInDenom, 10),
+		TokenOutMinAmount: sdk.OneInt(),
+	}
+}
+
+func TestMatchers(t *testing.T) {
+	swapAtomToUosmo := swapMsg("atom", gammtypes.SwapAmountInRoute{PoolId: 1, TokenOutDenom: "uosmo"})
+	swapAtomToAtom := swapMsg("atom",
+		gammtypes.SwapAmountInRoute{PoolId: 1, TokenOutDenom: "uosmo"},
+		gammtypes.SwapAmountInRoute{PoolId: 2, TokenOutDenom: "atom"})
+	swapOutUosmo := &gammtypes.MsgSwapExactAmountOut{
+		Routes:   []gammtypes.SwapAmountOutRoute{{PoolId: 3, TokenInDenom: "uion"}},
+		TokenOut: sdk.NewInt64Coin("uosmo", 10),
+	}
+	joinPool := &gammtypes.MsgJoinPool{PoolId: 4}
+	exitPool := &gammtypes.MsgExitPool{PoolId: 4}
+	send := &banktypes.MsgSend{}
+
+	tests := []struct {
+		name     string
+		matcher  types.MsgsMatcher
+		msgs     []sdk.Msg
+		expected bool
+	}{
+		{"arbitrage: swap to another denom", types.ArbitrageMatcher{}, []sdk.Msg{swapAtomToUosmo}, false},
+		{"arbitrage: swap to the same denom", types.ArbitrageMatcher{}, []sdk.Msg{swapAtomToAtom}, true},
+		{"arbitrage: join and exit", types.ArbitrageMatcher{}, []sdk.Msg{joinPool, exitPool}, true},
+		{"msg type: matching", types.MsgTypeMatcher{MsgTypeURLs: []string{sdk.MsgTypeURL(&gammtypes.MsgJoinPool{})}}, []sdk.Msg{send, joinPool}, true},
+		{"msg type: not matching", types.MsgTypeMatcher{MsgTypeURLs: []string{sdk.MsgTypeURL(&gammtypes.MsgJoinPool{})}}, []sdk.Msg{send, exitPool}, false},
+		{"pool: swap in route", types.PoolMatcher{PoolIds: []uint64{2}}, []sdk.Msg{swapAtomToAtom}, true},
+		{"pool: swap out route", types.PoolMatcher{PoolIds: []uint64{3}}, []sdk.Msg{swapOutUosmo}, true},
+		{"pool: join pool", types.PoolMatcher{PoolIds: []uint64{4}}, []sdk.Msg{joinPool}, true},
+		{"pool: other pools", types.PoolMatcher{PoolIds: []uint64{5}}, []sdk.Msg{swapAtomToAtom, swapOutUosmo, joinPool, send}, false},
+		{"all of: all match", types.AllOfMatcher{types.ArbitrageMatcher{}, types.PoolMatcher{PoolIds: []uint64{1}}}, []sdk.Msg{swapAtomToAtom}, true},
+		{"all of: one doesn't match", types.AllOfMatcher{types.ArbitrageMatcher{}, types.PoolMatcher{PoolIds: []uint64{1}}}, []sdk.Msg{swapAtomToUosmo}, false},
+		{"all of: empty", types.AllOfMatcher{}, []sdk.Msg{swapAtomToUosmo}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.matcher.MatchMsgs(tc.msgs))
+		})
+	}
+}
+
+func TestApplyFeeFilters(t *testing.T) {
+	swapAtomToUosmo := swapMsg("atom", gammtypes.SwapAmountInRoute{PoolId: 1, TokenOutDenom: "uosmo"})
+	swapAtomToAtom := swapMsg("atom",
+		gammtypes.SwapAmountInRoute{PoolId: 1, TokenOutDenom: "uosmo"},
+		gammtypes.SwapAmountInRoute{PoolId: 2, TokenOutDenom: "atom"})
+	poolFilter := types.FeeFilter{
+		Name:               "pool 1",
+		Matcher:            types.PoolMatcher{PoolIds: []uint64{1}},
+		GasPriceMultiplier: sdk.NewDec(3),
+	}
+
+	tests := []struct {
+		name        string
+		filters     []types.FeeFilter
+		minGasPrice sdk.Dec
+		msgs        []sdk.Msg
+		expected    sdk.Dec
+	}{
+		{
+			name:        "default filters ignore non arbitrage txs",
+			filters:     types.DefaultFeeFilters(sdk.MustNewDecFromStr("0.1")),
+			minGasPrice: sdk.MustNewDecFromStr("0.01"),
+			msgs:        []sdk.Msg{swapAtomToUosmo},
+			expected:    sdk.MustNewDecFromStr("0.01"),
+		},
+		{
+			name:        "default filters raise arbitrage txs to the arbitrage min gas price",
+			filters:     types.DefaultFeeFilters(sdk.MustNewDecFromStr("0.1")),
+			minGasPrice: sdk.MustNewDecFromStr("0.01"),
+			msgs:        []sdk.Msg{swapAtomToAtom},
+			expected:    sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			name:        "default filters keep a higher min gas price",
+			filters:     types.DefaultFeeFilters(sdk.MustNewDecFromStr("0.1")),
+			minGasPrice: sdk.MustNewDecFromStr("0.5"),
+			msgs:        []sdk.Msg{swapAtomToAtom},
+			expected:    sdk.MustNewDecFromStr("0.5"),
+		},
+		{
+			name:        "multiplier",
+			filters:     []types.FeeFilter{poolFilter},
+			minGasPrice: sdk.MustNewDecFromStr("0.01"),
+			msgs:        []sdk.Msg{swapAtomToUosmo},
+			expected:    sdk.MustNewDecFromStr("0.03"),
+		},
+		{
+			name:        "multiplier applies after earlier filters",
+			filters:     append(types.DefaultFeeFilters(sdk.MustNewDecFromStr("0.1")), poolFilter),
+			minGasPrice: sdk.MustNewDecFromStr("0.01"),
+			msgs:        []sdk.Msg{swapAtomToAtom},
+			expected:    sdk.MustNewDecFromStr("0.3"),
+		},
+		{
+			name:        "no filters",
+			filters:     nil,
+			minGasPrice: sdk.MustNewDecFromStr("0.01"),
+			msgs:        []sdk.Msg{swapAtomToAtom},
+			expected:    sdk.MustNewDecFromStr("0.01"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, types.ApplyFeeFilters(tc.filters, tc.minGasPrice, tc.msgs))
+		})
+	}
+}
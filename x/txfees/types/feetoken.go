@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// Validate performs stateless validation of a fee token record.
+// Whether its pools exist and contain its route's denoms is checked by the keeper's ValidateFeeToken.
+func (f FeeToken) Validate() error {
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return err
+	}
+	if len(f.Route) == 0 {
+		return nil
+	}
+	if f.Route[0].PoolId != f.PoolID {
+		return fmt.Errorf("route of fee token %s must start with its pool %d, got %d", f.Denom, f.PoolID, f.Route[0].PoolId)
+	}
+	for _, hop := range f.Route {
+		if hop.PoolId == 0 {
+			return fmt.Errorf("route of fee token %s has a hop without a pool id", f.Denom)
+		}
+		if err := sdk.ValidateDenom(hop.TokenOutDenom); err != nil {
+			return err
+		}
+		if hop.TokenOutDenom == f.Denom {
+			return fmt.Errorf("route of fee token %s must not swap back into it", f.Denom)
+		}
+	}
+	return nil
+}
+
+// SwapRoutes returns the routes through which the fee token is swapped into baseDenom.
+// These are the fee token's route if it has one, and otherwise a single hop through its pool.
+func (f FeeToken) SwapRoutes(baseDenom string) []gammtypes.SwapAmountInRoute {
+	return swapRoutes(f.Route, f.PoolID, baseDenom)
+}
+
+// SwapRoutes returns the routes through which the pending conversion is swapped into baseDenom.
+func (c PendingConversion) SwapRoutes(baseDenom string) []gammtypes.SwapAmountInRoute {
+	return swapRoutes(c.Route, c.PoolID, baseDenom)
+}
+
+func swapRoutes(route []gammtypes.SwapAmountInRoute, poolId uint64, baseDenom string) []gammtypes.SwapAmountInRoute {
+	if len(route) > 0 {
+		return route
+	}
+	return []gammtypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: baseDenom}}
+}
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -26,10 +27,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // FeeToken is a struct that specifies a coin denom, and pool ID pair.
 // This marks the token as eligible for use as a tx fee asset in Osmosis.
 // Its price in osmo is derived through looking at the provided pool ID.
-// The pool ID must have osmo as one of its assets.
+// The pool ID must have osmo as one of its assets, unless a route is set.
 type FeeToken struct {
 	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	PoolID uint64 `protobuf:"varint,2,opt,name=poolID,proto3" json:"poolID,omitempty" yaml:"pool_id"`
+	// route optionally prices and swaps the token into osmo through multiple
+	// pools, for tokens that are only liquid against other tokens. Its first
+	// hop must be through the pool ID, and its last hop must output osmo.
+	Route []types.SwapAmountInRoute `protobuf:"bytes,3,rep,name=route,proto3" json:"route" yaml:"route"`
 }
 
 func (m *FeeToken) Reset()         { *m = FeeToken{} }
@@ -79,6 +84,13 @@ func (m *FeeToken) GetPoolID() uint64 {
 	return 0
 }
 
+func (m *FeeToken) GetRoute() []types.SwapAmountInRoute {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*FeeToken)(nil), "osmosis.txfees.v1beta1.FeeToken")
 }
@@ -88,22 +100,27 @@ func init() {
 }
 
 var fileDescriptor_c50689857adfcfe0 = []byte{
-	// 235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xa9, 0x48, 0x4b, 0x4d, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4b, 0x4d, 0x2d, 0xc9, 0xcf, 0x4e, 0xcd, 0xd3, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x83, 0x2a, 0xd3, 0x83, 0x28, 0xd3, 0x83, 0x2a, 0x93, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0x95, 0x52, 0xb8, 0x38, 0xdc, 0x52, 0x53, 0x43,
-	0x40, 0xfa, 0x85, 0xd4, 0xb8, 0x58, 0x53, 0x52, 0xf3, 0xf2, 0x73, 0x25, 0x18, 0x15, 0x18, 0x35,
-	0x38, 0x9d, 0x04, 0x3e, 0xdd, 0x93, 0xe7, 0xa9, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0x02, 0x0b, 0x2b,
-	0x05, 0x41, 0xa4, 0x85, 0xb4, 0xb8, 0xd8, 0x0a, 0xf2, 0xf3, 0x73, 0x3c, 0x5d, 0x24, 0x98, 0x14,
-	0x18, 0x35, 0x58, 0x9c, 0x84, 0x3e, 0xdd, 0x93, 0xe7, 0x83, 0x28, 0x04, 0x89, 0xc7, 0x67, 0xa6,
-	0x28, 0x05, 0x41, 0x55, 0x58, 0xb1, 0xbc, 0x58, 0x20, 0xcf, 0xe8, 0xe4, 0x7d, 0xe2, 0x91, 0x1c,
-	0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x86, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9,
-	0xf9, 0xb9, 0xfa, 0x50, 0x87, 0xeb, 0xe6, 0x24, 0x26, 0x15, 0xc3, 0x38, 0xfa, 0x65, 0xe6, 0xfa,
-	0x15, 0x30, 0x1f, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x5d, 0x6e, 0x0c, 0x08, 0x00,
-	0x00, 0xff, 0xff, 0x6f, 0x91, 0x61, 0xbb, 0x10, 0x01, 0x00, 0x00,
+	// 308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0x86, 0x33, 0x5f, 0x7f, 0xf8, 0x8c, 0x22, 0x12, 0x8a, 0x94, 0x82, 0x93, 0x12, 0x50, 0x8b,
+	0xe0, 0x0c, 0xd5, 0x85, 0xd0, 0x9d, 0x41, 0x84, 0xe2, 0x2e, 0x75, 0xe5, 0x46, 0x12, 0x3b, 0x8d,
+	0xc5, 0x4c, 0x4e, 0xe8, 0x4c, 0x6a, 0x7a, 0x17, 0x5e, 0x82, 0xb7, 0xe1, 0x1d, 0x74, 0xd9, 0xa5,
+	0xab, 0x20, 0xc9, 0xc6, 0x75, 0xaf, 0x40, 0x92, 0x49, 0x74, 0x37, 0x73, 0xce, 0xc3, 0x73, 0x5e,
+	0x5e, 0xfd, 0x18, 0x04, 0x07, 0x31, 0x17, 0x54, 0x26, 0x33, 0xc6, 0x04, 0x5d, 0x0e, 0x3d, 0x26,
+	0xdd, 0x21, 0x9d, 0x31, 0x26, 0xe1, 0x85, 0x85, 0x24, 0x5a, 0x80, 0x04, 0xe3, 0xb0, 0xc2, 0x88,
+	0xc2, 0x48, 0x85, 0xf5, 0x3a, 0x3e, 0xf8, 0x50, 0x22, 0xb4, 0x78, 0x29, 0xba, 0x77, 0x54, 0x4b,
+	0x7d, 0x97, 0xf3, 0x5f, 0xa5, 0x4c, 0xd4, 0xda, 0xfa, 0x40, 0xfa, 0xff, 0x5b, 0xc6, 0xee, 0x0b,
+	0xbf, 0x71, 0xa2, 0xb7, 0xa6, 0x2c, 0x04, 0xde, 0x45, 0x7d, 0x34, 0xd8, 0xb1, 0x0f, 0xb6, 0xa9,
+	0xb9, 0xb7, 0x72, 0x79, 0x30, 0xb2, 0xca, 0xb1, 0xe5, 0xa8, 0xb5, 0x71, 0xa6, 0xb7, 0x23, 0x80,
+	0x60, 0x7c, 0xd3, 0xfd, 0xd7, 0x47, 0x83, 0xa6, 0x6d, 0x6c, 0x53, 0x73, 0x5f, 0x81, 0xc5, 0xfc,
+	0x71, 0x3e, 0xb5, 0x9c, 0x8a, 0x30, 0x26, 0x7a, 0x6b, 0x01, 0xb1, 0x64, 0xdd, 0x46, 0xbf, 0x31,
+	0xd8, 0xbd, 0x38, 0x25, 0x75, 0xfa, 0x22, 0x4f, 0x9d, 0x9d, 0x4c, 0x5e, 0xdd, 0xe8, 0x9a, 0x43,
+	0x1c, 0xca, 0x71, 0xe8, 0x14, 0xb8, 0xdd, 0x59, 0xa7, 0xa6, 0xf6, 0x17, 0xa0, 0x74, 0x58, 0x8e,
+	0x72, 0x8d, 0x9a, 0xdf, 0xef, 0x26, 0xb2, 0xef, 0xd6, 0x19, 0x46, 0x9b, 0x0c, 0xa3, 0xaf, 0x0c,
+	0xa3, 0xb7, 0x1c, 0x6b, 0x9b, 0x1c, 0x6b, 0x9f, 0x39, 0xd6, 0x1e, 0x86, 0xfe, 0x5c, 0x3e, 0xc7,
+	0x1e, 0x79, 0x02, 0x4e, 0xab, 0x7b, 0xe7, 0x81, 0xeb, 0x89, 0xfa, 0x43, 0x97, 0x57, 0x34, 0xa9,
+	0x6b, 0x96, 0xab, 0x88, 0x09, 0xaf, 0x5d, 0xf6, 0x71, 0xf9, 0x33, 0x00, 0xa9, 0x3b, 0x49, 0xf3,
+	0x85, 0x01, 0x00, 0x00,
 }
 
 func (this *FeeToken) Equal(that interface{}) bool {
@@ -131,6 +148,14 @@ func (this *FeeToken) Equal(that interface{}) bool {
 	if this.PoolID != that1.PoolID {
 		return false
 	}
+	if len(this.Route) != len(that1.Route) {
+		return false
+	}
+	for i := range this.Route {
+		if !this.Route[i].Equal(&that1.Route[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *FeeToken) Marshal() (dAtA []byte, err error) {
@@ -153,6 +178,20 @@ func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Route[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeetoken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.PoolID != 0 {
 		i = encodeVarintFeetoken(dAtA, i, uint64(m.PoolID))
 		i--
@@ -192,6 +231,12 @@ func (m *FeeToken) Size() (n int) {
 	if m.PoolID != 0 {
 		n += 1 + sovFeetoken(uint64(m.PoolID))
 	}
+	if len(m.Route) > 0 {
+		for _, e := range m.Route {
+			l = e.Size()
+			n += 1 + l + sovFeetoken(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -281,6 +326,40 @@ func (m *FeeToken) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeetoken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeetoken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = append(m.Route, types.SwapAmountInRoute{})
+			if err := m.Route[len(m.Route)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeetoken(dAtA[iNdEx:])
@@ -7,6 +7,9 @@ func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Basedenom: sdk.DefaultBondDenom,
 		Feetokens: []FeeToken{},
+		Params:    DefaultParams(),
+
+		PendingConversions: []PendingConversion{},
 	}
 }
 
@@ -20,11 +23,18 @@ func (gs GenesisState) Validate() error {
 	}
 
 	for _, feeToken := range gs.Feetokens {
-		err := sdk.ValidateDenom(feeToken.Denom)
+		err := feeToken.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, conversion := range gs.PendingConversions {
+		err := conversion.Validate()
 		if err != nil {
 			return err
 		}
 	}
 
-	return nil
+	return gs.Params.Validate()
 }
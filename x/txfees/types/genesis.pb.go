@@ -25,8 +25,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the txfees module's genesis state.
 type GenesisState struct {
-	Basedenom string     `protobuf:"bytes,1,opt,name=basedenom,proto3" json:"basedenom,omitempty"`
-	Feetokens []FeeToken `protobuf:"bytes,2,rep,name=feetokens,proto3" json:"feetokens"`
+	Basedenom          string              `protobuf:"bytes,1,opt,name=basedenom,proto3" json:"basedenom,omitempty"`
+	Feetokens          []FeeToken          `protobuf:"bytes,2,rep,name=feetokens,proto3" json:"feetokens"`
+	Params             Params              `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	PendingConversions []PendingConversion `protobuf:"bytes,4,rep,name=pending_conversions,json=pendingConversions,proto3" json:"pending_conversions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +78,20 @@ func (m *GenesisState) GetFeetokens() []FeeToken {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetPendingConversions() []PendingConversion {
+	if m != nil {
+		return m.PendingConversions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.txfees.v1beta1.GenesisState")
 }
@@ -85,22 +101,27 @@ func init() {
 }
 
 var fileDescriptor_4423c18e3d020b37 = []byte{
-	// 235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xa9, 0x48, 0x4b, 0x4d, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xaa, 0xd2, 0x83, 0xa8, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x54, 0x71, 0x98, 0x99, 0x96, 0x9a, 0x5a, 0x92,
-	0x9f, 0x9d, 0x9a, 0x07, 0x51, 0xa6, 0x54, 0xc4, 0xc5, 0xe3, 0x0e, 0xb1, 0x25, 0xb8, 0x24, 0xb1,
-	0x24, 0x55, 0x48, 0x86, 0x8b, 0x33, 0x29, 0xb1, 0x38, 0x35, 0x25, 0x35, 0x2f, 0x3f, 0x57, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0x21, 0x20, 0xe4, 0xc2, 0xc5, 0x09, 0xd3, 0x5f, 0x2c, 0xc1,
-	0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0xa0, 0x87, 0xdd, 0x59, 0x7a, 0x6e, 0xa9, 0xa9, 0x21, 0x20,
-	0x85, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x21, 0x34, 0x3a, 0x79, 0x9f, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x61, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72,
-	0x7e, 0xae, 0x3e, 0xd4, 0x58, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x18, 0x47, 0xbf, 0xcc, 0x5c, 0xbf,
-	0x02, 0xe6, 0xa3, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x3f, 0x8c, 0x01, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xdd, 0x30, 0x73, 0x62, 0x44, 0x01, 0x00, 0x00,
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xbd, 0x4e, 0xf3, 0x30,
+	0x14, 0x86, 0xe3, 0xb6, 0xaa, 0x54, 0xf7, 0x9b, 0xfc, 0x21, 0x14, 0x55, 0xc8, 0x44, 0xfc, 0x48,
+	0x65, 0xc0, 0x56, 0xcb, 0xc0, 0xc2, 0x54, 0x10, 0x0c, 0x2c, 0xa8, 0x30, 0xb1, 0x80, 0xd3, 0x9e,
+	0x86, 0x08, 0x62, 0x47, 0x3d, 0xa6, 0x2a, 0x77, 0x81, 0xb8, 0xaa, 0x8e, 0x1d, 0x99, 0x10, 0x6a,
+	0x6f, 0x04, 0x35, 0x71, 0xa8, 0x04, 0x64, 0x4b, 0xec, 0xe7, 0x7d, 0xfc, 0x9e, 0x43, 0xf7, 0x0c,
+	0x26, 0x06, 0x63, 0x94, 0x76, 0x3a, 0x02, 0x40, 0x39, 0xe9, 0x84, 0x60, 0x55, 0x47, 0x46, 0xa0,
+	0x01, 0x63, 0x14, 0xe9, 0xd8, 0x58, 0xc3, 0x36, 0x1d, 0x25, 0x72, 0x4a, 0x38, 0xaa, 0xb5, 0x11,
+	0x99, 0xc8, 0x64, 0x88, 0x5c, 0x7d, 0xe5, 0x74, 0x6b, 0xbf, 0xc4, 0x39, 0x02, 0xb0, 0xe6, 0x11,
+	0xb4, 0xc3, 0x76, 0x4b, 0xb0, 0x54, 0x8d, 0x55, 0xe2, 0x5e, 0x6e, 0xc9, 0x32, 0x08, 0xf4, 0x30,
+	0xd6, 0xd1, 0xdd, 0xc0, 0xe8, 0x09, 0x8c, 0x31, 0x36, 0xce, 0xba, 0xf3, 0x56, 0xa1, 0xff, 0x2e,
+	0xf2, 0xf2, 0xd7, 0x56, 0x59, 0x60, 0x5b, 0xb4, 0x11, 0x2a, 0x84, 0x21, 0x68, 0x93, 0xf8, 0x24,
+	0x20, 0xed, 0x46, 0x7f, 0x7d, 0xc0, 0xce, 0x68, 0xa3, 0xa8, 0x85, 0x7e, 0x25, 0xa8, 0xb6, 0x9b,
+	0xdd, 0x40, 0xfc, 0x3d, 0xad, 0x38, 0x07, 0xb8, 0x59, 0x81, 0xbd, 0xda, 0xec, 0x63, 0xdb, 0xeb,
+	0xaf, 0x83, 0xec, 0x84, 0xd6, 0xf3, 0xd6, 0x7e, 0x35, 0x20, 0xed, 0x66, 0x97, 0x97, 0x29, 0xae,
+	0x32, 0xca, 0x09, 0x5c, 0x86, 0xdd, 0xd3, 0xff, 0xbf, 0xc7, 0x41, 0xbf, 0x96, 0xb5, 0x39, 0x28,
+	0x55, 0xe5, 0x91, 0xd3, 0xef, 0x84, 0xb3, 0xb2, 0xf4, 0xe7, 0x05, 0xf6, 0x2e, 0x67, 0x0b, 0x4e,
+	0xe6, 0x0b, 0x4e, 0x3e, 0x17, 0x9c, 0xbc, 0x2e, 0xb9, 0x37, 0x5f, 0x72, 0xef, 0x7d, 0xc9, 0xbd,
+	0xdb, 0x4e, 0x14, 0xdb, 0x87, 0xe7, 0x50, 0x0c, 0x4c, 0x52, 0xac, 0xfa, 0xf0, 0x49, 0x85, 0x58,
+	0xfc, 0xc8, 0xc9, 0xb1, 0x9c, 0x16, 0xcb, 0xb7, 0x2f, 0x29, 0x60, 0x58, 0xcf, 0x16, 0x7d, 0xf4,
+	0x35, 0x00, 0x4d, 0x72, 0x31, 0x37, 0x3b, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +144,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingConversions) > 0 {
+		for iNdEx := len(m.PendingConversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingConversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Feetokens) > 0 {
 		for iNdEx := len(m.Feetokens) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -174,6 +219,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingConversions) > 0 {
+		for _, e := range m.PendingConversions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -278,6 +331,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingConversions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingConversions = append(m.PendingConversions, PendingConversion{})
+			if err := m.PendingConversions[len(m.PendingConversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
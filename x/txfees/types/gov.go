@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -43,7 +42,7 @@ func (p *UpdateFeeTokenProposal) ValidateBasic() error {
 		return err
 	}
 
-	return sdk.ValidateDenom(p.Feetoken.Denom)
+	return p.Feetoken.Validate()
 }
 
 func (p UpdateFeeTokenProposal) String() string {
@@ -23,4 +23,6 @@ const (
 var (
 	BaseDenomKey         = []byte("base_denom")
 	FeeTokensStorePrefix = []byte("fee_tokens")
+
+	PendingConversionsStorePrefix = []byte("pending_conversions")
 )
@@ -23,27 +23,37 @@ var (
 )
 
 type MempoolFeeOptions struct {
-	MaxGasWantedPerTx         uint64
-	MinGasPriceForArbitrageTx sdk.Dec
-	HighGasTxThreshold        uint64
-	MinGasPriceForHighGasTx   sdk.Dec
+	MaxGasWantedPerTx       uint64
+	HighGasTxThreshold      uint64
+	MinGasPriceForHighGasTx sdk.Dec
+	// FeeFilters raise the minimum gas price of the txs they match, in order.
+	FeeFilters []FeeFilter
 }
 
 func NewDefaultMempoolFeeOptions() MempoolFeeOptions {
 	return MempoolFeeOptions{
-		MaxGasWantedPerTx:         DefaultMaxGasWantedPerTx,
-		MinGasPriceForArbitrageTx: DefaultMinGasPriceForArbitrageTx.Clone(),
-		HighGasTxThreshold:        DefaultHighGasTxThreshold,
-		MinGasPriceForHighGasTx:   DefaultMinGasPriceForHighGasTx.Clone(),
+		MaxGasWantedPerTx:       DefaultMaxGasWantedPerTx,
+		HighGasTxThreshold:      DefaultHighGasTxThreshold,
+		MinGasPriceForHighGasTx: DefaultMinGasPriceForHighGasTx.Clone(),
+		FeeFilters:              DefaultFeeFilters(DefaultMinGasPriceForArbitrageTx.Clone()),
 	}
 }
 
+// MinBaseGasPrice returns the minimum gas price in the base denom that the mempool accepts for a tx
+// with msgs wanting gasWanted gas, given the node's configured minimum gas price in the base denom.
+func (opts MempoolFeeOptions) MinBaseGasPrice(cfgMinGasPrice sdk.Dec, gasWanted uint64, msgs []sdk.Msg) sdk.Dec {
+	if gasWanted >= opts.HighGasTxThreshold {
+		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, opts.MinGasPriceForHighGasTx)
+	}
+	return ApplyFeeFilters(opts.FeeFilters, cfgMinGasPrice, msgs)
+}
+
 func NewMempoolFeeOptions(opts servertypes.AppOptions) MempoolFeeOptions {
 	return MempoolFeeOptions{
-		MaxGasWantedPerTx:         parseMaxGasWantedPerTx(opts),
-		MinGasPriceForArbitrageTx: parseMinGasPriceForArbitrageTx(opts),
-		HighGasTxThreshold:        DefaultHighGasTxThreshold,
-		MinGasPriceForHighGasTx:   parseMinGasPriceForHighGasTx(opts),
+		MaxGasWantedPerTx:       parseMaxGasWantedPerTx(opts),
+		HighGasTxThreshold:      DefaultHighGasTxThreshold,
+		MinGasPriceForHighGasTx: parseMinGasPriceForHighGasTx(opts),
+		FeeFilters:              parseFeeFilters(opts),
 	}
 }
 
@@ -63,6 +73,91 @@ func parseMinGasPriceForArbitrageTx(opts servertypes.AppOptions) sdk.Dec {
 	return parseDecFromConfig(opts, "arbitrage-min-gas-fee", DefaultMinGasPriceForArbitrageTx.Clone())
 }
 
+// parseFeeFilters returns the default fee filters, followed by the ones configured
+// in the osmosis-mempool.fee-filters array of tables.
+func parseFeeFilters(opts servertypes.AppOptions) []FeeFilter {
+	filters := DefaultFeeFilters(parseMinGasPriceForArbitrageTx(opts))
+
+	valueInterface := opts.Get("osmosis-mempool.fee-filters")
+	if valueInterface == nil {
+		return filters
+	}
+	values, err := cast.ToSliceE(valueInterface)
+	if err != nil {
+		panic(fmt.Errorf("invalidly configured osmosis-mempool.fee-filters, err= %v", err))
+	}
+	for i, value := range values {
+		filter, err := parseFeeFilter(value)
+		if err != nil {
+			panic(fmt.Errorf("invalidly configured osmosis-mempool.fee-filters[%d], err= %v", i, err))
+		}
+		filters = append(filters, filter)
+	}
+	return filters
+}
+
+// parseFeeFilter parses a configured fee filter. Its matchers must all match a tx for the filter to apply.
+func parseFeeFilter(value interface{}) (FeeFilter, error) {
+	cfg, err := cast.ToStringMapE(value)
+	if err != nil {
+		return FeeFilter{}, err
+	}
+	filter := FeeFilter{Name: cast.ToString(cfg["name"])}
+
+	matcher := AllOfMatcher{}
+	if isArbitrage, ok := cfg["arbitrage"]; ok {
+		isArbitrage, err := cast.ToBoolE(isArbitrage)
+		if err != nil {
+			return FeeFilter{}, fmt.Errorf("invalid arbitrage: %w", err)
+		}
+		if isArbitrage {
+			matcher = append(matcher, ArbitrageMatcher{})
+		}
+	}
+	if msgTypes, ok := cfg["msg-types"]; ok {
+		msgTypeURLs, err := cast.ToStringSliceE(msgTypes)
+		if err != nil {
+			return FeeFilter{}, fmt.Errorf("invalid msg-types: %w", err)
+		}
+		matcher = append(matcher, MsgTypeMatcher{MsgTypeURLs: msgTypeURLs})
+	}
+	if poolIdsValue, ok := cfg["pool-ids"]; ok {
+		values, err := cast.ToSliceE(poolIdsValue)
+		if err != nil {
+			return FeeFilter{}, fmt.Errorf("invalid pool-ids: %w", err)
+		}
+		poolIds := make([]uint64, len(values))
+		for i, value := range values {
+			poolIds[i], err = cast.ToUint64E(value)
+			if err != nil {
+				return FeeFilter{}, fmt.Errorf("invalid pool-ids: %w", err)
+			}
+		}
+		matcher = append(matcher, PoolMatcher{PoolIds: poolIds})
+	}
+	if len(matcher) == 0 {
+		return FeeFilter{}, fmt.Errorf("fee filter %q matches no txs, it needs arbitrage, msg-types or pool-ids", filter.Name)
+	}
+	filter.Matcher = matcher
+
+	if multiplier, ok := cfg["gas-price-multiplier"]; ok {
+		filter.GasPriceMultiplier, err = parseDec(multiplier)
+		if err != nil {
+			return FeeFilter{}, fmt.Errorf("invalid gas-price-multiplier: %w", err)
+		}
+	}
+	if minGasPrice, ok := cfg["min-gas-price"]; ok {
+		filter.MinGasPrice, err = parseDec(minGasPrice)
+		if err != nil {
+			return FeeFilter{}, fmt.Errorf("invalid min-gas-price: %w", err)
+		}
+	}
+	if filter.GasPriceMultiplier.IsNil() && filter.MinGasPrice.IsNil() {
+		return FeeFilter{}, fmt.Errorf("fee filter %q has neither a gas-price-multiplier nor a min-gas-price", filter.Name)
+	}
+	return filter, nil
+}
+
 func parseMinGasPriceForHighGasTx(opts servertypes.AppOptions) sdk.Dec {
 	return parseDecFromConfig(opts, "min-gas-price-for-high-gas-tx", DefaultMinGasPriceForHighGasTx.Clone())
 }
@@ -76,11 +171,20 @@ func parseDecFromConfig(opts servertypes.AppOptions, optName string, defaultValu
 			panic("invalidly configured osmosis-mempool." + optName)
 		}
 		var err error
-		// pre-pend 0 to allow the config to start with a decimal, e.g. ".01"
-		value, err = sdk.NewDecFromStr("0" + valueStr)
+		value, err = parseDec(valueStr)
 		if err != nil {
 			panic(fmt.Errorf("invalidly configured osmosis-mempool.%v, err= %v", optName, err))
 		}
 	}
 	return value
 }
+
+// parseDec parses a non-negative decimal from a config value, which may be a string or a number.
+func parseDec(value interface{}) (sdk.Dec, error) {
+	valueStr, err := cast.ToStringE(value)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	// pre-pend 0 to allow the config to start with a decimal, e.g. ".01"
+	return sdk.NewDecFromStr("0" + valueStr)
+}
@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/txfees/types"
+)
+
+type mapAppOptions map[string]interface{}
+
+func (m mapAppOptions) Get(key string) interface{} {
+	return m[key]
+}
+
+func TestNewMempoolFeeOptionsFeeFilters(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        mapAppOptions
+		expected    []types.FeeFilter
+		expectPanic bool
+	}{
+		{
+			name:     "defaults",
+			opts:     mapAppOptions{},
+			expected: types.DefaultFeeFilters(sdk.ZeroDec()),
+		},
+		{
+			name:     "arbitrage min gas fee",
+			opts:     mapAppOptions{"osmosis-mempool.arbitrage-min-gas-fee": ".1"},
+			expected: types.DefaultFeeFilters(sdk.MustNewDecFromStr("0.1")),
+		},
+		{
+			name: "configured filters",
+			opts: mapAppOptions{
+				"osmosis-mempool.fee-filters": []interface{}{
+					map[string]interface{}{
+						"name":                 "pool 1 joins",
+						"msg-types":            []interface{}{"/osmosis.gamm.v1beta1.MsgJoinPool"},
+						"pool-ids":             []interface{}{int64(1)},
+						"gas-price-multiplier": "2",
+					},
+					map[string]interface{}{
+						"name":          "arbitrage",
+						"arbitrage":     true,
+						"min-gas-price": ".5",
+					},
+				},
+			},
+			expected: append(types.DefaultFeeFilters(sdk.ZeroDec()),
+				types.FeeFilter{
+					Name: "pool 1 joins",
+					Matcher: types.AllOfMatcher{
+						types.MsgTypeMatcher{MsgTypeURLs: []string{"/osmosis.gamm.v1beta1.MsgJoinPool"}},
+						types.PoolMatcher{PoolIds: []uint64{1}},
+					},
+					GasPriceMultiplier: sdk.NewDec(2),
+				},
+				types.FeeFilter{
+					Name:        "arbitrage",
+					Matcher:     types.AllOfMatcher{types.ArbitrageMatcher{}},
+					MinGasPrice: sdk.MustNewDecFromStr("0.5"),
+				},
+			),
+		},
+		{
+			name: "filter without matchers",
+			opts: mapAppOptions{
+				"osmosis-mempool.fee-filters": []interface{}{
+					map[string]interface{}{"name": "all", "min-gas-price": "1"},
+				},
+			},
+			expectPanic: true,
+		},
+		{
+			name: "filter without a gas price",
+			opts: mapAppOptions{
+				"osmosis-mempool.fee-filters": []interface{}{
+					map[string]interface{}{"name": "pool 1", "pool-ids": []interface{}{int64(1)}},
+				},
+			},
+			expectPanic: true,
+		},
+		{
+			name: "invalid multiplier",
+			opts: mapAppOptions{
+				"osmosis-mempool.fee-filters": []interface{}{
+					map[string]interface{}{"name": "pool 1", "pool-ids": []interface{}{int64(1)}, "gas-price-multiplier": "x"},
+				},
+			},
+			expectPanic: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPanic {
+				require.Panics(t, func() { types.NewMempoolFeeOptions(tc.opts) })
+				return
+			}
+			require.Equal(t, tc.expected, types.NewMempoolFeeOptions(tc.opts).FeeFilters)
+		})
+	}
+}
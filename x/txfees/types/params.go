@@ -0,0 +1,120 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	epochtypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
+)
+
+// Parameter store keys.
+var (
+	KeyMaxEpochSwapPriceDeviation = []byte("MaxEpochSwapPriceDeviation")
+
+	// DefaultMaxEpochSwapPriceDeviation is the default largest fraction by which the
+	// epoch swap of a fee token may fall short of its twap reference price.
+	DefaultMaxEpochSwapPriceDeviation = sdk.NewDecWithPrec(5, 2) // 5%
+
+	KeyEpochSwapMode     = []byte("EpochSwapMode")
+	DefaultEpochSwapMode = EpochSwapModeSingleBlock
+
+	KeyEpochSwapBlocks = []byte("EpochSwapBlocks")
+	// DefaultEpochSwapBlocks is the default number of blocks that the multi block
+	// epoch swap is split across, ~10 minutes at 6 second blocks.
+	DefaultEpochSwapBlocks = uint64(100)
+
+	KeyEpochIdentifier     = []byte("EpochIdentifier")
+	DefaultEpochIdentifier = "day"
+)
+
+// ParamKeyTable returns the txfees module's parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(maxEpochSwapPriceDeviation sdk.Dec, epochSwapMode EpochSwapMode, epochSwapBlocks uint64, epochIdentifier string) Params {
+	return Params{
+		MaxEpochSwapPriceDeviation: maxEpochSwapPriceDeviation,
+		EpochSwapMode:              epochSwapMode,
+		EpochSwapBlocks:            epochSwapBlocks,
+		EpochIdentifier:            epochIdentifier,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the txfees module.
+func DefaultParams() Params {
+	return NewParams(DefaultMaxEpochSwapPriceDeviation, DefaultEpochSwapMode, DefaultEpochSwapBlocks, DefaultEpochIdentifier)
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	if err := validateMaxEpochSwapPriceDeviation(p.MaxEpochSwapPriceDeviation); err != nil {
+		return err
+	}
+	if err := validateEpochSwapMode(p.EpochSwapMode); err != nil {
+		return err
+	}
+	if err := validateEpochSwapBlocks(p.EpochSwapBlocks); err != nil {
+		return err
+	}
+	return epochtypes.ValidateEpochIdentifierString(p.EpochIdentifier)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxEpochSwapPriceDeviation, &p.MaxEpochSwapPriceDeviation, validateMaxEpochSwapPriceDeviation),
+		paramtypes.NewParamSetPair(KeyEpochSwapMode, &p.EpochSwapMode, validateEpochSwapMode),
+		paramtypes.NewParamSetPair(KeyEpochSwapBlocks, &p.EpochSwapBlocks, validateEpochSwapBlocks),
+		paramtypes.NewParamSetPair(KeyEpochIdentifier, &p.EpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+	}
+}
+
+func validateMaxEpochSwapPriceDeviation(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max epoch swap price deviation must be in [0, 1): %s", v)
+	}
+
+	return nil
+}
+
+func validateEpochSwapMode(i interface{}) error {
+	v, ok := i.(EpochSwapMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := EpochSwapMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid epoch swap mode: %d", v)
+	}
+
+	return nil
+}
+
+func validateEpochSwapBlocks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("epoch swap blocks must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,496 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/txfees/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EpochSwapMode defines how the non-base fee tokens collected over an epoch
+// are swapped into the base denom.
+type EpochSwapMode int32
+
+const (
+	// The full balance of every fee token is swapped in the block at the end of
+	// the epoch.
+	EpochSwapModeSingleBlock EpochSwapMode = 0
+	// The balance of every fee token at the end of the epoch is recorded as a
+	// pending conversion, and swapped in equal parts over the following
+	// epoch_swap_blocks blocks.
+	EpochSwapModeMultiBlock EpochSwapMode = 1
+)
+
+var EpochSwapMode_name = map[int32]string{
+	0: "EpochSwapModeSingleBlock",
+	1: "EpochSwapModeMultiBlock",
+}
+
+var EpochSwapMode_value = map[string]int32{
+	"EpochSwapModeSingleBlock": 0,
+	"EpochSwapModeMultiBlock":  1,
+}
+
+func (x EpochSwapMode) String() string {
+	return proto.EnumName(EpochSwapMode_name, int32(x))
+}
+
+func (EpochSwapMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fcbfbe8e37bb08e6, []int{0}
+}
+
+// Params holds parameters for the txfees module
+type Params struct {
+	// max_epoch_swap_price_deviation is how far below the twap reference price
+	// the non-base fee tokens may be sold for, when they are swapped into the
+	// base denom at the end of an epoch. Amounts that can't be swapped within
+	// this bound are carried over to the next epoch.
+	MaxEpochSwapPriceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_epoch_swap_price_deviation,json=maxEpochSwapPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_epoch_swap_price_deviation" yaml:"max_epoch_swap_price_deviation"`
+	// epoch_swap_mode is how the fee tokens are swapped into the base denom.
+	EpochSwapMode EpochSwapMode `protobuf:"varint,2,opt,name=epoch_swap_mode,json=epochSwapMode,proto3,enum=osmosis.txfees.v1beta1.EpochSwapMode" json:"epoch_swap_mode,omitempty" yaml:"epoch_swap_mode"`
+	// epoch_swap_blocks is the number of blocks that the swaps are split across
+	// in the multi block epoch swap mode.
+	EpochSwapBlocks uint64 `protobuf:"varint,3,opt,name=epoch_swap_blocks,json=epochSwapBlocks,proto3" json:"epoch_swap_blocks,omitempty" yaml:"epoch_swap_blocks"`
+	// epoch_identifier is the identifier of the epoch at whose end the fee
+	// tokens are swapped into the base denom. Its duration must not exceed the
+	// twap record_history_keep_period, as the swaps are bounded by the twap
+	// over the epoch.
+	EpochIdentifier string `protobuf:"bytes,4,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty" yaml:"epoch_identifier"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcbfbe8e37bb08e6, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEpochSwapMode() EpochSwapMode {
+	if m != nil {
+		return m.EpochSwapMode
+	}
+	return EpochSwapModeSingleBlock
+}
+
+func (m *Params) GetEpochSwapBlocks() uint64 {
+	if m != nil {
+		return m.EpochSwapBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("osmosis.txfees.v1beta1.EpochSwapMode", EpochSwapMode_name, EpochSwapMode_value)
+	proto.RegisterType((*Params)(nil), "osmosis.txfees.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/txfees/v1beta1/params.proto", fileDescriptor_fcbfbe8e37bb08e6)
+}
+
+var fileDescriptor_fcbfbe8e37bb08e6 = []byte{
+	// 424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x33, 0x36, 0x14, 0x1c, 0xa8, 0xad, 0x41, 0xda, 0x90, 0x96, 0xc9, 0x12, 0xa9, 0x2c,
+	0x42, 0x33, 0xac, 0x1e, 0x84, 0x1e, 0x43, 0x15, 0x45, 0x0a, 0xcb, 0xf6, 0x20, 0x78, 0x09, 0x93,
+	0x64, 0x9a, 0x0e, 0x4d, 0x76, 0x42, 0x66, 0xba, 0x9b, 0xfd, 0x06, 0x1e, 0x3d, 0x0a, 0x5e, 0x04,
+	0x4f, 0x7e, 0x93, 0x3d, 0xee, 0x51, 0x3c, 0x04, 0xd9, 0xfd, 0x06, 0xf9, 0x04, 0xb2, 0x93, 0x64,
+	0xcd, 0xaa, 0x78, 0x4a, 0xf2, 0x3e, 0xbf, 0xf7, 0x79, 0xf3, 0xfe, 0x81, 0x8f, 0xb9, 0x48, 0xb9,
+	0x60, 0x02, 0xcb, 0xe2, 0x9a, 0x52, 0x81, 0x27, 0x83, 0x80, 0x4a, 0x32, 0xc0, 0x19, 0xc9, 0x49,
+	0x2a, 0xdc, 0x2c, 0xe7, 0x92, 0x1b, 0x87, 0x0d, 0xe4, 0xd6, 0x90, 0xdb, 0x40, 0xd6, 0xa3, 0x98,
+	0xc7, 0x5c, 0x21, 0x78, 0xfd, 0x56, 0xd3, 0xce, 0xb7, 0x1d, 0xb8, 0x3b, 0x54, 0xe9, 0xc6, 0x67,
+	0x00, 0x51, 0x4a, 0x0a, 0x9f, 0x66, 0x3c, 0xbc, 0xf1, 0xc5, 0x94, 0x64, 0x7e, 0x96, 0xb3, 0x90,
+	0xfa, 0x11, 0x9d, 0x30, 0x22, 0x19, 0x1f, 0x9b, 0xa0, 0x07, 0xfa, 0xf7, 0xbd, 0x77, 0xf3, 0xd2,
+	0xd6, 0x7e, 0x94, 0xf6, 0x93, 0x98, 0xc9, 0x9b, 0xbb, 0xc0, 0x0d, 0x79, 0x8a, 0x43, 0x55, 0xb5,
+	0x79, 0x9c, 0x89, 0xe8, 0x16, 0xcb, 0x59, 0x46, 0x85, 0x7b, 0x41, 0xc3, 0xaa, 0xb4, 0x4f, 0x67,
+	0x24, 0x4d, 0xce, 0x9d, 0xff, 0xbb, 0x3b, 0x23, 0x2b, 0x25, 0xc5, 0xcb, 0xb5, 0x7e, 0x35, 0x25,
+	0xd9, 0x70, 0xad, 0x5e, 0xb4, 0xa2, 0xc1, 0xe0, 0x7e, 0x27, 0x35, 0xe5, 0x11, 0x35, 0xef, 0xf5,
+	0x40, 0xff, 0xc1, 0xb3, 0x53, 0xf7, 0xdf, 0x0d, 0xbb, 0x1b, 0xa7, 0x4b, 0x1e, 0x51, 0xcf, 0xaa,
+	0x4a, 0xfb, 0xb0, 0xfe, 0x8d, 0x3f, 0x7c, 0x9c, 0xd1, 0x1e, 0xed, 0xa2, 0xc6, 0x6b, 0xf8, 0xb0,
+	0x83, 0x04, 0x09, 0x0f, 0x6f, 0x85, 0xb9, 0xd3, 0x03, 0x7d, 0xdd, 0x3b, 0xa9, 0x4a, 0xdb, 0xfc,
+	0xcb, 0xa5, 0x46, 0x9c, 0xd1, 0xfe, 0xc6, 0xc7, 0x53, 0x11, 0xe3, 0x15, 0x3c, 0xa8, 0x31, 0x16,
+	0xd1, 0xb1, 0x64, 0xd7, 0x8c, 0xe6, 0xa6, 0xae, 0x66, 0x78, 0x5c, 0x95, 0xf6, 0x51, 0xd7, 0xe8,
+	0x37, 0xd1, 0xfa, 0xbc, 0xd9, 0x44, 0xce, 0xf5, 0x4f, 0x5f, 0x6c, 0xed, 0xe9, 0x10, 0xee, 0x6d,
+	0xf5, 0x64, 0x9c, 0x40, 0x73, 0x2b, 0x70, 0xc5, 0xc6, 0x71, 0x42, 0x55, 0xed, 0x03, 0xcd, 0x38,
+	0x86, 0x47, 0x5b, 0xea, 0xe5, 0x5d, 0x22, 0x59, 0x2d, 0x02, 0x4b, 0xff, 0xf0, 0x15, 0x69, 0xde,
+	0xdb, 0xf9, 0x12, 0x81, 0xc5, 0x12, 0x81, 0x9f, 0x4b, 0x04, 0x3e, 0xae, 0x90, 0xb6, 0x58, 0x21,
+	0xed, 0xfb, 0x0a, 0x69, 0xef, 0x07, 0x9d, 0xdd, 0x36, 0xf3, 0x3d, 0x4b, 0x48, 0x20, 0xda, 0x0f,
+	0x3c, 0x79, 0x81, 0x8b, 0xf6, 0x0e, 0xd5, 0xaa, 0x83, 0x5d, 0x75, 0x51, 0xcf, 0x7f, 0x0d, 0x00,
+	0x5d, 0x22, 0x65, 0xbe, 0xa6, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EpochSwapBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochSwapBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochSwapMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochSwapMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MaxEpochSwapPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxEpochSwapPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxEpochSwapPriceDeviation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.EpochSwapMode != 0 {
+		n += 1 + sovParams(uint64(m.EpochSwapMode))
+	}
+	if m.EpochSwapBlocks != 0 {
+		n += 1 + sovParams(uint64(m.EpochSwapBlocks))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpochSwapPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxEpochSwapPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochSwapMode", wireType)
+			}
+			m.EpochSwapMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochSwapMode |= EpochSwapMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochSwapBlocks", wireType)
+			}
+			m.EpochSwapBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochSwapBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
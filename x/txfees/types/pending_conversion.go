@@ -0,0 +1,27 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs stateless validation of a pending conversion.
+func (c PendingConversion) Validate() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return err
+	}
+	if c.PoolID == 0 {
+		return fmt.Errorf("pending conversion of %s has no pool id", c.Denom)
+	}
+	if c.Remaining.IsNil() || !c.Remaining.IsPositive() {
+		return fmt.Errorf("pending conversion of %s must have a positive remaining amount", c.Denom)
+	}
+	if c.AmountPerBlock.IsNil() || !c.AmountPerBlock.IsPositive() {
+		return fmt.Errorf("pending conversion of %s must have a positive amount per block", c.Denom)
+	}
+	if c.MinPrice.IsNil() || c.MinPrice.IsNegative() {
+		return fmt.Errorf("pending conversion of %s must have a non-negative min price", c.Denom)
+	}
+	return nil
+}
@@ -0,0 +1,582 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/txfees/v1beta1/pending_conversion.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingConversion is the part of a fee token's balance at the end of the
+// last epoch that is still to be swapped into the base denom, in the multi
+// block epoch swap mode.
+type PendingConversion struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	PoolID uint64 `protobuf:"varint,2,opt,name=poolID,proto3" json:"poolID,omitempty" yaml:"pool_id"`
+	// remaining is the amount of the fee token still to be swapped.
+	Remaining github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=remaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining" yaml:"remaining"`
+	// amount_per_block is the most that is swapped in a single block.
+	AmountPerBlock github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount_per_block,json=amountPerBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount_per_block" yaml:"amount_per_block"`
+	// min_price is the lowest price, in the base denom per fee token, at which
+	// the fee token may be sold. It is fixed at the end of the epoch.
+	MinPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_price,json=minPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price" yaml:"min_price"`
+	// route is the fee token's route into the base denom, if it has one.
+	Route []types.SwapAmountInRoute `protobuf:"bytes,6,rep,name=route,proto3" json:"route" yaml:"route"`
+}
+
+func (m *PendingConversion) Reset()         { *m = PendingConversion{} }
+func (m *PendingConversion) String() string { return proto.CompactTextString(m) }
+func (*PendingConversion) ProtoMessage()    {}
+func (*PendingConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94b63a3004dcc487, []int{0}
+}
+func (m *PendingConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingConversion.Merge(m, src)
+}
+func (m *PendingConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingConversion proto.InternalMessageInfo
+
+func (m *PendingConversion) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PendingConversion) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *PendingConversion) GetRoute() []types.SwapAmountInRoute {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PendingConversion)(nil), "osmosis.txfees.v1beta1.PendingConversion")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/txfees/v1beta1/pending_conversion.proto", fileDescriptor_94b63a3004dcc487)
+}
+
+var fileDescriptor_94b63a3004dcc487 = []byte{
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x13, 0xba, 0x56, 0xd4, 0xa0, 0xa9, 0x58, 0x13, 0x44, 0x93, 0x48, 0x2a, 0x1f, 0x46,
+	0x85, 0xb4, 0x58, 0x85, 0x03, 0x12, 0x37, 0xc2, 0x2e, 0x11, 0x97, 0x2a, 0xbb, 0x71, 0x09, 0xf9,
+	0x63, 0x82, 0xb5, 0xd8, 0x8e, 0x62, 0xb7, 0x74, 0xdf, 0x82, 0x8f, 0xb5, 0xe3, 0x8e, 0x88, 0x43,
+	0x84, 0xda, 0x6f, 0x50, 0xf1, 0x01, 0x50, 0xec, 0x24, 0x45, 0xdc, 0x76, 0x8a, 0xf3, 0xfa, 0x79,
+	0x7f, 0xcf, 0xfb, 0xea, 0x31, 0xc0, 0x42, 0x32, 0x21, 0xa9, 0xc4, 0x6a, 0xfb, 0x95, 0x10, 0x89,
+	0x37, 0xcb, 0x94, 0xa8, 0x64, 0x89, 0x2b, 0xc2, 0x73, 0xca, 0x8b, 0x38, 0x13, 0x7c, 0x43, 0x6a,
+	0x49, 0x05, 0xf7, 0xab, 0x5a, 0x28, 0x01, 0x9f, 0x77, 0x0d, 0xbe, 0x69, 0xf0, 0xbb, 0x86, 0xf3,
+	0xb3, 0x42, 0x14, 0x42, 0x4b, 0x70, 0x7b, 0x32, 0xea, 0xf3, 0x97, 0x3d, 0xbe, 0x48, 0x18, 0x1b,
+	0xe0, 0x6a, 0x6b, 0xae, 0xd1, 0x9f, 0x11, 0x78, 0xb6, 0x32, 0x4e, 0x1f, 0x07, 0x23, 0x78, 0x01,
+	0xc6, 0x39, 0xe1, 0x82, 0x39, 0xf6, 0xdc, 0x5e, 0x4c, 0x83, 0xd9, 0xa1, 0xf1, 0x9e, 0xde, 0x26,
+	0xac, 0x7c, 0x8f, 0x74, 0x19, 0x45, 0xe6, 0x1a, 0xbe, 0x06, 0x93, 0x4a, 0x88, 0x32, 0xbc, 0x72,
+	0x1e, 0xcd, 0xed, 0xc5, 0x49, 0x00, 0x0f, 0x8d, 0x77, 0x6a, 0x84, 0x6d, 0x3d, 0xa6, 0x39, 0x8a,
+	0x3a, 0x05, 0xfc, 0x02, 0xa6, 0x35, 0x61, 0x09, 0xe5, 0x94, 0x17, 0xce, 0x48, 0x73, 0x83, 0xbb,
+	0xc6, 0xb3, 0x7e, 0x35, 0xde, 0x45, 0x41, 0xd5, 0xb7, 0x75, 0xea, 0x67, 0x82, 0xe1, 0x4c, 0xcf,
+	0xdb, 0x7d, 0x2e, 0x65, 0x7e, 0x83, 0xd5, 0x6d, 0x45, 0xa4, 0x1f, 0x72, 0x75, 0x68, 0xbc, 0x99,
+	0x81, 0x0f, 0x20, 0x14, 0x1d, 0xa1, 0x50, 0x82, 0x59, 0xc2, 0xc4, 0x9a, 0xab, 0xb8, 0x22, 0x75,
+	0x9c, 0x96, 0x22, 0xbb, 0x71, 0x4e, 0xb4, 0x51, 0xf8, 0x60, 0xa3, 0x17, 0xc6, 0xe8, 0x7f, 0x1e,
+	0x8a, 0x4e, 0x4d, 0x69, 0x45, 0xea, 0xa0, 0x2d, 0xc0, 0x18, 0x4c, 0x19, 0xe5, 0x71, 0x55, 0xd3,
+	0x8c, 0x38, 0xe3, 0x07, 0xaf, 0x75, 0x45, 0xb2, 0xe3, 0x5a, 0x03, 0x08, 0x45, 0x8f, 0x19, 0xe5,
+	0xab, 0xf6, 0x08, 0xaf, 0xc1, 0xb8, 0x16, 0x6b, 0x45, 0x9c, 0xc9, 0x7c, 0xb4, 0x78, 0xf2, 0xe6,
+	0x95, 0xdf, 0xc7, 0xdf, 0x06, 0xda, 0x87, 0xef, 0x5f, 0x7f, 0x4f, 0xaa, 0x0f, 0x7a, 0xb2, 0x90,
+	0x47, 0xad, 0x3c, 0x38, 0x6b, 0xa7, 0x38, 0x06, 0xa7, 0x19, 0x28, 0x32, 0xac, 0xe0, 0xd3, 0xdd,
+	0xce, 0xb5, 0xef, 0x77, 0xae, 0xfd, 0x7b, 0xe7, 0xda, 0x3f, 0xf6, 0xae, 0x75, 0xbf, 0x77, 0xad,
+	0x9f, 0x7b, 0xd7, 0xfa, 0xbc, 0xfc, 0x67, 0xe8, 0xce, 0xe9, 0xb2, 0x4c, 0x52, 0xd9, 0xff, 0xe0,
+	0xcd, 0x3b, 0xbc, 0xed, 0xdf, 0xaa, 0xde, 0x21, 0x9d, 0xe8, 0xa7, 0xf4, 0xf6, 0xef, 0x00, 0x30,
+	0xc0, 0x47, 0x85, 0xca, 0x02, 0x00, 0x00,
+}
+
+func (m *PendingConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Route[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPendingConversion(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.MinPrice.Size()
+		i -= size
+		if _, err := m.MinPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPendingConversion(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AmountPerBlock.Size()
+		i -= size
+		if _, err := m.AmountPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPendingConversion(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPendingConversion(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolID != 0 {
+		i = encodeVarintPendingConversion(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPendingConversion(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPendingConversion(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPendingConversion(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPendingConversion(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovPendingConversion(uint64(m.PoolID))
+	}
+	l = m.Remaining.Size()
+	n += 1 + l + sovPendingConversion(uint64(l))
+	l = m.AmountPerBlock.Size()
+	n += 1 + l + sovPendingConversion(uint64(l))
+	l = m.MinPrice.Size()
+	n += 1 + l + sovPendingConversion(uint64(l))
+	if len(m.Route) > 0 {
+		for _, e := range m.Route {
+			l = e.Size()
+			n += 1 + l + sovPendingConversion(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPendingConversion(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPendingConversion(x uint64) (n int) {
+	return sovPendingConversion(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingConversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPendingConversion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingConversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingConversion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingConversion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingConversion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingConversion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingConversion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingConversion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingConversion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingConversion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingConversion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingConversion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingConversion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingConversion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingConversion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingConversion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPendingConversion
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingConversion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = append(m.Route, types.SwapAmountInRoute{})
+			if err := m.Route[len(m.Route)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPendingConversion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPendingConversion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPendingConversion(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPendingConversion
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingConversion
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingConversion
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPendingConversion
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPendingConversion
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPendingConversion
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPendingConversion        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPendingConversion          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPendingConversion = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &QueryEstimateFeeRequest{}
+
+// NewQueryEstimateFeeRequest returns a request estimating the fee in denom of a tx with msgs wanting gasWanted gas.
+func NewQueryEstimateFeeRequest(gasWanted uint64, denom string, msgs []sdk.Msg) (*QueryEstimateFeeRequest, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+	return &QueryEstimateFeeRequest{GasWanted: gasWanted, Denom: denom, Msgs: anys}, nil
+}
+
+// GetSdkMsgs returns the unpacked msgs of the request.
+func (req *QueryEstimateFeeRequest) GetSdkMsgs() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(req.Msgs))
+	for i, any := range req.Msgs {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, fmt.Errorf("message %s at position %d is not an sdk.Msg", any.TypeUrl, i)
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (req *QueryEstimateFeeRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range req.Msgs {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
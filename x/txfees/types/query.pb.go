@@ -6,7 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -372,6 +374,201 @@ func (m *QueryBaseDenomResponse) GetBaseDenom() string {
 	return ""
 }
 
+type QueryPendingConversionsRequest struct {
+}
+
+func (m *QueryPendingConversionsRequest) Reset()         { *m = QueryPendingConversionsRequest{} }
+func (m *QueryPendingConversionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingConversionsRequest) ProtoMessage()    {}
+func (*QueryPendingConversionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{8}
+}
+func (m *QueryPendingConversionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingConversionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingConversionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingConversionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingConversionsRequest.Merge(m, src)
+}
+func (m *QueryPendingConversionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingConversionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingConversionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingConversionsRequest proto.InternalMessageInfo
+
+type QueryPendingConversionsResponse struct {
+	PendingConversions []PendingConversion `protobuf:"bytes,1,rep,name=pending_conversions,json=pendingConversions,proto3" json:"pending_conversions" yaml:"pending_conversions"`
+}
+
+func (m *QueryPendingConversionsResponse) Reset()         { *m = QueryPendingConversionsResponse{} }
+func (m *QueryPendingConversionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingConversionsResponse) ProtoMessage()    {}
+func (*QueryPendingConversionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{9}
+}
+func (m *QueryPendingConversionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingConversionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingConversionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingConversionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingConversionsResponse.Merge(m, src)
+}
+func (m *QueryPendingConversionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingConversionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingConversionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingConversionsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingConversionsResponse) GetPendingConversions() []PendingConversion {
+	if m != nil {
+		return m.PendingConversions
+	}
+	return nil
+}
+
+// QueryEstimateFeeRequest defines grpc request structure for estimating the
+// minimum fee for a tx
+type QueryEstimateFeeRequest struct {
+	GasWanted uint64 `protobuf:"varint,1,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty" yaml:"gas_wanted"`
+	// denom is the fee token to pay the fee in. It defaults to the base denom.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// msgs are the msgs of the tx, which the mempool fee filters are matched
+	// against, e.g. to charge arbitrage txs a higher minimum gas price.
+	Msgs []*types.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty" yaml:"msgs"`
+}
+
+func (m *QueryEstimateFeeRequest) Reset()         { *m = QueryEstimateFeeRequest{} }
+func (m *QueryEstimateFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateFeeRequest) ProtoMessage()    {}
+func (*QueryEstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{10}
+}
+func (m *QueryEstimateFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateFeeRequest.Merge(m, src)
+}
+func (m *QueryEstimateFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateFeeRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateFeeRequest) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *QueryEstimateFeeRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryEstimateFeeRequest) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+// QueryEstimateFeeResponse defines grpc response structure for estimating the
+// minimum fee for a tx
+type QueryEstimateFeeResponse struct {
+	// fee is the minimum fee in the requested denom, at current spot prices.
+	Fee types1.Coin `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee" yaml:"fee"`
+	// min_base_gas_price is the minimum gas price in the base denom that the
+	// fee is derived from.
+	MinBaseGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_base_gas_price,json=minBaseGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_gas_price" yaml:"min_base_gas_price"`
+}
+
+func (m *QueryEstimateFeeResponse) Reset()         { *m = QueryEstimateFeeResponse{} }
+func (m *QueryEstimateFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateFeeResponse) ProtoMessage()    {}
+func (*QueryEstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{11}
+}
+func (m *QueryEstimateFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateFeeResponse.Merge(m, src)
+}
+func (m *QueryEstimateFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateFeeResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateFeeResponse) GetFee() types1.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types1.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryFeeTokensRequest)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensRequest")
 	proto.RegisterType((*QueryFeeTokensResponse)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensResponse")
@@ -381,6 +578,10 @@ func init() {
 	proto.RegisterType((*QueryDenomPoolIdResponse)(nil), "osmosis.txfees.v1beta1.QueryDenomPoolIdResponse")
 	proto.RegisterType((*QueryBaseDenomRequest)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomRequest")
 	proto.RegisterType((*QueryBaseDenomResponse)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomResponse")
+	proto.RegisterType((*QueryPendingConversionsRequest)(nil), "osmosis.txfees.v1beta1.QueryPendingConversionsRequest")
+	proto.RegisterType((*QueryPendingConversionsResponse)(nil), "osmosis.txfees.v1beta1.QueryPendingConversionsResponse")
+	proto.RegisterType((*QueryEstimateFeeRequest)(nil), "osmosis.txfees.v1beta1.QueryEstimateFeeRequest")
+	proto.RegisterType((*QueryEstimateFeeResponse)(nil), "osmosis.txfees.v1beta1.QueryEstimateFeeResponse")
 }
 
 func init() {
@@ -388,46 +589,64 @@ func init() {
 }
 
 var fileDescriptor_6cbc1b48c44dfdd6 = []byte{
-	// 611 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6b, 0x13, 0x4f,
-	0x18, 0xc6, 0x33, 0xfd, 0xff, 0x53, 0xc8, 0x54, 0x8a, 0x0e, 0xb6, 0x8d, 0xab, 0x6c, 0xc2, 0xa0,
-	0xa5, 0x54, 0xb2, 0x63, 0x12, 0x45, 0xf0, 0x66, 0x0c, 0x05, 0x11, 0xa4, 0xae, 0x9e, 0x7a, 0x59,
-	0x76, 0x93, 0x37, 0x71, 0x69, 0x92, 0xd9, 0x66, 0x26, 0xa5, 0x41, 0xbc, 0xf8, 0x09, 0x04, 0xc5,
-	0xaf, 0xe0, 0x49, 0x3f, 0x47, 0x8f, 0x05, 0x2f, 0xe2, 0x21, 0x48, 0xe2, 0x27, 0xc8, 0x27, 0x90,
-	0x9d, 0x9d, 0xcd, 0xa6, 0x6d, 0x62, 0x9a, 0x53, 0xb2, 0xf3, 0x3e, 0xf3, 0xbc, 0xcf, 0xbb, 0xf3,
-	0x9b, 0xc5, 0x94, 0x8b, 0x36, 0x17, 0xbe, 0x60, 0xf2, 0xa4, 0x01, 0x20, 0xd8, 0x71, 0xd1, 0x03,
-	0xe9, 0x16, 0xd9, 0x51, 0x0f, 0xba, 0x7d, 0x2b, 0xe8, 0x72, 0xc9, 0xc9, 0xa6, 0xd6, 0x58, 0x91,
-	0xc6, 0xd2, 0x1a, 0xe3, 0x66, 0x93, 0x37, 0xb9, 0x92, 0xb0, 0xf0, 0x5f, 0xa4, 0x36, 0xee, 0x34,
-	0x39, 0x6f, 0xb6, 0x80, 0xb9, 0x81, 0xcf, 0xdc, 0x4e, 0x87, 0x4b, 0x57, 0xfa, 0xbc, 0x23, 0x74,
-	0xd5, 0xd4, 0x55, 0xf5, 0xe4, 0xf5, 0x1a, 0xac, 0xde, 0xeb, 0x2a, 0x81, 0xae, 0xdf, 0x9b, 0x93,
-	0xa7, 0x01, 0x20, 0xf9, 0x21, 0x68, 0x19, 0xdd, 0xc2, 0x1b, 0xaf, 0xc2, 0x84, 0x7b, 0x00, 0x6f,
-	0xc2, 0x65, 0x61, 0xc3, 0x51, 0x0f, 0x84, 0xa4, 0x12, 0x6f, 0x5e, 0x2c, 0x88, 0x80, 0x77, 0x04,
-	0x90, 0x03, 0x8c, 0x1b, 0x00, 0x8e, 0x72, 0x11, 0x59, 0x94, 0xff, 0x6f, 0x67, 0xad, 0x94, 0xb7,
-	0x66, 0x8f, 0x66, 0xc5, 0xdb, 0x2b, 0xb7, 0x4e, 0x07, 0xb9, 0xd4, 0x78, 0x90, 0xbb, 0xd1, 0x77,
-	0xdb, 0xad, 0x27, 0x34, 0x71, 0xa0, 0x76, 0xa6, 0x11, 0xf7, 0xa0, 0x55, 0x6c, 0xa8, 0xae, 0x55,
-	0xe8, 0xf0, 0xf6, 0xeb, 0x80, 0xcb, 0xfd, 0xae, 0x5f, 0x03, 0x9d, 0x89, 0x6c, 0xe3, 0x74, 0x3d,
-	0x2c, 0x64, 0x51, 0x1e, 0xed, 0x64, 0x2a, 0xd7, 0xc7, 0x83, 0xdc, 0xb5, 0xc8, 0x4e, 0x2d, 0x53,
-	0x3b, 0x2a, 0xd3, 0x6f, 0x08, 0xdf, 0x9e, 0x69, 0xa3, 0x27, 0xd8, 0xc5, 0xab, 0x01, 0xe7, 0xad,
-	0xe7, 0x55, 0x65, 0xf4, 0x7f, 0x85, 0x8c, 0x07, 0xb9, 0xf5, 0xc8, 0x28, 0x5c, 0x77, 0xfc, 0x3a,
-	0xb5, 0xb5, 0x82, 0x78, 0x18, 0x8b, 0x80, 0x4b, 0x27, 0x08, 0x1d, 0xb2, 0x2b, 0xaa, 0xf1, 0xb3,
-	0x70, 0x96, 0x5f, 0x83, 0xdc, 0x76, 0xd3, 0x97, 0x6f, 0x7b, 0x9e, 0x55, 0xe3, 0x6d, 0x56, 0x53,
-	0x2f, 0x40, 0xff, 0x14, 0x44, 0xfd, 0x90, 0xc9, 0x7e, 0x00, 0xc2, 0xaa, 0x42, 0x2d, 0x99, 0x3a,
-	0x71, 0xa2, 0x76, 0x46, 0xc4, 0xb9, 0xe8, 0x53, 0xbc, 0x95, 0xc4, 0xdd, 0x0f, 0xfb, 0xd6, 0x97,
-	0x1d, 0x79, 0x0f, 0x67, 0x2f, 0x5b, 0x2c, 0x3f, 0xee, 0x84, 0x87, 0x8a, 0x2b, 0x40, 0x79, 0xc5,
-	0x3c, 0xbc, 0xd4, 0x3c, 0x4c, 0x15, 0xb4, 0xfd, 0x43, 0x8c, 0x3d, 0x57, 0x80, 0x33, 0x9d, 0x73,
-	0x23, 0x99, 0x39, 0xa9, 0x51, 0x3b, 0xe3, 0xc5, 0xbb, 0x4b, 0x9f, 0xd3, 0x38, 0xad, 0x0c, 0xc9,
-	0x17, 0x84, 0x33, 0x13, 0xca, 0x48, 0x61, 0x1e, 0x49, 0x33, 0x31, 0x35, 0xac, 0xab, 0xca, 0xa3,
-	0xb0, 0x74, 0xf7, 0xc3, 0x8f, 0x3f, 0x9f, 0x56, 0xee, 0x12, 0xca, 0xe6, 0xdf, 0x0f, 0x0d, 0x26,
-	0xf9, 0x8e, 0xf0, 0xfa, 0x79, 0x82, 0x48, 0xe9, 0x9f, 0xed, 0x66, 0x52, 0x6b, 0x94, 0x97, 0xda,
-	0xa3, 0x73, 0x96, 0x55, 0xce, 0x02, 0xb9, 0x3f, 0x2f, 0x67, 0x82, 0x92, 0xe3, 0xf5, 0xa3, 0xf7,
-	0x4b, 0xbe, 0x22, 0xbc, 0x36, 0x05, 0x00, 0x61, 0x8b, 0x3b, 0x9f, 0xa3, 0xcd, 0x78, 0x70, 0xf5,
-	0x0d, 0x3a, 0xe7, 0x23, 0x95, 0x93, 0x91, 0xc2, 0xbc, 0x9c, 0x2a, 0x99, 0xa3, 0x39, 0x63, 0xef,
-	0xd4, 0xe3, 0x7b, 0x75, 0xe6, 0x13, 0x92, 0x16, 0x9c, 0xf9, 0x45, 0x14, 0x17, 0x9c, 0xf9, 0x25,
-	0x40, 0x17, 0x9f, 0x79, 0x82, 0x68, 0xe5, 0xc5, 0xe9, 0xd0, 0x44, 0x67, 0x43, 0x13, 0xfd, 0x1e,
-	0x9a, 0xe8, 0xe3, 0xc8, 0x4c, 0x9d, 0x8d, 0xcc, 0xd4, 0xcf, 0x91, 0x99, 0x3a, 0x28, 0x4e, 0x5d,
-	0x76, 0xed, 0x53, 0x68, 0xb9, 0x9e, 0x98, 0x98, 0x1e, 0x3f, 0x66, 0x27, 0xb1, 0xb3, 0xba, 0xfb,
-	0xde, 0xaa, 0xfa, 0xc6, 0x96, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x77, 0xe0, 0xbb, 0x37, 0x1c,
-	0x06, 0x00, 0x00,
+	// 904 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x8f, 0x77, 0xdb, 0xa2, 0xbc, 0xa0, 0x16, 0x86, 0x76, 0x9b, 0x18, 0xe4, 0x44, 0xa3, 0xb2,
+	0x2a, 0x85, 0x78, 0xba, 0xd9, 0x42, 0x05, 0x17, 0x54, 0x6f, 0x58, 0x84, 0x2a, 0xa1, 0xc5, 0x20,
+	0x21, 0xf5, 0x62, 0xd9, 0xc9, 0xc4, 0x58, 0x8d, 0x3d, 0xde, 0x8c, 0xb3, 0x6c, 0x84, 0xe0, 0xc0,
+	0x27, 0x40, 0x42, 0xe2, 0x88, 0xc4, 0x89, 0x13, 0x1c, 0x38, 0xf2, 0x09, 0xf6, 0xb8, 0x12, 0x42,
+	0x42, 0x1c, 0x22, 0xb4, 0xcb, 0x27, 0xc8, 0x27, 0xa8, 0x3c, 0x1e, 0xdb, 0xd9, 0x38, 0xde, 0x4d,
+	0x4e, 0xf9, 0xf3, 0xde, 0xfb, 0xbd, 0xdf, 0x7b, 0xef, 0x37, 0x3f, 0xc0, 0x8c, 0xfb, 0x8c, 0x7b,
+	0x9c, 0x44, 0xc7, 0x03, 0x4a, 0x39, 0x39, 0xda, 0x71, 0x68, 0x64, 0xef, 0x90, 0xc3, 0x31, 0x1d,
+	0x4d, 0xf4, 0x70, 0xc4, 0x22, 0x86, 0xb6, 0x64, 0x8e, 0x9e, 0xe4, 0xe8, 0x32, 0x47, 0xbd, 0xed,
+	0x32, 0x97, 0x89, 0x14, 0x12, 0x7f, 0x4b, 0xb2, 0x55, 0xad, 0x27, 0xd2, 0x89, 0x63, 0x73, 0x9a,
+	0xc1, 0xf5, 0x98, 0x17, 0xc8, 0xf8, 0x1b, 0x2e, 0x63, 0xee, 0x90, 0x12, 0x3b, 0xf4, 0x88, 0x1d,
+	0x04, 0x2c, 0xb2, 0x23, 0x8f, 0x05, 0x5c, 0x46, 0x1b, 0x32, 0x2a, 0x7e, 0x39, 0xe3, 0x01, 0xb1,
+	0x83, 0x49, 0x0a, 0xbc, 0x18, 0xea, 0x8f, 0x47, 0xa2, 0x56, 0xc6, 0xdf, 0x2c, 0x19, 0x65, 0x40,
+	0x69, 0xc4, 0x9e, 0xd3, 0x34, 0x8d, 0x94, 0xa4, 0x85, 0x34, 0xe8, 0x7b, 0x81, 0x6b, 0xf5, 0x58,
+	0x70, 0x44, 0x47, 0x3c, 0xc3, 0xc5, 0x77, 0xe1, 0xce, 0x67, 0xf1, 0x36, 0xf6, 0x29, 0xfd, 0x22,
+	0xc6, 0xe1, 0x26, 0x3d, 0x1c, 0x53, 0x1e, 0xe1, 0x08, 0xb6, 0x16, 0x03, 0x3c, 0x64, 0x01, 0xa7,
+	0xe8, 0x19, 0xc0, 0x80, 0x52, 0x4b, 0xb4, 0xe5, 0x75, 0xa5, 0xb5, 0x79, 0xbf, 0xd6, 0x69, 0xe9,
+	0xcb, 0xd7, 0xa8, 0xa7, 0xe5, 0x46, 0xe3, 0x64, 0xda, 0xac, 0xcc, 0xa6, 0xcd, 0x57, 0x27, 0xb6,
+	0x3f, 0xfc, 0x00, 0xe7, 0x08, 0xd8, 0xac, 0x0e, 0xd2, 0x1e, 0xb8, 0x0b, 0xaa, 0xe8, 0xda, 0xa5,
+	0x01, 0xf3, 0x3f, 0x0f, 0x59, 0x74, 0x30, 0xf2, 0x7a, 0x54, 0x72, 0x42, 0xdb, 0x70, 0xbd, 0x1f,
+	0x07, 0xea, 0x4a, 0x4b, 0xb9, 0x5f, 0x35, 0x5e, 0x99, 0x4d, 0x9b, 0x2f, 0x27, 0x70, 0xe2, 0x6f,
+	0x6c, 0x26, 0x61, 0xfc, 0x9b, 0x02, 0xaf, 0x2f, 0x85, 0x91, 0x13, 0x3c, 0x80, 0x1b, 0x21, 0x63,
+	0xc3, 0x4f, 0xba, 0x02, 0xe8, 0x9a, 0x81, 0x66, 0xd3, 0xe6, 0xcd, 0x04, 0x28, 0xfe, 0xdf, 0xf2,
+	0xfa, 0xd8, 0x94, 0x19, 0xc8, 0x01, 0xe0, 0x21, 0x8b, 0xac, 0x30, 0x46, 0xa8, 0x6f, 0x88, 0xc6,
+	0x7b, 0xf1, 0x2c, 0xff, 0x4e, 0x9b, 0xdb, 0xae, 0x17, 0x7d, 0x35, 0x76, 0xf4, 0x1e, 0xf3, 0x89,
+	0x14, 0x46, 0xf2, 0xd1, 0xe6, 0xfd, 0xe7, 0x24, 0x9a, 0x84, 0x94, 0xeb, 0x5d, 0xda, 0xcb, 0xa7,
+	0xce, 0x91, 0xb0, 0x59, 0xe5, 0x29, 0x2f, 0xfc, 0x04, 0xee, 0xe6, 0x74, 0x0f, 0xe2, 0xbe, 0xfd,
+	0x75, 0x47, 0xde, 0x87, 0x7a, 0x11, 0x62, 0xfd, 0x71, 0x33, 0x3d, 0x18, 0x36, 0xa7, 0x02, 0x2b,
+	0xd5, 0xc3, 0xa7, 0xb0, 0xb5, 0x18, 0x90, 0xf0, 0x8f, 0x00, 0xe2, 0xe7, 0x60, 0xcd, 0xf3, 0xbc,
+	0x93, 0xcf, 0x9c, 0xc7, 0xb0, 0x59, 0x75, 0xd2, 0x6a, 0xdc, 0x02, 0x4d, 0xe0, 0x1d, 0x24, 0xca,
+	0xdc, 0xcb, 0x84, 0x99, 0x29, 0xf0, 0x17, 0x05, 0x9a, 0xa5, 0x29, 0xb2, 0xf7, 0x77, 0xf0, 0x5a,
+	0x51, 0xda, 0xa9, 0x28, 0xdf, 0x2a, 0x13, 0x65, 0x01, 0xd0, 0xc0, 0x52, 0x9d, 0xaa, 0x5c, 0x4b,
+	0x11, 0x13, 0x9b, 0x28, 0x2c, 0xf0, 0xc0, 0x7f, 0x28, 0xf2, 0x74, 0x1f, 0xf1, 0xc8, 0xf3, 0xed,
+	0x88, 0xee, 0xd3, 0x4c, 0xad, 0x8f, 0x00, 0x5c, 0x9b, 0x5b, 0x5f, 0xdb, 0x41, 0x44, 0xfb, 0x72,
+	0xf5, 0x73, 0x7b, 0xc9, 0x63, 0xd8, 0xac, 0xba, 0x36, 0xff, 0x52, 0x7c, 0xcf, 0x0f, 0xbe, 0x71,
+	0xe9, 0xc1, 0xd1, 0xfb, 0x70, 0xcd, 0xe7, 0x2e, 0xaf, 0x6f, 0x8a, 0x51, 0x6f, 0xeb, 0x89, 0x7f,
+	0xe8, 0xa9, 0x7f, 0xe8, 0x4f, 0x82, 0x89, 0x71, 0x6b, 0x36, 0x6d, 0xd6, 0x92, 0xe2, 0x38, 0x17,
+	0x9b, 0xa2, 0x04, 0xff, 0xad, 0x40, 0xbd, 0x48, 0x5a, 0x6e, 0xf4, 0x43, 0xd8, 0x1c, 0x50, 0x2a,
+	0xe8, 0xd6, 0x3a, 0x0d, 0x3d, 0xd1, 0xb3, 0x1e, 0xdf, 0x2d, 0x5b, 0xdf, 0x1e, 0xf3, 0x02, 0x03,
+	0xc9, 0x8d, 0x41, 0xf6, 0x9e, 0xb1, 0x19, 0x57, 0xa2, 0x63, 0x40, 0xbe, 0x17, 0x58, 0xe2, 0xec,
+	0xf1, 0x8c, 0xf3, 0x0f, 0xe7, 0xe9, 0xda, 0x0f, 0xa7, 0x21, 0xe9, 0x17, 0x10, 0xb1, 0x79, 0xcb,
+	0xf7, 0x82, 0x58, 0x8d, 0x1f, 0xdb, 0x5c, 0x3c, 0xa3, 0xce, 0xec, 0x25, 0xb8, 0x2e, 0xe6, 0x42,
+	0x3f, 0x29, 0x50, 0xcd, 0x8c, 0x0b, 0xb5, 0xcb, 0x74, 0xb0, 0xd4, 0xf9, 0x54, 0x7d, 0xd5, 0xf4,
+	0x64, 0x63, 0xf8, 0xc1, 0xf7, 0x7f, 0xfd, 0xff, 0xe3, 0xc6, 0x3d, 0x84, 0x49, 0xb9, 0x47, 0x4b,
+	0xaf, 0x43, 0xbf, 0x2b, 0x70, 0xf3, 0xa2, 0x29, 0xa1, 0xce, 0xa5, 0xed, 0x96, 0x1a, 0xa1, 0xba,
+	0xbb, 0x56, 0x8d, 0xe4, 0xb9, 0x2b, 0x78, 0xb6, 0xd1, 0xdb, 0x65, 0x3c, 0x73, 0x77, 0xb2, 0x9c,
+	0x49, 0xf2, 0x64, 0xd1, 0xaf, 0x0a, 0xd4, 0xe6, 0x3c, 0x05, 0x91, 0xab, 0x3b, 0x5f, 0x30, 0x30,
+	0xf5, 0xe1, 0xea, 0x05, 0x92, 0xe7, 0xbb, 0x82, 0x27, 0x41, 0xed, 0x32, 0x9e, 0x82, 0x99, 0x25,
+	0xad, 0x8b, 0x7c, 0x23, 0x7e, 0x7e, 0x2b, 0x6e, 0x9e, 0x99, 0xd3, 0x15, 0x37, 0x5f, 0x74, 0x37,
+	0x55, 0x5f, 0x35, 0x7d, 0xd5, 0x9b, 0xe7, 0xae, 0x87, 0xfe, 0x54, 0x00, 0x15, 0x2d, 0x0c, 0xbd,
+	0x77, 0x69, 0xcb, 0x52, 0x5b, 0x54, 0x1f, 0xaf, 0x5d, 0xb7, 0xea, 0xfd, 0x97, 0xb8, 0x1e, 0xfa,
+	0x59, 0x81, 0xda, 0x9c, 0x4d, 0x5c, 0x71, 0xff, 0xa2, 0x0b, 0xaa, 0x0f, 0x57, 0x2f, 0x90, 0x3c,
+	0xdf, 0x11, 0x3c, 0xb7, 0xd1, 0xbd, 0x32, 0x9e, 0x54, 0x16, 0x59, 0x03, 0x4a, 0x8d, 0xa7, 0x27,
+	0x67, 0x9a, 0x72, 0x7a, 0xa6, 0x29, 0xff, 0x9d, 0x69, 0xca, 0x0f, 0xe7, 0x5a, 0xe5, 0xf4, 0x5c,
+	0xab, 0xfc, 0x73, 0xae, 0x55, 0x9e, 0xed, 0xcc, 0x99, 0x8c, 0x44, 0x6a, 0x0f, 0x6d, 0x87, 0x67,
+	0xb0, 0x47, 0x8f, 0xc9, 0x71, 0x8a, 0x2d, 0x3c, 0xc7, 0xb9, 0x21, 0xec, 0x73, 0xf7, 0xc5, 0x00,
+	0x78, 0xf7, 0xdc, 0x15, 0x39, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -449,6 +668,12 @@ type QueryClient interface {
 	DenomSpotPrice(ctx context.Context, in *QueryDenomSpotPriceRequest, opts ...grpc.CallOption) (*QueryDenomSpotPriceResponse, error)
 	DenomPoolId(ctx context.Context, in *QueryDenomPoolIdRequest, opts ...grpc.CallOption) (*QueryDenomPoolIdResponse, error)
 	BaseDenom(ctx context.Context, in *QueryBaseDenomRequest, opts ...grpc.CallOption) (*QueryBaseDenomResponse, error)
+	// PendingConversions returns the fee token balances still to be swapped
+	// into the base denom, in the multi block epoch swap mode.
+	PendingConversions(ctx context.Context, in *QueryPendingConversionsRequest, opts ...grpc.CallOption) (*QueryPendingConversionsResponse, error)
+	// EstimateFee returns the minimum fee in a fee token that this node accepts
+	// into its mempool, for a tx wanting the given gas.
+	EstimateFee(ctx context.Context, in *QueryEstimateFeeRequest, opts ...grpc.CallOption) (*QueryEstimateFeeResponse, error)
 }
 
 type queryClient struct {
@@ -495,6 +720,24 @@ func (c *queryClient) BaseDenom(ctx context.Context, in *QueryBaseDenomRequest,
 	return out, nil
 }
 
+func (c *queryClient) PendingConversions(ctx context.Context, in *QueryPendingConversionsRequest, opts ...grpc.CallOption) (*QueryPendingConversionsResponse, error) {
+	out := new(QueryPendingConversionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/PendingConversions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateFee(ctx context.Context, in *QueryEstimateFeeRequest, opts ...grpc.CallOption) (*QueryEstimateFeeResponse, error) {
+	out := new(QueryEstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// FeeTokens returns a list of all the whitelisted fee tokens and their
@@ -504,6 +747,12 @@ type QueryServer interface {
 	DenomSpotPrice(context.Context, *QueryDenomSpotPriceRequest) (*QueryDenomSpotPriceResponse, error)
 	DenomPoolId(context.Context, *QueryDenomPoolIdRequest) (*QueryDenomPoolIdResponse, error)
 	BaseDenom(context.Context, *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error)
+	// PendingConversions returns the fee token balances still to be swapped
+	// into the base denom, in the multi block epoch swap mode.
+	PendingConversions(context.Context, *QueryPendingConversionsRequest) (*QueryPendingConversionsResponse, error)
+	// EstimateFee returns the minimum fee in a fee token that this node accepts
+	// into its mempool, for a tx wanting the given gas.
+	EstimateFee(context.Context, *QueryEstimateFeeRequest) (*QueryEstimateFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -522,6 +771,12 @@ func (*UnimplementedQueryServer) DenomPoolId(ctx context.Context, req *QueryDeno
 func (*UnimplementedQueryServer) BaseDenom(ctx context.Context, req *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseDenom not implemented")
 }
+func (*UnimplementedQueryServer) PendingConversions(ctx context.Context, req *QueryPendingConversionsRequest) (*QueryPendingConversionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingConversions not implemented")
+}
+func (*UnimplementedQueryServer) EstimateFee(ctx context.Context, req *QueryEstimateFeeRequest) (*QueryEstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -599,6 +854,42 @@ func _Query_BaseDenom_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingConversions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingConversionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingConversions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/PendingConversions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingConversions(ctx, req.(*QueryPendingConversionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateFee(ctx, req.(*QueryEstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.txfees.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -619,6 +910,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BaseDenom",
 			Handler:    _Query_BaseDenom_Handler,
 		},
+		{
+			MethodName: "PendingConversions",
+			Handler:    _Query_PendingConversions_Handler,
+		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _Query_EstimateFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/txfees/v1beta1/query.proto",
@@ -863,81 +1162,233 @@ func (m *QueryBaseDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPendingConversionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryFeeTokensRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryPendingConversionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingConversionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeeTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingConversionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingConversionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingConversionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.FeeTokens) > 0 {
-		for _, e := range m.FeeTokens {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.PendingConversions) > 0 {
+		for iNdEx := len(m.PendingConversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingConversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomSpotPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryEstimateFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDenomSpotPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovQuery(uint64(m.PoolID))
-	}
-	l = m.SpotPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryEstimateFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomPoolIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEstimateFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MinBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryFeeTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomSpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomSpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovQuery(uint64(m.PoolID))
+	}
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomPoolIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryDenomPoolIdResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -972,6 +1423,65 @@ func (m *QueryBaseDenomResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingConversionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingConversionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingConversions) > 0 {
+		for _, e := range m.PendingConversions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasWanted != 0 {
+		n += 1 + sovQuery(uint64(m.GasWanted))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinBaseGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1580,6 +2090,392 @@ func (m *QueryBaseDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPendingConversionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingConversionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingConversionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingConversionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingConversionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingConversionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingConversions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingConversions = append(m.PendingConversions, PendingConversion{})
+			if err := m.PendingConversions[len(m.PendingConversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0